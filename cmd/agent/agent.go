@@ -1,20 +1,34 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"os"
+	"path/filepath"
+	"sync"
 
 	"github.com/zdnscloud/cement/log"
+	"github.com/zdnscloud/cement/signal"
 	"google.golang.org/grpc"
 
 	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/agentmetric"
+	"github.com/linkingthing/ddi-agent/pkg/audit"
 	"github.com/linkingthing/ddi-agent/pkg/db"
 	dhcpconsumer "github.com/linkingthing/ddi-agent/pkg/dhcp/kafkaconsumer"
 	"github.com/linkingthing/ddi-agent/pkg/dns"
+	"github.com/linkingthing/ddi-agent/pkg/dns/analytics"
+	"github.com/linkingthing/ddi-agent/pkg/dns/detector"
 	dnsconsumer "github.com/linkingthing/ddi-agent/pkg/dns/kafkaconsumer"
+	"github.com/linkingthing/ddi-agent/pkg/dns/prober"
+	"github.com/linkingthing/ddi-agent/pkg/dns/querylog"
+	"github.com/linkingthing/ddi-agent/pkg/fileutil"
 	"github.com/linkingthing/ddi-agent/pkg/grpcclient"
 	"github.com/linkingthing/ddi-agent/pkg/grpcserver"
 	"github.com/linkingthing/ddi-agent/pkg/kafkaproducer"
 	"github.com/linkingthing/ddi-agent/pkg/metric"
+	"github.com/linkingthing/ddi-agent/pkg/nginxlog"
+	"github.com/linkingthing/ddi-agent/pkg/tlsconfig"
 )
 
 var (
@@ -31,22 +45,64 @@ func main() {
 		log.Fatalf("load config file failed: %s", err.Error())
 	}
 
+	if level, err := parseLogLevel(conf.Server.LogLevel); err != nil {
+		log.Fatalf("load log level failed: %s", err.Error())
+	} else if level != log.Debug {
+		log.InitLogger(level)
+	}
+
+	if err := audit.Init(conf); err != nil {
+		log.Fatalf("new audit log failed: %s", err.Error())
+	}
+
 	db.RegisterResources(dns.PersistentResources()...)
 	if err := db.Init(conf); err != nil {
 		log.Fatalf("new db failed: %s", err.Error())
 	}
 
+	if err := kafkaproducer.Init(conf); err != nil {
+		log.Fatalf("new kafka producer failed: %s", err.Error())
+	}
+	queryLogTailer := querylog.NewTailer(filepath.Join(conf.DNS.ConfDir, querylog.QueryLogName))
+	if analyzer := analytics.Init(conf); analyzer != nil {
+		queryLogTailer.AddHandler(analyzer)
+	}
+	backgroundSteps := []shutdownStep{
+		{"query log tailer", func(ctx context.Context) error { return stopWithContext(ctx, queryLogTailer.Stop) }},
+	}
+	if d, err := detector.Init(conf); err != nil {
+		log.Fatalf("new dns detector failed: %s", err.Error())
+	} else if d != nil {
+		queryLogTailer.AddHandler(d)
+		backgroundSteps = append(backgroundSteps, shutdownStep{"dns detector", func(ctx context.Context) error { return stopWithContext(ctx, d.Close) }})
+	}
+	go queryLogTailer.Run()
+	if p := prober.Init(conf); p != nil {
+		go p.Run()
+		backgroundSteps = append(backgroundSteps, shutdownStep{"dns prober", func(ctx context.Context) error { return stopWithContext(ctx, p.Stop) }})
+	}
+	if stats := nginxlog.Init(conf); stats != nil {
+		nginxLogQuit := make(chan struct{})
+		go fileutil.Tail(nginxlog.LogPath(&conf.NginxAccessLog), stats.HandleLine, nginxLogQuit)
+		backgroundSteps = append(backgroundSteps, shutdownStep{"nginx access log tailer", func(context.Context) error { close(nginxLogQuit); return nil }})
+	}
+
 	m, err := metric.New(conf)
 	if err != nil {
 		log.Fatalf("new metric failed: %s", err.Error())
 	}
 	go m.Run()
 
-	monitorConn, err := grpc.Dial(conf.Monitor.GrpcAddr, grpc.WithInsecure())
+	monitorCreds, err := tlsconfig.DialOption(conf.Monitor.TLS, conf.Monitor.GrpcAddr)
+	if err != nil {
+		log.Fatalf("create monitor grpc tls config failed: %s", err.Error())
+	}
+
+	monitorConn, err := grpc.Dial(conf.Monitor.GrpcAddr, monitorCreds,
+		grpc.WithUnaryInterceptor(agentmetric.MonitorClientInterceptor))
 	if err != nil {
 		log.Fatalf("dial monitor grpc server failed: %s", err.Error())
 	}
-	defer monitorConn.Close()
 	grpcclient.New(monitorConn)
 
 	s, err := grpcserver.New(conf)
@@ -54,14 +110,63 @@ func main() {
 		log.Fatalf("new grpc server failed: %s", err.Error())
 	}
 
-	conn, err := grpc.Dial(conf.Server.GrpcAddr, grpc.WithInsecure())
+	serverCreds, err := tlsconfig.DialOption(loopbackTLSConf(&conf.Server), conf.Server.GrpcAddr)
+	if err != nil {
+		log.Fatalf("create grpc tls config failed: %s", err.Error())
+	}
+
+	dialOpts := []grpc.DialOption{serverCreds, grpc.WithUnaryInterceptor(agentmetric.KafkaCommandClientInterceptor)}
+	if conf.Server.Auth.Enabled {
+		tokenCreds, err := grpcserver.NewLocalTokenCredentials(conf.Server.Auth)
+		if err != nil {
+			log.Fatalf("create grpc token credentials failed: %s", err.Error())
+		}
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(tokenCreds))
+	}
+
+	conn, err := grpc.Dial(conf.Server.GrpcAddr, dialOpts...)
 	if err != nil {
 		log.Fatalf("dial grpc server failed: %s", err.Error())
 	}
-	defer conn.Close()
 
-	kafkaproducer.Init(conf)
-	go dnsconsumer.Run(conn, conf)
-	go dhcpconsumer.Run(conn, conf)
-	s.Run()
+	ctx, stopConsumers := context.WithCancel(context.Background())
+	var consumers sync.WaitGroup
+	consumers.Add(2)
+	go func() {
+		defer consumers.Done()
+		dnsconsumer.Run(ctx, conn, conf)
+	}()
+	go func() {
+		defer consumers.Done()
+		dhcpconsumer.Run(ctx, conn, conf)
+	}()
+	go newReloader(conf, m, s).Run()
+	go func() {
+		if err := s.Run(); err != nil {
+			log.Fatalf("run grpc server failed: %s", err.Error())
+		}
+	}()
+
+	signal.WaitForInterrupt(nil)
+	exitCode := shutdown(stopConsumers, &consumers, m, s, backgroundSteps, conn, monitorConn)
+	log.CloseLogger()
+	os.Exit(exitCode)
+}
+
+func loopbackTLSConf(conf *config.ServerConf) config.TLSConf {
+	if conf.ClientTLS.Enabled || conf.TLS.Enabled == false {
+		return conf.ClientTLS
+	}
+
+	tlsConf := config.TLSConf{
+		Enabled:    true,
+		CAFile:     conf.TLS.CAFile,
+		ServerName: conf.TLS.ServerName,
+	}
+	if conf.TLS.ClientAuth {
+		tlsConf.CertFile = conf.TLS.CertFile
+		tlsConf.KeyFile = conf.TLS.KeyFile
+	}
+
+	return tlsConf
 }
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/zdnscloud/cement/log"
+
+	"github.com/linkingthing/ddi-agent/config"
+	dhcpconsumer "github.com/linkingthing/ddi-agent/pkg/dhcp/kafkaconsumer"
+	dnsconsumer "github.com/linkingthing/ddi-agent/pkg/dns/kafkaconsumer"
+	"github.com/linkingthing/ddi-agent/pkg/grpcserver"
+	"github.com/linkingthing/ddi-agent/pkg/kafkaproducer"
+	"github.com/linkingthing/ddi-agent/pkg/metric"
+)
+
+const (
+	FieldLogLevel    = "server.log_level"
+	FieldKafkaAddrs  = "kafka.kafka_addrs"
+	FieldMetricPort  = "metric.port"
+	FieldStatsAddr   = "dns.stats_addr"
+	FieldStatsFormat = "dns.stats_format"
+	FieldDNSGroupID  = "dns.group_id"
+	FieldDHCPCmdAddr = "dhcp.cmd_addr"
+	FieldDHCPGroupID = "dhcp.group_id"
+)
+
+var liveFields = []string{FieldLogLevel, FieldKafkaAddrs, FieldMetricPort, FieldStatsAddr,
+	FieldStatsFormat, FieldDNSGroupID, FieldDHCPCmdAddr, FieldDHCPGroupID}
+
+type reloader struct {
+	conf          atomic.Value
+	metricHandler *metric.MetricHandler
+	grpcServer    *grpcserver.GRPCServer
+}
+
+func newReloader(conf *config.AgentConfig, metricHandler *metric.MetricHandler, grpcServer *grpcserver.GRPCServer) *reloader {
+	current := *conf
+	r := &reloader{metricHandler: metricHandler, grpcServer: grpcServer}
+	r.conf.Store(&current)
+	return r
+}
+
+func (r *reloader) Config() *config.AgentConfig {
+	return r.conf.Load().(*config.AgentConfig)
+}
+
+func (r *reloader) Run() {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	for range ch {
+		log.Infof("receive SIGHUP, reload config file %s", r.Config().Path)
+		r.reload()
+	}
+}
+
+func (r *reloader) reload() {
+	current := r.Config()
+	newConf := *current
+	if err := newConf.Reload(); err != nil {
+		log.Errorf("reload config file %s failed: %s", current.Path, err.Error())
+		return
+	}
+
+	changes := make(map[string]bool)
+	for _, field := range diffConfig("", reflect.ValueOf(*current), reflect.ValueOf(newConf)) {
+		if isLiveField(field) {
+			changes[field] = true
+		} else {
+			log.Errorf("config %s changed but can not be applied without restart, ignored", field)
+		}
+	}
+
+	if len(changes) == 0 {
+		log.Infof("no config change can be applied live")
+		return
+	}
+
+	applied := *current
+	var failed []string
+	if changes[FieldLogLevel] {
+		if level, err := parseLogLevel(newConf.Server.LogLevel); err != nil {
+			log.Errorf("reload log level failed: %s", err.Error())
+			failed = append(failed, "log level")
+		} else {
+			log.InitLogger(level)
+			applied.Server.LogLevel = newConf.Server.LogLevel
+		}
+	}
+
+	if changes[FieldMetricPort] || changes[FieldStatsAddr] || changes[FieldStatsFormat] || changes[FieldDHCPCmdAddr] {
+		candidate := applied
+		candidate.Metric.Port = newConf.Metric.Port
+		candidate.DNS.StatsAddr = newConf.DNS.StatsAddr
+		candidate.DNS.StatsFormat = newConf.DNS.StatsFormat
+		candidate.DHCP.CmdAddr = newConf.DHCP.CmdAddr
+		if err := r.metricHandler.Reload(&candidate); err != nil {
+			log.Errorf("reload metric failed: %s", err.Error())
+			failed = append(failed, "metric")
+		} else if err := r.grpcServer.Reload(&candidate); err != nil {
+			log.Errorf("reload dhcp cmd address failed: %s", err.Error())
+			failed = append(failed, "dhcp cmd address")
+		} else {
+			applied = candidate
+		}
+	}
+
+	kafkaConf := applied
+	kafkaConf.Kafka.Addr = newConf.Kafka.Addr
+	kafkaConf.DNS.GroupID = newConf.DNS.GroupID
+	kafkaConf.DHCP.GroupID = newConf.DHCP.GroupID
+	kafkaApplied := true
+	if changes[FieldKafkaAddrs] {
+		if err := kafkaproducer.Reload(&kafkaConf); err != nil {
+			log.Errorf("reload kafka producer failed: %s", err.Error())
+			failed = append(failed, "kafka producer")
+			kafkaApplied = false
+		}
+	}
+	if changes[FieldKafkaAddrs] || changes[FieldDNSGroupID] {
+		if err := dnsconsumer.Reload(&kafkaConf); err != nil {
+			log.Errorf("reload dns kafka consumer failed: %s", err.Error())
+			failed = append(failed, "dns kafka consumer")
+			kafkaApplied = false
+		} else {
+			applied.DNS.GroupID = kafkaConf.DNS.GroupID
+		}
+	}
+	if changes[FieldKafkaAddrs] || changes[FieldDHCPGroupID] {
+		if err := dhcpconsumer.Reload(&kafkaConf); err != nil {
+			log.Errorf("reload dhcp kafka consumer failed: %s", err.Error())
+			failed = append(failed, "dhcp kafka consumer")
+			kafkaApplied = false
+		} else {
+			applied.DHCP.GroupID = kafkaConf.DHCP.GroupID
+		}
+	}
+	if changes[FieldKafkaAddrs] && kafkaApplied {
+		applied.Kafka.Addr = kafkaConf.Kafka.Addr
+	}
+
+	r.conf.Store(&applied)
+	if len(failed) != 0 {
+		log.Errorf("reload config file %s failed, %s not reloaded", applied.Path, strings.Join(failed, ", "))
+	} else {
+		log.Infof("reload config file %s succeed", applied.Path)
+	}
+}
+
+func isLiveField(field string) bool {
+	for _, liveField := range liveFields {
+		if field == liveField {
+			return true
+		}
+	}
+
+	return false
+}
+
+func diffConfig(prefix string, oldValue, newValue reflect.Value) []string {
+	if oldValue.Kind() != reflect.Struct {
+		if reflect.DeepEqual(oldValue.Interface(), newValue.Interface()) {
+			return nil
+		}
+		return []string{prefix}
+	}
+
+	var fields []string
+	for i := 0; i < oldValue.NumField(); i++ {
+		name := fieldName(oldValue.Type().Field(i))
+		if name == "" {
+			continue
+		}
+
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		fields = append(fields, diffConfig(name, oldValue.Field(i), newValue.Field(i))...)
+	}
+
+	return fields
+}
+
+func fieldName(field reflect.StructField) string {
+	for _, tag := range []string{"yaml", "json"} {
+		if name := strings.Split(field.Tag.Get(tag), ",")[0]; name == "-" {
+			return ""
+		} else if name != "" {
+			return name
+		}
+	}
+
+	return strings.ToLower(field.Name)
+}
+
+func parseLogLevel(level string) (log.LogLevel, error) {
+	switch log.LogLevel(strings.ToLower(level)) {
+	case "", log.Debug:
+		return log.Debug, nil
+	case log.Info:
+		return log.Info, nil
+	case log.Warn:
+		return log.Warn, nil
+	case log.Error:
+		return log.Error, nil
+	default:
+		return "", fmt.Errorf("unsupported log level %s", level)
+	}
+}
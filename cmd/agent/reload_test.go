@@ -0,0 +1,50 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zdnscloud/cement/log"
+	ut "github.com/zdnscloud/cement/unittest"
+
+	"github.com/linkingthing/ddi-agent/config"
+)
+
+func TestDiffConfig(t *testing.T) {
+	oldConf := config.AgentConfig{Path: "agent.conf"}
+	oldConf.Server.LogLevel = "info"
+	oldConf.Kafka.Addr = []string{"10.0.0.1:9092"}
+	oldConf.DB.Host = "127.0.0.1"
+
+	newConf := oldConf
+	newConf.Path = "other.conf"
+	newConf.Server.LogLevel = "debug"
+	newConf.Kafka.Addr = []string{"10.0.0.1:9092", "10.0.0.2:9092"}
+	newConf.DB.Host = "10.0.0.3"
+
+	fields := diffConfig("", reflect.ValueOf(oldConf), reflect.ValueOf(newConf))
+	ut.Equal(t, fields, []string{FieldLogLevel, FieldKafkaAddrs, "db.host"})
+	ut.Equal(t, isLiveField(FieldLogLevel), true)
+	ut.Equal(t, isLiveField("db.host"), false)
+	ut.Equal(t, len(diffConfig("", reflect.ValueOf(oldConf), reflect.ValueOf(oldConf))), 0)
+}
+
+func TestParseLogLevel(t *testing.T) {
+	for _, c := range []struct {
+		level    string
+		expected log.LogLevel
+	}{
+		{"", log.Debug},
+		{"DEBUG", log.Debug},
+		{"info", log.Info},
+		{"Warn", log.Warn},
+		{"error", log.Error},
+	} {
+		level, err := parseLogLevel(c.level)
+		ut.Assert(t, err == nil, "parse log level %s should succeed", c.level)
+		ut.Equal(t, level, c.expected)
+	}
+
+	_, err := parseLogLevel("verbose")
+	ut.Assert(t, err != nil, "parse unsupported log level should fail")
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zdnscloud/cement/log"
+	"google.golang.org/grpc"
+
+	"github.com/linkingthing/ddi-agent/pkg/audit"
+	"github.com/linkingthing/ddi-agent/pkg/db"
+	"github.com/linkingthing/ddi-agent/pkg/grpcserver"
+	"github.com/linkingthing/ddi-agent/pkg/kafkaproducer"
+	"github.com/linkingthing/ddi-agent/pkg/metric"
+)
+
+const ShutdownTimeout = 30 * time.Second
+
+type shutdownStep struct {
+	name string
+	stop func(context.Context) error
+}
+
+func shutdown(stopConsumers context.CancelFunc, consumers *sync.WaitGroup, metricHandler *metric.MetricHandler,
+	grpcServer *grpcserver.GRPCServer, backgroundSteps []shutdownStep, conns ...*grpc.ClientConn) int {
+	log.Infof("receive stop signal, shutdown agent")
+	ctx, cancel := context.WithTimeout(context.Background(), ShutdownTimeout)
+	defer cancel()
+
+	stopConsumers()
+	steps := []shutdownStep{
+		{"kafka consumers", func(ctx context.Context) error { return waitGroupWithContext(ctx, consumers) }},
+		{"grpc server", grpcServer.Stop},
+		{"metric server", metricHandler.Stop},
+	}
+	steps = append(steps, backgroundSteps...)
+	steps = append(steps, []shutdownStep{
+		{"kafka producer", func(context.Context) error { kafkaproducer.Close(); return nil }},
+		{"grpc client connections", func(context.Context) error { return closeConns(conns) }},
+		{"db", func(context.Context) error { db.Close(); return nil }},
+		{"audit log", func(context.Context) error { return audit.Close() }},
+	}...)
+
+	var failed bool
+	for _, step := range steps {
+		if err := step.stop(ctx); err != nil {
+			log.Errorf("stop %s failed: %s", step.name, err.Error())
+			failed = true
+		} else {
+			log.Infof("stop %s succeed", step.name)
+		}
+	}
+
+	if failed {
+		log.Errorf("agent stopped with errors")
+		return 1
+	}
+
+	log.Infof("agent stopped")
+	return 0
+}
+
+func waitGroupWithContext(ctx context.Context, wg *sync.WaitGroup) error {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("wait for in-flight commands failed: %s", ctx.Err().Error())
+	}
+}
+
+func stopWithContext(ctx context.Context, stop func()) error {
+	done := make(chan struct{})
+	go func() {
+		stop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("wait for stop failed: %s", ctx.Err().Error())
+	}
+}
+
+func closeConns(conns []*grpc.ClientConn) error {
+	var err error
+	for _, conn := range conns {
+		if closeErr := conn.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
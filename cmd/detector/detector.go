@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+
+	"github.com/zdnscloud/cement/log"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/dns/detector"
+	"github.com/linkingthing/ddi-agent/pkg/dns/querylog"
+)
+
+var (
+	configFile   string
+	queryLogFile string
+)
+
+type stdoutAlerter struct {
+	alerts int
+}
+
+func (a *stdoutAlerter) SendAlert(alert *detector.Alert) error {
+	data, err := json.Marshal(alert.ToProto(""))
+	if err != nil {
+		return err
+	}
+
+	a.alerts += 1
+	fmt.Println(string(data))
+	return nil
+}
+
+func main() {
+	flag.StringVar(&configFile, "c", "agent.conf", "configure file path")
+	flag.StringVar(&queryLogFile, "f", querylog.QueryLogName, "saved query log file path")
+	flag.Parse()
+
+	log.InitLogger(log.Info)
+	conf, err := config.LoadConfig(configFile)
+	if err != nil {
+		log.Fatalf("load config file failed: %s", err.Error())
+	}
+
+	alerter := &stdoutAlerter{}
+	d, err := detector.NewSync(conf.DNS.Detector, alerter)
+	if err != nil {
+		log.Fatalf("new dns detector failed: %s", err.Error())
+	}
+
+	if err := querylog.ReadFile(queryLogFile, d); err != nil {
+		log.Fatalf("detect query log failed: %s", err.Error())
+	}
+
+	log.Infof("detect query log %s finished with %d alerts", queryLogFile, alerter.alerts)
+}
@@ -5,34 +5,105 @@ import (
 )
 
 type AgentConfig struct {
-	Path            string         `yaml:"-"`
-	Server          ServerConf     `yaml:"server"`
-	DNS             DNSConf        `yaml:"dns"`
-	DHCP            DHCPConf       `yaml:"dhcp"`
-	Kafka           KafkaConf      `yaml:"kafka"`
-	Prometheus      PrometheusConf `yaml:"prometheus"`
-	Metric          MetricConf     `yaml:"metric"`
-	DB              DBConf         `yaml:"db"`
-	NginxDefaultDir string         `yaml:"nginx_default_dir"`
-	Monitor         MonitorConf    `yaml:"monitor"`
+	Path            string             `yaml:"-"`
+	Server          ServerConf         `yaml:"server"`
+	DNS             DNSConf            `yaml:"dns"`
+	DHCP            DHCPConf           `yaml:"dhcp"`
+	Kafka           KafkaConf          `yaml:"kafka"`
+	Prometheus      PrometheusConf     `yaml:"prometheus"`
+	Metric          MetricConf         `yaml:"metric"`
+	DB              DBConf             `yaml:"db"`
+	NginxDefaultDir string             `yaml:"nginx_default_dir"`
+	NginxCheckPath  string             `yaml:"nginx_check_path"`
+	NginxAccessLog  NginxAccessLogConf `yaml:"nginx_access_log"`
+	Monitor         MonitorConf        `yaml:"monitor"`
+	Audit           AuditConf          `yaml:"audit"`
+	ACME            ACMEConf           `yaml:"acme"`
 }
 
 type ServerConf struct {
-	IP       string `yaml:"ip"`
-	IPV6     string `yaml:"ipv6"`
-	Hostname string `yaml:"hostname"`
-	GrpcAddr string `yaml:"grpc_addr"`
+	IP        string   `yaml:"ip"`
+	IPV6      string   `yaml:"ipv6"`
+	Hostname  string   `yaml:"hostname"`
+	GrpcAddr  string   `yaml:"grpc_addr"`
+	LogLevel  string   `yaml:"log_level"`
+	TLS       TLSConf  `yaml:"tls"`
+	ClientTLS TLSConf  `yaml:"client_tls"`
+	Auth      AuthConf `yaml:"auth"`
+}
+
+type TLSConf struct {
+	Enabled    bool     `yaml:"enabled"`
+	CertFile   string   `yaml:"cert_file"`
+	KeyFile    string   `yaml:"key_file"`
+	CAFile     string   `yaml:"ca_file"`
+	ClientAuth bool     `yaml:"client_auth"`
+	ServerName string   `yaml:"server_name"`
+	PeerNames  []string `yaml:"peer_names"`
+}
+
+type AuthConf struct {
+	Enabled   bool         `yaml:"enabled"`
+	KeyFile   string       `yaml:"key_file"`
+	Issuer    string       `yaml:"issuer"`
+	Audience  string       `yaml:"audience"`
+	RoleClaim string       `yaml:"role_claim"`
+	LocalRole string       `yaml:"local_role"`
+	Policies  []AuthPolicy `yaml:"policies"`
+}
+
+type AuthPolicy struct {
+	Role    string   `yaml:"role"`
+	Methods []string `yaml:"methods"`
 }
 
 type DNSConf struct {
-	Enabled   bool   `yaml:"enabled"`
-	ConfDir   string `yaml:"conf_dir"`
-	DBDir     string `yaml:"db_dir"`
-	StatsAddr string `yaml:"stats_addr"`
-	GroupID   string `yaml:"group_id"`
-	ServerIp  string `yaml:"server_ip"`
-	Dbport    uint32 `yaml:"db_port"`
-	Dbhost    string `yaml:"db_host"`
+	Enabled     bool          `yaml:"enabled"`
+	ConfDir     string        `yaml:"conf_dir"`
+	DBDir       string        `yaml:"db_dir"`
+	StatsAddr   string        `yaml:"stats_addr"`
+	StatsFormat string        `yaml:"stats_format"`
+	GroupID     string        `yaml:"group_id"`
+	RndcPath    string        `yaml:"rndc_path"`
+	NamedUser   string        `yaml:"named_user"`
+	ServerIp    string        `yaml:"server_ip"`
+	Dbport      uint32        `yaml:"db_port"`
+	Dbhost      string        `yaml:"db_host"`
+	Analytics   AnalyticsConf `yaml:"analytics"`
+	Detector    DetectorConf  `yaml:"detector"`
+	Checker     CheckerConf   `yaml:"checker"`
+	Snapshot    SnapshotConf  `yaml:"snapshot"`
+	Prober      ProberConf    `yaml:"prober"`
+}
+
+type SnapshotConf struct {
+	Enabled     bool   `yaml:"enabled"`
+	Dir         string `yaml:"dir"`
+	MaxVersions uint32 `yaml:"max_versions"`
+}
+
+type ProberConf struct {
+	Enabled          bool   `yaml:"enabled"`
+	IntervalSeconds  uint32 `yaml:"interval_seconds"`
+	TimeoutSeconds   uint32 `yaml:"timeout_seconds"`
+	SampleRecords    uint32 `yaml:"sample_records"`
+	FailureThreshold uint32 `yaml:"failure_threshold"`
+}
+
+type CheckerConf struct {
+	CheckConfPath string `yaml:"checkconf_path"`
+	CheckZonePath string `yaml:"checkzone_path"`
+}
+
+type AnalyticsConf struct {
+	Enabled       bool   `yaml:"enabled"`
+	WindowSeconds uint32 `yaml:"window_seconds"`
+	TopN          uint32 `yaml:"top_n"`
+}
+
+type NginxAccessLogConf struct {
+	Enabled bool   `yaml:"enabled"`
+	Path    string `yaml:"path"`
 }
 
 type DHCPConf struct {
@@ -42,9 +113,40 @@ type DHCPConf struct {
 	GroupID   string `yaml:"group_id"`
 }
 
+type DetectorConf struct {
+	Enabled              bool     `yaml:"enabled"`
+	WindowSeconds        uint32   `yaml:"window_seconds"`
+	MaxLabelLength       uint32   `yaml:"max_label_length"`
+	MaxEntropy           float64  `yaml:"max_entropy"`
+	MaxUniqueSubdomains  uint32   `yaml:"max_unique_subdomains"`
+	MaxTxtNullQueries    uint32   `yaml:"max_txt_null_queries"`
+	MinDGAScore          uint32   `yaml:"min_dga_score"`
+	ClientAlertThreshold uint32   `yaml:"client_alert_threshold"`
+	AllowDomains         []string `yaml:"allow_domains"`
+	AllowClients         []string `yaml:"allow_clients"`
+}
+
 type KafkaConf struct {
-	Addr  []string `yaml:"kafka_addrs"`
-	Topic string   `yaml:"topic"`
+	Addr     []string        `yaml:"kafka_addrs"`
+	ClientID string          `yaml:"client_id"`
+	Topics   KafkaTopicsConf `yaml:"topics"`
+	SASL     KafkaSASLConf   `yaml:"sasl"`
+	TLS      TLSConf         `yaml:"tls"`
+}
+
+type KafkaTopicsConf struct {
+	DNS         string `yaml:"dns"`
+	DHCP        string `yaml:"dhcp"`
+	AgentEvent  string `yaml:"agent_event"`
+	UploadLog   string `yaml:"upload_log"`
+	DNSSecurity string `yaml:"dns_security"`
+}
+
+type KafkaSASLConf struct {
+	Enabled   bool   `yaml:"enabled"`
+	Mechanism string `yaml:"mechanism"`
+	Username  string `yaml:"username"`
+	Password  string `yaml:"password"`
 }
 
 type PrometheusConf struct {
@@ -57,7 +159,26 @@ type MetricConf struct {
 }
 
 type MonitorConf struct {
-	GrpcAddr string `yaml:"grpc_addr"`
+	GrpcAddr string  `yaml:"grpc_addr"`
+	TLS      TLSConf `yaml:"tls"`
+}
+
+type AuditConf struct {
+	Enabled   bool   `yaml:"enabled"`
+	Dir       string `yaml:"dir"`
+	MaxSizeMB uint32 `yaml:"max_size_mb"`
+	MaxFiles  uint32 `yaml:"max_files"`
+}
+
+type ACMEConf struct {
+	Enabled         bool   `yaml:"enabled"`
+	DirectoryURL    string `yaml:"directory_url"`
+	Email           string `yaml:"email"`
+	AccountKeyFile  string `yaml:"account_key_file"`
+	CAFile          string `yaml:"ca_file"`
+	Webroot         string `yaml:"webroot"`
+	RenewBeforeDays uint32 `yaml:"renew_before_days"`
+	CheckInterval   uint32 `yaml:"check_interval"`
 }
 
 type DBConf struct {
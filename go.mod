@@ -1,6 +1,6 @@
 module github.com/linkingthing/ddi-agent
 
-go 1.14
+go 1.15
 
 require (
 	github.com/golang/protobuf v1.4.2
@@ -12,6 +12,7 @@ require (
 	github.com/zdnscloud/cement v0.0.0-20200612070849-67372f989797
 	github.com/zdnscloud/g53 v0.0.0-20200610043040-c71a4decb734
 	github.com/zdnscloud/gorest v0.0.0-20200909072941-55569cb2f203
+	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
 	google.golang.org/grpc v1.32.0
 	google.golang.org/protobuf v1.25.0
 )
@@ -0,0 +1,306 @@
+package acmeclient
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/acme"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/fileutil"
+)
+
+const (
+	DefaultRenewBeforeDays = 30
+	DefaultCheckInterval   = 12 * time.Hour
+	KeyFilePermissions     = 0600
+	dirPermissions         = 0755
+	pemTypeECPrivateKey    = "EC PRIVATE KEY"
+	pemTypeCertificate     = "CERTIFICATE"
+)
+
+type Certificate struct {
+	Key      []byte
+	Crt      []byte
+	NotAfter time.Time
+}
+
+type Client struct {
+	client     *acme.Client
+	email      string
+	webroot    string
+	lock       sync.Mutex
+	registered bool
+}
+
+func New(conf *config.ACMEConf) (*Client, error) {
+	if conf.DirectoryURL == "" {
+		return nil, fmt.Errorf("acme directory url is empty")
+	}
+
+	if conf.Webroot == "" {
+		return nil, fmt.Errorf("acme webroot is empty")
+	}
+
+	key, err := loadOrCreateAccountKey(conf.AccountKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := newHTTPClient(conf.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		client: &acme.Client{
+			Key:          key,
+			DirectoryURL: conf.DirectoryURL,
+			HTTPClient:   httpClient,
+		},
+		email:   conf.Email,
+		webroot: conf.Webroot,
+	}, nil
+}
+
+func RenewBefore(conf *config.ACMEConf) time.Duration {
+	days := conf.RenewBeforeDays
+	if days == 0 {
+		days = DefaultRenewBeforeDays
+	}
+
+	return time.Duration(days) * 24 * time.Hour
+}
+
+func CheckInterval(conf *config.ACMEConf) time.Duration {
+	if conf.CheckInterval == 0 {
+		return DefaultCheckInterval
+	}
+
+	return time.Duration(conf.CheckInterval) * time.Second
+}
+
+func (c *Client) Obtain(ctx context.Context, domain string) (*Certificate, error) {
+	if err := c.register(ctx); err != nil {
+		return nil, err
+	}
+
+	order, err := c.client.AuthorizeOrder(ctx, acme.DomainIDs(domain))
+	if err != nil {
+		return nil, fmt.Errorf("create acme order for %s failed: %s", domain, err.Error())
+	}
+
+	for _, authzURL := range order.AuthzURLs {
+		if err := c.authorize(ctx, authzURL); err != nil {
+			return nil, fmt.Errorf("authorize %s failed: %s", domain, err.Error())
+		}
+	}
+
+	if order, err = c.client.WaitOrder(ctx, order.URI); err != nil {
+		return nil, fmt.Errorf("wait acme order for %s failed: %s", domain, err.Error())
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate key for %s failed: %s", domain, err.Error())
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: domain},
+		DNSNames: []string{domain},
+	}, key)
+	if err != nil {
+		return nil, fmt.Errorf("create csr for %s failed: %s", domain, err.Error())
+	}
+
+	ders, _, err := c.client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, fmt.Errorf("finalize acme order for %s failed: %s", domain, err.Error())
+	}
+
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var crtPEM []byte
+	for _, der := range ders {
+		crtPEM = append(crtPEM, pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: der})...)
+	}
+
+	notAfter, err := CertExpiry(crtPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Certificate{Key: keyPEM, Crt: crtPEM, NotAfter: notAfter}, nil
+}
+
+func (c *Client) register(ctx context.Context) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.registered {
+		return nil
+	}
+
+	account := &acme.Account{}
+	if c.email != "" {
+		account.Contact = []string{"mailto:" + c.email}
+	}
+
+	if _, err := c.client.Register(ctx, account, acme.AcceptTOS); err != nil && err != acme.ErrAccountAlreadyExists {
+		return fmt.Errorf("register acme account failed: %s", err.Error())
+	}
+
+	c.registered = true
+	return nil
+}
+
+func (c *Client) authorize(ctx context.Context, authzURL string) error {
+	authz, err := c.client.GetAuthorization(ctx, authzURL)
+	if err != nil {
+		return err
+	}
+
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	var challenge *acme.Challenge
+	for _, ch := range authz.Challenges {
+		if ch.Type == "http-01" {
+			challenge = ch
+			break
+		}
+	}
+
+	if challenge == nil {
+		return fmt.Errorf("no http-01 challenge offered")
+	}
+
+	response, err := c.client.HTTP01ChallengeResponse(challenge.Token)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(c.webroot, filepath.FromSlash(c.client.HTTP01ChallengePath(challenge.Token)))
+	if err := os.MkdirAll(filepath.Dir(path), dirPermissions); err != nil {
+		return fmt.Errorf("create challenge dir failed: %s", err.Error())
+	}
+
+	if err := fileutil.WriteFileAtomic(path, []byte(response), 0644); err != nil {
+		return err
+	}
+	defer os.Remove(path)
+
+	if _, err := c.client.Accept(ctx, challenge); err != nil {
+		return fmt.Errorf("accept challenge failed: %s", err.Error())
+	}
+
+	_, err = c.client.WaitAuthorization(ctx, authz.URI)
+	return err
+}
+
+func CertExpiry(crt []byte) (time.Time, error) {
+	block, _ := pem.Decode(crt)
+	if block == nil || block.Type != pemTypeCertificate {
+		return time.Time{}, fmt.Errorf("no certificate found in pem data")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse certificate failed: %s", err.Error())
+	}
+
+	return cert.NotAfter, nil
+}
+
+func loadOrCreateAccountKey(path string) (crypto.Signer, error) {
+	if path == "" {
+		return nil, fmt.Errorf("acme account key file is empty")
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err == nil {
+		block, _ := pem.Decode(data)
+		if block == nil || block.Type != pemTypeECPrivateKey {
+			return nil, fmt.Errorf("invalid acme account key file %s", path)
+		}
+
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse acme account key %s failed: %s", path, err.Error())
+		}
+		return key, nil
+	} else if os.IsNotExist(err) == false {
+		return nil, fmt.Errorf("read acme account key %s failed: %s", path, err.Error())
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate acme account key failed: %s", err.Error())
+	}
+
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), dirPermissions); err != nil {
+		return nil, fmt.Errorf("create dir for %s failed: %s", path, err.Error())
+	}
+
+	if err := fileutil.WriteFileAtomic(path, keyPEM, KeyFilePermissions); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func encodeKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("marshal private key failed: %s", err.Error())
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypeECPrivateKey, Bytes: der}), nil
+}
+
+func newHTTPClient(caFile string) (*http.Client, error) {
+	if caFile == "" {
+		return http.DefaultClient, nil
+	}
+
+	data, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("read acme ca file %s failed: %s", caFile, err.Error())
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	if pool.AppendCertsFromPEM(data) == false {
+		return nil, fmt.Errorf("no certificate found in acme ca file %s", caFile)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	return &http.Client{Transport: transport}, nil
+}
@@ -0,0 +1,58 @@
+package acmeclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+func TestCertExpiry(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ut.Assert(t, err == nil, "generate key should succeed")
+
+	notAfter := time.Now().Add(90 * 24 * time.Hour).Truncate(time.Second).UTC()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "www.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	ut.Assert(t, err == nil, "create certificate should succeed")
+
+	expiry, err := CertExpiry(pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: der}))
+	ut.Assert(t, err == nil, "parse certificate should succeed")
+	ut.Assert(t, expiry.Equal(notAfter), "expiry should be certificate not after")
+
+	_, err = CertExpiry([]byte("invalid"))
+	ut.Assert(t, err != nil, "invalid pem should fail")
+}
+
+func TestLoadOrCreateAccountKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "acme-test")
+	ut.Assert(t, err == nil, "create temp dir should succeed")
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "acme", "account.key")
+	created, err := loadOrCreateAccountKey(path)
+	ut.Assert(t, err == nil, "create account key should succeed")
+
+	info, err := os.Stat(path)
+	ut.Assert(t, err == nil, "account key should be saved")
+	ut.Equal(t, info.Mode().Perm(), os.FileMode(KeyFilePermissions))
+
+	loaded, err := loadOrCreateAccountKey(path)
+	ut.Assert(t, err == nil, "load account key should succeed")
+	ut.Assert(t, created.(*ecdsa.PrivateKey).D.Cmp(loaded.(*ecdsa.PrivateKey).D) == 0, "loaded key should match created key")
+}
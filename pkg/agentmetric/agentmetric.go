@@ -0,0 +1,135 @@
+package agentmetric
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	MetricLabelTopic    = "topic"
+	MetricLabelKey      = "key"
+	MetricLabelOutcome  = "outcome"
+	MetricLabelMethod   = "method"
+	MetricLabelTemplate = "template"
+	MetricLabelService  = "service"
+	MetricLabelReason   = "reason"
+	MetricLabelDomain   = "domain"
+
+	MetricNameKafkaConsumerLag       = "lx_agent_kafka_consumer_lag"
+	MetricNameKafkaMessagesReceived  = "lx_agent_kafka_messages_received_total"
+	MetricNameKafkaMessagesProcessed = "lx_agent_kafka_messages_processed_total"
+	MetricNameGRPCHandlerDuration    = "lx_agent_grpc_handler_duration_seconds"
+	MetricNameMonitorCalls           = "lx_agent_monitor_calls_total"
+	MetricNameMonitorCallErrors      = "lx_agent_monitor_call_errors_total"
+	MetricNameDBTransactionDuration  = "lx_agent_db_transaction_duration_seconds"
+	MetricNameTemplateRenderDuration = "lx_agent_template_render_duration_seconds"
+	MetricNameFileWriteDuration      = "lx_agent_file_write_duration_seconds"
+	MetricNameServiceRestarts        = "lx_agent_service_restarts_total"
+	MetricNameGRPCAuthDenied         = "lx_agent_grpc_auth_denied_total"
+	MetricNameCertificateExpiry      = "lx_agent_nginx_certificate_expiry_timestamp_seconds"
+
+	OutcomeSucceed  = "succeed"
+	OutcomeFailed   = "failed"
+	OutcomeCommit   = "commit"
+	OutcomeRollback = "rollback"
+
+	ServiceDNS  = "dns"
+	ServiceDHCP = "dhcp"
+)
+
+var (
+	KafkaMessagesReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: MetricNameKafkaMessagesReceived,
+		Help: "kafka messages received per topic,key",
+	}, []string{MetricLabelTopic, MetricLabelKey})
+	KafkaMessagesProcessed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: MetricNameKafkaMessagesProcessed,
+		Help: "kafka messages processed per topic,key,outcome",
+	}, []string{MetricLabelTopic, MetricLabelKey, MetricLabelOutcome})
+	GRPCHandlerDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    MetricNameGRPCHandlerDuration,
+		Help:    "grpc handler latency per method,outcome",
+		Buckets: prometheus.DefBuckets,
+	}, []string{MetricLabelMethod, MetricLabelOutcome})
+	MonitorCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: MetricNameMonitorCalls,
+		Help: "ddi monitor calls per method",
+	}, []string{MetricLabelMethod})
+	MonitorCallErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: MetricNameMonitorCallErrors,
+		Help: "ddi monitor call errors per method",
+	}, []string{MetricLabelMethod})
+	DBTransactionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    MetricNameDBTransactionDuration,
+		Help:    "db transaction duration per outcome",
+		Buckets: prometheus.DefBuckets,
+	}, []string{MetricLabelOutcome})
+	TemplateRenderDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    MetricNameTemplateRenderDuration,
+		Help:    "template render duration per template",
+		Buckets: prometheus.DefBuckets,
+	}, []string{MetricLabelTemplate})
+	FileWriteDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    MetricNameFileWriteDuration,
+		Help:    "config file write duration per template",
+		Buckets: prometheus.DefBuckets,
+	}, []string{MetricLabelTemplate})
+	ServiceRestarts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: MetricNameServiceRestarts,
+		Help: "service restarts triggered by agent per service",
+	}, []string{MetricLabelService})
+	GRPCAuthDenied = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: MetricNameGRPCAuthDenied,
+		Help: "grpc calls denied by authentication or authorization per method,reason",
+	}, []string{MetricLabelMethod, MetricLabelReason})
+	CertificateExpiry = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: MetricNameCertificateExpiry,
+		Help: "nginx https proxy certificate expiry unix timestamp per domain",
+	}, []string{MetricLabelDomain})
+)
+
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		kafkaLag,
+		KafkaMessagesReceived,
+		KafkaMessagesProcessed,
+		GRPCHandlerDuration,
+		MonitorCalls,
+		MonitorCallErrors,
+		DBTransactionDuration,
+		TemplateRenderDuration,
+		FileWriteDuration,
+		ServiceRestarts,
+		GRPCAuthDenied,
+		CertificateExpiry,
+	}
+}
+
+func ObserveTemplateRender(template string, start time.Time) {
+	TemplateRenderDuration.WithLabelValues(template).Observe(time.Since(start).Seconds())
+}
+
+func ObserveFileWrite(template string, start time.Time) {
+	FileWriteDuration.WithLabelValues(template).Observe(time.Since(start).Seconds())
+}
+
+func IncServiceRestart(service string) {
+	ServiceRestarts.WithLabelValues(service).Inc()
+}
+
+func SetCertificateExpiry(domain string, notAfter time.Time) {
+	CertificateExpiry.WithLabelValues(domain).Set(float64(notAfter.Unix()))
+}
+
+func DeleteCertificateExpiry(domain string) {
+	CertificateExpiry.DeleteLabelValues(domain)
+}
+
+func getOutcome(err error) string {
+	if err != nil {
+		return OutcomeFailed
+	}
+
+	return OutcomeSucceed
+}
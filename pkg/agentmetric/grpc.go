@@ -0,0 +1,26 @@
+package agentmetric
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+func GRPCServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	GRPCHandlerDuration.WithLabelValues(info.FullMethod, getOutcome(err)).Observe(time.Since(start).Seconds())
+	return resp, err
+}
+
+func MonitorClientInterceptor(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	MonitorCalls.WithLabelValues(method).Inc()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if err != nil {
+		MonitorCallErrors.WithLabelValues(method).Inc()
+	}
+	return err
+}
@@ -0,0 +1,68 @@
+package agentmetric
+
+import (
+	"context"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	kg "github.com/segmentio/kafka-go"
+	"google.golang.org/grpc"
+)
+
+var kafkaLagDesc = prometheus.NewDesc(MetricNameKafkaConsumerLag, "kafka consumer lag per topic",
+	[]string{MetricLabelTopic}, nil)
+
+type kafkaLagCollector struct {
+	lock    sync.RWMutex
+	readers map[string]*kg.Reader
+}
+
+var kafkaLag = &kafkaLagCollector{readers: make(map[string]*kg.Reader)}
+
+func RegisterKafkaReader(reader *kg.Reader) {
+	kafkaLag.lock.Lock()
+	kafkaLag.readers[reader.Config().Topic] = reader
+	kafkaLag.lock.Unlock()
+}
+
+func UnregisterKafkaReader(reader *kg.Reader) {
+	kafkaLag.lock.Lock()
+	defer kafkaLag.lock.Unlock()
+	topic := reader.Config().Topic
+	if kafkaLag.readers[topic] == reader {
+		delete(kafkaLag.readers, topic)
+	}
+}
+
+func (c *kafkaLagCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- kafkaLagDesc
+}
+
+func (c *kafkaLagCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	for topic, reader := range c.readers {
+		ch <- prometheus.MustNewConstMetric(kafkaLagDesc, prometheus.GaugeValue, float64(reader.Stats().Lag), topic)
+	}
+}
+
+type kafkaMessageKey struct{}
+
+type kafkaMessage struct {
+	topic string
+	key   string
+}
+
+func NewKafkaMessageContext(ctx context.Context, topic, key string) context.Context {
+	KafkaMessagesReceived.WithLabelValues(topic, key).Inc()
+	return context.WithValue(ctx, kafkaMessageKey{}, kafkaMessage{topic: topic, key: key})
+}
+
+func KafkaCommandClientInterceptor(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if message, ok := ctx.Value(kafkaMessageKey{}).(kafkaMessage); ok {
+		KafkaMessagesProcessed.WithLabelValues(message.topic, message.key, getOutcome(err)).Inc()
+	}
+	return err
+}
@@ -0,0 +1,31 @@
+package agentmetric
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	kg "github.com/segmentio/kafka-go"
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+func TestKafkaLagCollector(t *testing.T) {
+	newReader := func() *kg.Reader {
+		return kg.NewReader(kg.ReaderConfig{
+			Brokers: []string{"127.0.0.1:1"},
+			Topic:   "ddi-dns",
+			GroupID: "ddi-agent",
+		})
+	}
+
+	oldReader, reader := newReader(), newReader()
+	defer oldReader.Close()
+	defer reader.Close()
+
+	RegisterKafkaReader(oldReader)
+	RegisterKafkaReader(reader)
+	UnregisterKafkaReader(oldReader)
+	ut.Equal(t, testutil.ToFloat64(kafkaLag), float64(0))
+
+	UnregisterKafkaReader(reader)
+	ut.Equal(t, testutil.CollectAndCount(kafkaLag), 0)
+}
@@ -0,0 +1,144 @@
+package audit
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/zdnscloud/cement/log"
+
+	"github.com/linkingthing/ddi-agent/config"
+)
+
+const (
+	SourceKafka = "kafka"
+	SourceGRPC  = "grpc"
+
+	ActionInsert = "insert"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+	ActionExec   = "exec"
+
+	DefaultDir       = "/var/lib/ddi-agent/audit"
+	DefaultMaxSizeMB = 100
+	DefaultMaxFiles  = 10
+)
+
+type Entry struct {
+	ID            string          `json:"id"`
+	Time          time.Time       `json:"time"`
+	Source        string          `json:"source"`
+	ClaimedSource string          `json:"claimed_source,omitempty"`
+	Command       string          `json:"command"`
+	Method        string          `json:"method"`
+	Caller        string          `json:"caller,omitempty"`
+	Peer          string          `json:"peer,omitempty"`
+	Resource      string          `json:"resource,omitempty"`
+	ResourceID    string          `json:"resource_id,omitempty"`
+	Request       json.RawMessage `json:"request,omitempty"`
+	Changes       []Change        `json:"changes,omitempty"`
+	Files         []FileChange    `json:"files,omitempty"`
+	Succeed       bool            `json:"succeed"`
+	Denied        bool            `json:"denied,omitempty"`
+	Error         string          `json:"error,omitempty"`
+	lock          sync.Mutex
+}
+
+type Change struct {
+	Table      string                 `json:"table"`
+	Action     string                 `json:"action"`
+	Conditions map[string]interface{} `json:"conditions,omitempty"`
+	Before     interface{}            `json:"before,omitempty"`
+	After      interface{}            `json:"after,omitempty"`
+}
+
+type FileChange struct {
+	Path   string `json:"path"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+type Filter struct {
+	Start    time.Time
+	End      time.Time
+	Resource string
+	Command  string
+	Limit    int
+}
+
+func (f *Filter) match(entry *Entry) bool {
+	if f.Start.IsZero() == false && entry.Time.Before(f.Start) {
+		return false
+	}
+
+	if f.End.IsZero() == false && entry.Time.Before(f.End) == false {
+		return false
+	}
+
+	if f.Resource != "" && f.Resource != entry.Resource && f.Resource != entry.ResourceID {
+		return false
+	}
+
+	return f.Command == "" || f.Command == entry.Command
+}
+
+var globalStore *fileStore
+
+func Init(conf *config.AgentConfig) error {
+	if conf.Audit.Enabled == false {
+		return nil
+	}
+
+	dir := conf.Audit.Dir
+	if dir == "" {
+		dir = DefaultDir
+	}
+
+	maxSizeMB := conf.Audit.MaxSizeMB
+	if maxSizeMB == 0 {
+		maxSizeMB = DefaultMaxSizeMB
+	}
+
+	maxFiles := conf.Audit.MaxFiles
+	if maxFiles == 0 {
+		maxFiles = DefaultMaxFiles
+	}
+
+	store, err := newFileStore(dir, int64(maxSizeMB)*1024*1024, int(maxFiles))
+	if err != nil {
+		return err
+	}
+
+	globalStore = store
+	return nil
+}
+
+func IsEnabled() bool {
+	return globalStore != nil
+}
+
+func Close() error {
+	if globalStore != nil {
+		return globalStore.close()
+	}
+
+	return nil
+}
+
+func Record(entry *Entry) {
+	if globalStore == nil {
+		return
+	}
+
+	if err := globalStore.append(entry); err != nil {
+		log.Errorf("record audit entry %s %s failed: %s", entry.Command, entry.ResourceID, err.Error())
+	}
+}
+
+func Query(filter *Filter) ([]*Entry, error) {
+	if globalStore == nil {
+		return nil, nil
+	}
+
+	return globalStore.query(filter)
+}
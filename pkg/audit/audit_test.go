@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ut "github.com/zdnscloud/cement/unittest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+func TestFileStoreRotateAndQuery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	ut.Assert(t, err == nil, "create temp dir failed")
+	defer os.RemoveAll(dir)
+
+	store, err := newFileStore(dir, 512, 2)
+	ut.Assert(t, err == nil, "new file store failed")
+	defer store.close()
+
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		ut.Assert(t, store.append(&Entry{
+			ID:       fmt.Sprintf("%d", i),
+			Time:     now.Add(time.Duration(i) * time.Second),
+			Command:  "create_view",
+			Resource: "view",
+			Succeed:  true,
+		}) == nil, "append entry failed")
+	}
+
+	rotated, err := store.rotatedFiles()
+	ut.Assert(t, err == nil, "list rotated files failed")
+	ut.Equal(t, len(rotated), 2)
+
+	entries, err := store.query(&Filter{Resource: "view", Limit: 2})
+	ut.Assert(t, err == nil, "query failed")
+	ut.Equal(t, len(entries), 2)
+	ut.Equal(t, entries[0].ID, "9")
+	ut.Equal(t, entries[1].ID, "8")
+
+	entries, _ = store.query(&Filter{Start: now.Add(8 * time.Second)})
+	ut.Equal(t, len(entries), 2)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	ut.Assert(t, err == nil, "create temp dir failed")
+	defer os.RemoveAll(dir)
+
+	store, err := newFileStore(dir, 1024*1024, 1)
+	ut.Assert(t, err == nil, "new file store failed")
+	globalStore = store
+	defer func() {
+		store.close()
+		globalStore = nil
+	}()
+
+	file := filepath.Join(dir, "named.conf")
+	ctx := WithCaller(metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(MetadataSource, SourceKafka, MetadataCommand, "upload_dnslog")), LocalCaller)
+	_, err = UnaryServerInterceptor(ctx, &pb.UploadLogReq{Id: "log1", Password: "secret"},
+		&grpc.UnaryServerInfo{FullMethod: "/proto.AgentManager/UploadLog"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			entry := FromContext(ctx)
+			ut.Assert(t, entry != nil, "handler context should carry audit entry")
+			entry.BeforeFileWrite(file)
+			ioutil.WriteFile(file, []byte("options {};"), 0644)
+			entry.AfterFileWrite(file)
+			return nil, nil
+		})
+	ut.Assert(t, err == nil, "interceptor failed")
+	ut.Assert(t, FromContext(ctx) == nil, "caller context should not carry audit entry")
+
+	entries, err := Query(&Filter{Resource: "log1"})
+	ut.Assert(t, err == nil, "query failed")
+	ut.Equal(t, len(entries), 1)
+	ut.Equal(t, entries[0].Source, SourceKafka)
+	ut.Equal(t, entries[0].ClaimedSource, SourceKafka)
+	ut.Equal(t, entries[0].Command, "upload_dnslog")
+	ut.Equal(t, entries[0].Resource, "dnslog")
+	ut.Equal(t, entries[0].Succeed, true)
+	ut.Equal(t, len(entries[0].Files), 1)
+	ut.Equal(t, entries[0].Files[0].Before, "")
+	ut.Equal(t, entries[0].Files[0].After, hashFile(file))
+	ut.Assert(t, string(entries[0].Request) == `{"id":"log1","password":"******"}`, "password should be redacted: %s", entries[0].Request)
+	ut.Equal(t, methodToCommand("CreateAuthZone"), "create_authzone")
+
+	claimed := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataSource, SourceKafka))
+	for _, c := range []struct {
+		ctx    context.Context
+		source string
+	}{
+		{claimed, SourceGRPC},
+		{WithCaller(claimed, "controller"), SourceGRPC},
+		{peer.NewContext(claimed, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 58888}}), SourceGRPC},
+		{peer.NewContext(claimed, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 58888}}), SourceKafka},
+	} {
+		entry := newEntry(c.ctx, "/proto.AgentManager/UploadLog", nil)
+		ut.Equal(t, entry.Source, c.source)
+		ut.Equal(t, entry.ClaimedSource, SourceKafka)
+	}
+}
+
+func TestIsReadOnlyMethod(t *testing.T) {
+	for _, c := range []struct {
+		method   string
+		readOnly bool
+	}{
+		{"/proto.AgentManager/GetDNSCacheStats", true},
+		{"/proto.AgentManager/QueryAuditLog", true},
+		{"/proto.AgentManager/DiagnoseQuery", true},
+		{"/proto.AgentManager/DumpDNSCache", true},
+		{"/proto.AgentManager/CreateView", false},
+		{"/proto.AgentManager/FlushDNSCache", false},
+	} {
+		ut.Equal(t, IsReadOnlyMethod(c.method), c.readOnly)
+	}
+}
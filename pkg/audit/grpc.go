@@ -0,0 +1,233 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/zdnscloud/cement/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	MetadataSource  = "x-ddi-source"
+	MetadataCommand = "x-ddi-command"
+	LocalCaller     = "ddi-agent"
+	RedactedValue   = "******"
+)
+
+var readOnlyMethodPrefixes = []string{"Get", "Query", "List", "Diagnose", "Dump"}
+
+var sensitiveFields = []string{"password", "secret", "key", "token"}
+
+type callerKey struct{}
+
+func WithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+func NewKafkaContext(ctx context.Context, command string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataSource, SourceKafka, MetadataCommand, command)
+}
+
+func IsReadOnlyMethod(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if IsEnabled() == false || IsReadOnlyMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	entry := newEntry(ctx, info.FullMethod, req)
+	resp, err := handler(NewContext(ctx, entry), req)
+
+	entry.Succeed = err == nil
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	Record(entry)
+	return resp, err
+}
+
+func RecordDenied(ctx context.Context, fullMethod string, req interface{}, caller string, err error) {
+	if IsEnabled() == false {
+		return
+	}
+
+	entry := newEntry(ctx, fullMethod, req)
+	if caller != "" {
+		entry.Caller = caller
+	}
+	entry.Denied = true
+	entry.Error = err.Error()
+	Record(entry)
+}
+
+func newEntry(ctx context.Context, fullMethod string, req interface{}) *Entry {
+	id, _ := uuid.Gen()
+	entry := &Entry{
+		ID:     id,
+		Time:   time.Now(),
+		Source: SourceGRPC,
+		Method: fullMethod,
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(MetadataSource); len(values) != 0 {
+			entry.ClaimedSource = values[0]
+		}
+		if values := md.Get(MetadataCommand); len(values) != 0 {
+			entry.Command = values[0]
+		}
+	}
+
+	if entry.Command == "" {
+		entry.Command = methodToCommand(fullMethod[strings.LastIndex(fullMethod, "/")+1:])
+	}
+
+	if i := strings.Index(entry.Command, "_"); i != -1 {
+		entry.Resource = entry.Command[i+1:]
+	}
+
+	if caller, ok := ctx.Value(callerKey{}).(string); ok {
+		entry.Caller = caller
+		if caller == LocalCaller {
+			entry.Source = SourceKafka
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if p.Addr != nil {
+			entry.Peer = p.Addr.String()
+			if entry.Caller == "" && isLoopbackAddr(p.Addr) {
+				entry.Source = SourceKafka
+			}
+		}
+
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && entry.Caller == "" &&
+			len(tlsInfo.State.VerifiedChains) != 0 && len(tlsInfo.State.VerifiedChains[0]) != 0 {
+			entry.Caller = tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+		}
+	}
+
+	if req != nil {
+		entry.Request, entry.ResourceID = marshalRequest(req)
+	}
+
+	return entry
+}
+
+func isLoopbackAddr(addr net.Addr) bool {
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return false
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func marshalRequest(req interface{}) (json.RawMessage, string) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, ""
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return data, ""
+	}
+
+	redact(fields)
+	resourceID := getResourceID(fields)
+	if redacted, err := json.Marshal(fields); err == nil {
+		data = redacted
+	}
+
+	return data, resourceID
+}
+
+func redact(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, field := range v {
+			if isSensitiveField(name) {
+				v[name] = RedactedValue
+			} else {
+				redact(field)
+			}
+		}
+	case []interface{}:
+		for _, field := range v {
+			redact(field)
+		}
+	}
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	for _, sensitive := range sensitiveFields {
+		if name == sensitive || strings.HasSuffix(name, "_"+sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getResourceID(fields map[string]interface{}) string {
+	if id := getIDField(fields); id != "" {
+		return id
+	}
+
+	for _, field := range fields {
+		if child, ok := field.(map[string]interface{}); ok {
+			if id := getIDField(child); id != "" {
+				return id
+			}
+		}
+	}
+
+	return ""
+}
+
+func getIDField(fields map[string]interface{}) string {
+	for _, name := range []string{"id", "name"} {
+		switch v := fields[name].(type) {
+		case string:
+			if v != "" {
+				return v
+			}
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	}
+
+	return ""
+}
+
+func methodToCommand(name string) string {
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			return strings.ToLower(name[:i]) + "_" + strings.ToLower(name[i:])
+		}
+	}
+
+	return strings.ToLower(name)
+}
@@ -0,0 +1,78 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+)
+
+type entryKey struct{}
+
+func NewContext(ctx context.Context, entry *Entry) context.Context {
+	return context.WithValue(ctx, entryKey{}, entry)
+}
+
+func FromContext(ctx context.Context) *Entry {
+	if ctx == nil {
+		return nil
+	}
+
+	entry, _ := ctx.Value(entryKey{}).(*Entry)
+	return entry
+}
+
+func (e *Entry) RecordChanges(changes []Change) {
+	if e == nil {
+		return
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.Changes = append(e.Changes, changes...)
+}
+
+func (e *Entry) BeforeFileWrite(path string) {
+	if e == nil {
+		return
+	}
+
+	hash := hashFile(path)
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for _, file := range e.Files {
+		if file.Path == path {
+			return
+		}
+	}
+
+	e.Files = append(e.Files, FileChange{Path: path, Before: hash})
+}
+
+func (e *Entry) AfterFileWrite(path string) {
+	if e == nil {
+		return
+	}
+
+	hash := hashFile(path)
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for i, file := range e.Files {
+		if file.Path == path {
+			e.Files[i].After = hash
+			return
+		}
+	}
+
+	e.Files = append(e.Files, FileChange{Path: path, After: hash})
+}
+
+func hashFile(path string) string {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
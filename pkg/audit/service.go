@@ -0,0 +1,104 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+const (
+	TimeFormat   = "2006-01-02 15:04:05"
+	DefaultLimit = 100
+	MaxLimit     = 1000
+)
+
+type AuditService struct{}
+
+func NewService() *AuditService {
+	return &AuditService{}
+}
+
+func (s *AuditService) QueryAuditLog(ctx context.Context, req *pb.QueryAuditLogReq) (*pb.QueryAuditLogResponse, error) {
+	filter, err := newFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := Query(filter)
+	if err != nil {
+		return nil, fmt.Errorf("query audit log failed: %s", err.Error())
+	}
+
+	resp := &pb.QueryAuditLogResponse{}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, entryToPB(entry))
+	}
+
+	return resp, nil
+}
+
+func newFilter(req *pb.QueryAuditLogReq) (*Filter, error) {
+	filter := &Filter{
+		Resource: req.GetResource(),
+		Command:  req.GetCommand(),
+		Limit:    int(req.GetLimit()),
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = DefaultLimit
+	} else if filter.Limit > MaxLimit {
+		filter.Limit = MaxLimit
+	}
+
+	var err error
+	if req.GetStartTime() != "" {
+		if filter.Start, err = time.ParseInLocation(TimeFormat, req.GetStartTime(), time.Local); err != nil {
+			return nil, fmt.Errorf("parse start time %s failed: %s", req.GetStartTime(), err.Error())
+		}
+	}
+
+	if req.GetEndTime() != "" {
+		end, err := time.ParseInLocation(TimeFormat, req.GetEndTime(), time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("parse end time %s failed: %s", req.GetEndTime(), err.Error())
+		}
+		filter.End = end.Add(time.Second)
+	}
+
+	return filter, nil
+}
+
+func entryToPB(entry *Entry) *pb.AuditLogEntry {
+	var changes string
+	if len(entry.Changes) != 0 {
+		if data, err := json.Marshal(entry.Changes); err == nil {
+			changes = string(data)
+		}
+	}
+
+	var files []*pb.AuditFileChange
+	for _, file := range entry.Files {
+		files = append(files, &pb.AuditFileChange{Path: file.Path, Before: file.Before, After: file.After})
+	}
+
+	return &pb.AuditLogEntry{
+		Id:           entry.ID,
+		Time:         entry.Time.Format(TimeFormat),
+		Source:       entry.Source,
+		Command:      entry.Command,
+		Method:       entry.Method,
+		Caller:       entry.Caller,
+		Peer:         entry.Peer,
+		Resource:     entry.Resource,
+		ResourceId:   entry.ResourceID,
+		Request:      string(entry.Request),
+		Changes:      changes,
+		Files:        files,
+		Succeed:      entry.Succeed,
+		Denied:       entry.Denied,
+		ErrorMessage: entry.Error,
+	}
+}
@@ -0,0 +1,211 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	AuditFileName         = "audit.jsonl"
+	RotatedFilePrefix     = "audit-"
+	RotatedFileSuffix     = ".jsonl"
+	RotatedFileTimeFormat = "20060102150405.000000"
+	MaxLineSize           = 16 * 1024 * 1024
+)
+
+type fileStore struct {
+	lock     sync.Mutex
+	dir      string
+	maxSize  int64
+	maxFiles int
+	file     *os.File
+	size     int64
+}
+
+func newFileStore(dir string, maxSize int64, maxFiles int) (*fileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("create audit dir %s failed: %s", dir, err.Error())
+	}
+
+	s := &fileStore{dir: dir, maxSize: maxSize, maxFiles: maxFiles}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *fileStore) open() error {
+	file, err := os.OpenFile(filepath.Join(s.dir, AuditFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("open audit file failed: %s", err.Error())
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("stat audit file failed: %s", err.Error())
+	}
+
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+func (s *fileStore) append(entry *Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal audit entry failed: %s", err.Error())
+	}
+	data = append(data, '\n')
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.file == nil {
+		return fmt.Errorf("audit store is closed")
+	}
+
+	if s.size > 0 && s.size+int64(len(data)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(data)
+	s.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("write audit entry failed: %s", err.Error())
+	}
+
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("sync audit file failed: %s", err.Error())
+	}
+
+	return nil
+}
+
+func (s *fileStore) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("close audit file failed: %s", err.Error())
+	}
+
+	s.file = nil
+	rotated := RotatedFilePrefix + time.Now().Format(RotatedFileTimeFormat) + RotatedFileSuffix
+	if err := os.Rename(filepath.Join(s.dir, AuditFileName), filepath.Join(s.dir, rotated)); err != nil {
+		return fmt.Errorf("rotate audit file failed: %s", err.Error())
+	}
+
+	if err := s.open(); err != nil {
+		return err
+	}
+
+	rotatedFiles, err := s.rotatedFiles()
+	if err != nil {
+		return err
+	}
+
+	for len(rotatedFiles) > s.maxFiles {
+		if err := os.Remove(rotatedFiles[0]); err != nil {
+			return fmt.Errorf("remove old audit file %s failed: %s", rotatedFiles[0], err.Error())
+		}
+		rotatedFiles = rotatedFiles[1:]
+	}
+
+	return nil
+}
+
+func (s *fileStore) rotatedFiles() ([]string, error) {
+	infos, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read audit dir %s failed: %s", s.dir, err.Error())
+	}
+
+	var files []string
+	for _, info := range infos {
+		if strings.HasPrefix(info.Name(), RotatedFilePrefix) && strings.HasSuffix(info.Name(), RotatedFileSuffix) {
+			files = append(files, filepath.Join(s.dir, info.Name()))
+		}
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+func (s *fileStore) query(filter *Filter) ([]*Entry, error) {
+	s.lock.Lock()
+	files, err := s.rotatedFiles()
+	s.lock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*Entry
+	for _, file := range append(files, filepath.Join(s.dir, AuditFileName)) {
+		matched, err := queryFile(file, filter)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, matched...)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.After(entries[j].Time)
+	})
+
+	if filter.Limit > 0 && len(entries) > filter.Limit {
+		entries = entries[:filter.Limit]
+	}
+
+	return entries, nil
+}
+
+func queryFile(path string, filter *Filter) ([]*Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("open audit file %s failed: %s", path, err.Error())
+	}
+	defer file.Close()
+
+	var entries []*Entry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), MaxLineSize)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+
+		if filter.match(&entry) {
+			entries = append(entries, &entry)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read audit file %s failed: %s", path, err.Error())
+	}
+
+	return entries, nil
+}
+
+func (s *fileStore) close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.file == nil {
+		return nil
+	}
+
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
@@ -1,9 +1,15 @@
 package db
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/agentmetric"
+	"github.com/linkingthing/ddi-agent/pkg/audit"
+	"github.com/linkingthing/ddi-agent/pkg/health"
 
 	restdb "github.com/zdnscloud/gorest/db"
 	"github.com/zdnscloud/gorest/resource"
@@ -33,8 +39,29 @@ func Init(conf *config.AgentConfig) error {
 		return err
 	}
 
-	globalDB, err = restdb.NewRStore(fmt.Sprintf(ConnStr, conf.DB.User, conf.DB.Password, conf.DNS.Dbhost, conf.DNS.Dbport, conf.DB.Name), meta)
-	return err
+	store, err := restdb.NewRStore(fmt.Sprintf(ConnStr, conf.DB.User, conf.DB.Password, conf.DNS.Dbhost, conf.DNS.Dbport, conf.DB.Name), meta)
+	if err != nil {
+		return err
+	}
+
+	if err := addMissingColumns(store, meta); err != nil {
+		return err
+	}
+
+	globalDB = &auditedStore{ResourceStore: store}
+	health.Register(health.ComponentPostgresql, func(context.Context) error {
+		return restdb.WithTx(globalDB, func(tx restdb.Transaction) error {
+			_, err := tx.Exec("select 1")
+			return err
+		})
+	})
+	return nil
+}
+
+func Close() {
+	if globalDB != nil {
+		globalDB.Close()
+	}
 }
 
 func GetResources(conditions map[string]interface{}, resources interface{}) error {
@@ -42,3 +69,113 @@ func GetResources(conditions map[string]interface{}, resources interface{}) erro
 		return tx.Fill(conditions, resources)
 	})
 }
+
+func WithAuditTx(entry *audit.Entry, fn func(tx restdb.Transaction) error) error {
+	store, ok := globalDB.(*auditedStore)
+	if ok == false || entry == nil {
+		return restdb.WithTx(globalDB, fn)
+	}
+
+	return restdb.WithTx(&auditedStore{ResourceStore: store.ResourceStore, entry: entry}, fn)
+}
+
+type auditedStore struct {
+	restdb.ResourceStore
+	entry *audit.Entry
+}
+
+func (s *auditedStore) Begin() (restdb.Transaction, error) {
+	tx, err := s.ResourceStore.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	return &auditedTx{Transaction: tx, begin: time.Now(), entry: s.entry}, nil
+}
+
+type auditedTx struct {
+	restdb.Transaction
+	begin   time.Time
+	entry   *audit.Entry
+	changes []audit.Change
+}
+
+func (tx *auditedTx) Insert(r resource.Resource) (resource.Resource, error) {
+	inserted, err := tx.Transaction.Insert(r)
+	if err == nil && tx.entry != nil {
+		tx.changes = append(tx.changes, audit.Change{
+			Table:  string(restdb.ResourceDBType(r)),
+			Action: audit.ActionInsert,
+			After:  inserted,
+		})
+	}
+	return inserted, err
+}
+
+func (tx *auditedTx) Update(typ restdb.ResourceType, nv map[string]interface{}, cond map[string]interface{}) (int64, error) {
+	if tx.entry == nil {
+		return tx.Transaction.Update(typ, nv, cond)
+	}
+
+	before, _ := tx.Transaction.Get(typ, cond)
+	rows, err := tx.Transaction.Update(typ, nv, cond)
+	if err == nil && rows > 0 {
+		after, _ := tx.Transaction.Get(typ, cond)
+		tx.changes = append(tx.changes, audit.Change{
+			Table:      string(typ),
+			Action:     audit.ActionUpdate,
+			Conditions: cond,
+			Before:     before,
+			After:      after,
+		})
+	}
+	return rows, err
+}
+
+func (tx *auditedTx) Delete(typ restdb.ResourceType, cond map[string]interface{}) (int64, error) {
+	if tx.entry == nil {
+		return tx.Transaction.Delete(typ, cond)
+	}
+
+	before, _ := tx.Transaction.Get(typ, cond)
+	rows, err := tx.Transaction.Delete(typ, cond)
+	if err == nil && rows > 0 {
+		tx.changes = append(tx.changes, audit.Change{
+			Table:      string(typ),
+			Action:     audit.ActionDelete,
+			Conditions: cond,
+			Before:     before,
+		})
+	}
+	return rows, err
+}
+
+func (tx *auditedTx) Exec(sql string, params ...interface{}) (int64, error) {
+	rows, err := tx.Transaction.Exec(sql, params...)
+	if err == nil && rows > 0 && isQuery(sql) == false && tx.entry != nil {
+		tx.changes = append(tx.changes, audit.Change{
+			Action:     audit.ActionExec,
+			Conditions: map[string]interface{}{"sql": sql, "params": params},
+		})
+	}
+	return rows, err
+}
+
+func isQuery(sql string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(sql)), "select")
+}
+
+func (tx *auditedTx) Commit() error {
+	err := tx.Transaction.Commit()
+	if err == nil && len(tx.changes) != 0 {
+		tx.entry.RecordChanges(tx.changes)
+	}
+	agentmetric.DBTransactionDuration.WithLabelValues(agentmetric.OutcomeCommit).Observe(time.Since(tx.begin).Seconds())
+	return err
+}
+
+func (tx *auditedTx) Rollback() error {
+	err := tx.Transaction.Rollback()
+	agentmetric.DBTransactionDuration.WithLabelValues(agentmetric.OutcomeRollback).Observe(time.Since(tx.begin).Seconds())
+	return err
+}
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"fmt"
+
+	restdb "github.com/zdnscloud/gorest/db"
+)
+
+var columnDefinitions = map[restdb.Datatype]string{
+	restdb.Bool:          "boolean default false",
+	restdb.SmallInt:      "integer default 0",
+	restdb.BigInt:        "bigint default 0",
+	restdb.SuperInt:      "numeric default 0",
+	restdb.Float32:       "float4 default 0",
+	restdb.String:        "text default ''",
+	restdb.SmallIntArray: "integer[] default '{}'",
+	restdb.BigIntArray:   "bigint[] default '{}'",
+	restdb.SuperIntArray: "numeric[] default '{}'",
+	restdb.Float32Array:  "float4[] default '{}'",
+	restdb.StringArray:   "text[] default '{}'",
+}
+
+func addMissingColumns(store restdb.ResourceStore, meta *restdb.ResourceMeta) error {
+	return restdb.WithTx(store, func(tx restdb.Transaction) error {
+		for _, descriptor := range meta.GetDescriptors() {
+			for _, field := range descriptor.Fields {
+				definition, ok := columnDefinitions[field.Type]
+				if ok == false || field.Unique {
+					continue
+				}
+
+				if _, err := tx.Exec(fmt.Sprintf("alter table %s%s add column if not exists %s %s",
+					restdb.TablePrefix, descriptor.Typ, field.Name, definition)); err != nil {
+					return fmt.Errorf("add column %s to %s failed: %s", field.Name, descriptor.Typ, err.Error())
+				}
+			}
+		}
+
+		return nil
+	})
+}
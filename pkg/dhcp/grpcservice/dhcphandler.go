@@ -18,8 +18,11 @@ import (
 	"github.com/zdnscloud/cement/log"
 
 	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/agentmetric"
+	"github.com/linkingthing/ddi-agent/pkg/audit"
 	"github.com/linkingthing/ddi-agent/pkg/dhcp/util"
 	"github.com/linkingthing/ddi-agent/pkg/grpcclient"
+	"github.com/linkingthing/ddi-agent/pkg/health"
 	pb "github.com/linkingthing/ddi-agent/pkg/proto"
 	monitorpb "github.com/linkingthing/ddi-monitor/pkg/proto"
 )
@@ -35,6 +38,7 @@ const (
 	Option4Routers         = "routers"
 	DHCPCommandConfigSet   = "config-set"
 	DHCPCommandConfigWrite = "config-write"
+	DHCPCommandVersionGet  = "version-get"
 	PostgresqlConnStr      = "user=%s password=%s host=%s port=%d database=%s sslmode=disable pool_max_conns=10"
 	TableLease4            = "lease4"
 	TableLease6            = "lease6"
@@ -43,12 +47,14 @@ const (
 )
 
 type DHCPHandler struct {
+	cmdLock    sync.RWMutex
 	cmdUrl     string
 	conf       *DHCPConfig
 	agentConf  *config.AgentConfig
 	lock       sync.RWMutex
 	db         *pgxpool.Pool
 	httpClient *http.Client
+	quit       chan struct{}
 }
 
 type DHCPConfig struct {
@@ -69,23 +75,50 @@ func newDHCPHandler(conf *config.AgentConfig) (*DHCPHandler, error) {
 	}
 
 	handler := &DHCPHandler{
-		agentConf: conf,
-		cmdUrl:    cmdUrl.String(),
-		db:        db,
-		httpClient: &http.Client{
-			Timeout:   HttpClientTimeout * time.Second,
-			Transport: &http.Transport{DisableKeepAlives: true},
-		},
+		agentConf:  conf,
+		cmdUrl:     cmdUrl.String(),
+		db:         db,
+		httpClient: newHttpClient(),
+		quit:       make(chan struct{}),
 	}
 
 	if err := handler.reconfigOrStartDHCP(true); err != nil {
 		return nil, err
 	}
 
+	health.Register(health.ComponentDHCPPostgresql, handler.checkDB)
+	health.Register(health.ComponentKea, handler.checkCtrlAgent)
 	go handler.monitor()
 	return handler, nil
 }
 
+func newHttpClient() *http.Client {
+	return &http.Client{
+		Timeout:   HttpClientTimeout * time.Second,
+		Transport: &http.Transport{DisableKeepAlives: true},
+	}
+}
+
+func (h *DHCPHandler) reload(conf *config.AgentConfig) error {
+	cmdUrl, err := url.Parse(HttpScheme + conf.DHCP.CmdAddr)
+	if err != nil {
+		return fmt.Errorf("parse dhcp cmd url %s failed: %s", HttpScheme+conf.DHCP.CmdAddr, err.Error())
+	}
+
+	h.cmdLock.Lock()
+	h.cmdUrl = cmdUrl.String()
+	h.httpClient = newHttpClient()
+	h.cmdLock.Unlock()
+	return nil
+}
+
+func (h *DHCPHandler) sendCmd(req *DHCPCmdRequest) ([]DHCPCmdResponse, error) {
+	h.cmdLock.RLock()
+	httpClient, cmdUrl := h.httpClient, h.cmdUrl
+	h.cmdLock.RUnlock()
+	return SendHttpRequestToDHCP(httpClient, cmdUrl, req)
+}
+
 func (h *DHCPHandler) reconfigOrStartDHCP(init bool) error {
 	err := h.loadDHCPConfig(h.agentConf)
 	if err != nil {
@@ -111,11 +144,11 @@ func (h *DHCPHandler) reconfigOrStartDHCP(init bool) error {
 }
 
 func (h *DHCPHandler) reconfigDHCP() error {
-	if err := h.reconfig(DHCP4Name, h.conf.dhcp4Conf.Path, h.conf.dhcp4Conf); err != nil {
+	if err := h.reconfig(context.Background(), DHCP4Name, h.conf.dhcp4Conf.Path, h.conf.dhcp4Conf); err != nil {
 		return err
 	}
 
-	return h.reconfig(DHCP6Name, h.conf.dhcp6Conf.Path, h.conf.dhcp6Conf)
+	return h.reconfig(context.Background(), DHCP6Name, h.conf.dhcp6Conf.Path, h.conf.dhcp6Conf)
 }
 
 func (h *DHCPHandler) loadDHCPConfig(conf *config.AgentConfig) error {
@@ -238,21 +271,41 @@ func (h *DHCPHandler) stopDHCP() error {
 	return err
 }
 
+func (h *DHCPHandler) checkDB(ctx context.Context) error {
+	_, err := h.db.Exec(ctx, "select 1")
+	return err
+}
+
+func (h *DHCPHandler) checkCtrlAgent(context.Context) error {
+	_, err := h.sendCmd(&DHCPCmdRequest{Command: DHCPCommandVersionGet})
+	return err
+}
+
 func (h *DHCPHandler) monitor() {
 	for {
 		resp, err := grpcclient.GetDDIMonitorGrpcClient().GetDHCPState(context.Background(),
 			&monitorpb.GetDHCPStateRequest{})
 		if err == nil && resp.GetIsRunning() == false {
+			agentmetric.IncServiceRestart(agentmetric.ServiceDHCP)
 			if err := h.reconfigOrStartDHCP(false); err != nil {
 				log.Warnf("start dhcp failed: %s", err.Error())
 			}
 		}
 
-		time.Sleep(10 * time.Second)
+		select {
+		case <-time.After(10 * time.Second):
+		case <-h.quit:
+			return
+		}
 	}
 }
 
-func (h *DHCPHandler) CreateSubnet4(req *pb.CreateSubnet4Request) error {
+func (h *DHCPHandler) close() {
+	close(h.quit)
+	h.db.Close()
+}
+
+func (h *DHCPHandler) CreateSubnet4(ctx context.Context, req *pb.CreateSubnet4Request) error {
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
@@ -273,7 +326,7 @@ func (h *DHCPHandler) CreateSubnet4(req *pb.CreateSubnet4Request) error {
 		Interface:        req.GetIfaceName(),
 	})
 
-	return h.reconfig4(dhcp4Conf)
+	return h.reconfig4(ctx, dhcp4Conf)
 }
 
 func genDhcp4ConfFromDeepCopy(src *DHCP4Config) (*DHCP4Config, error) {
@@ -320,9 +373,9 @@ func genRelayAgent(relayAgentAddrs []string) RelayAgent {
 	return RelayAgent{IPAddresses: relayAgentAddrs}
 }
 
-func (h *DHCPHandler) reconfig4(dhcp4Conf *DHCP4Config) error {
-	if err := h.reconfig(DHCP4Name, dhcp4Conf.Path, dhcp4Conf); err != nil {
-		if err := h.reconfig(DHCP4Name, h.conf.dhcp4Conf.Path, h.conf.dhcp4Conf); err != nil {
+func (h *DHCPHandler) reconfig4(ctx context.Context, dhcp4Conf *DHCP4Config) error {
+	if err := h.reconfig(ctx, DHCP4Name, dhcp4Conf.Path, dhcp4Conf); err != nil {
+		if err := h.reconfig(ctx, DHCP4Name, h.conf.dhcp4Conf.Path, h.conf.dhcp4Conf); err != nil {
 			log.Errorf("rollback dhcp4 to old config failed: %s", err.Error())
 		}
 		return err
@@ -332,16 +385,16 @@ func (h *DHCPHandler) reconfig4(dhcp4Conf *DHCP4Config) error {
 	return nil
 }
 
-func (h *DHCPHandler) reconfig(service string, configPath string, conf interface{}) error {
+func (h *DHCPHandler) reconfig(ctx context.Context, service string, configPath string, conf interface{}) error {
 	if err := h.setDHCPConfigToMemory(service, conf); err != nil {
 		return err
 	}
 
-	return h.writeDHCPConfigToFile(service, configPath)
+	return h.writeDHCPConfigToFile(ctx, service, configPath)
 }
 
 func (h *DHCPHandler) setDHCPConfigToMemory(service string, conf interface{}) error {
-	_, err := SendHttpRequestToDHCP(h.httpClient, h.cmdUrl, &DHCPCmdRequest{
+	_, err := h.sendCmd(&DHCPCmdRequest{
 		Command:   DHCPCommandConfigSet,
 		Services:  []string{service},
 		Arguments: conf,
@@ -350,8 +403,11 @@ func (h *DHCPHandler) setDHCPConfigToMemory(service string, conf interface{}) er
 	return err
 }
 
-func (h *DHCPHandler) writeDHCPConfigToFile(service string, configPath string) error {
-	_, err := SendHttpRequestToDHCP(h.httpClient, h.cmdUrl, &DHCPCmdRequest{
+func (h *DHCPHandler) writeDHCPConfigToFile(ctx context.Context, service string, configPath string) error {
+	entry := audit.FromContext(ctx)
+	entry.BeforeFileWrite(configPath)
+	defer entry.AfterFileWrite(configPath)
+	_, err := h.sendCmd(&DHCPCmdRequest{
 		Command:  DHCPCommandConfigWrite,
 		Services: []string{service},
 		Arguments: map[string]interface{}{
@@ -362,7 +418,7 @@ func (h *DHCPHandler) writeDHCPConfigToFile(service string, configPath string) e
 	return err
 }
 
-func (h *DHCPHandler) UpdateSubnet4(req *pb.UpdateSubnet4Request) error {
+func (h *DHCPHandler) UpdateSubnet4(ctx context.Context, req *pb.UpdateSubnet4Request) error {
 	exists := false
 	h.lock.Lock()
 	defer h.lock.Unlock()
@@ -388,13 +444,13 @@ func (h *DHCPHandler) UpdateSubnet4(req *pb.UpdateSubnet4Request) error {
 	}
 
 	if exists {
-		return h.reconfig4(dhcp4Conf)
+		return h.reconfig4(ctx, dhcp4Conf)
 	} else {
 		return fmt.Errorf("no found subnet4 %d", req.GetId())
 	}
 }
 
-func (h *DHCPHandler) DeleteSubnet4(req *pb.DeleteSubnet4Request) error {
+func (h *DHCPHandler) DeleteSubnet4(ctx context.Context, req *pb.DeleteSubnet4Request) error {
 	exists := false
 	h.lock.Lock()
 	defer h.lock.Unlock()
@@ -413,13 +469,13 @@ func (h *DHCPHandler) DeleteSubnet4(req *pb.DeleteSubnet4Request) error {
 	}
 
 	if exists {
-		return h.reconfig4(dhcp4Conf)
+		return h.reconfig4(ctx, dhcp4Conf)
 	} else {
 		return fmt.Errorf("no found subnet4 %d", req.GetId())
 	}
 }
 
-func (h *DHCPHandler) CreateSubnet6(req *pb.CreateSubnet6Request) error {
+func (h *DHCPHandler) CreateSubnet6(ctx context.Context, req *pb.CreateSubnet6Request) error {
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
@@ -441,7 +497,7 @@ func (h *DHCPHandler) CreateSubnet6(req *pb.CreateSubnet6Request) error {
 		Interface:        req.GetIfaceName(),
 	})
 
-	return h.reconfig6(dhcp6Conf)
+	return h.reconfig6(ctx, dhcp6Conf)
 }
 
 func genDhcp6ConfFromDeepCopy(src *DHCP6Config) (*DHCP6Config, error) {
@@ -459,9 +515,9 @@ func genDhcp6ConfFromDeepCopy(src *DHCP6Config) (*DHCP6Config, error) {
 	return dst, nil
 }
 
-func (h *DHCPHandler) reconfig6(dhcp6Conf *DHCP6Config) error {
-	if err := h.reconfig(DHCP6Name, dhcp6Conf.Path, dhcp6Conf); err != nil {
-		if err := h.reconfig(DHCP6Name, h.conf.dhcp6Conf.Path, h.conf.dhcp6Conf); err != nil {
+func (h *DHCPHandler) reconfig6(ctx context.Context, dhcp6Conf *DHCP6Config) error {
+	if err := h.reconfig(ctx, DHCP6Name, dhcp6Conf.Path, dhcp6Conf); err != nil {
+		if err := h.reconfig(ctx, DHCP6Name, h.conf.dhcp6Conf.Path, h.conf.dhcp6Conf); err != nil {
 			log.Errorf("rollback dhcp6 to old config failed: %s", err.Error())
 		}
 		return err
@@ -471,7 +527,7 @@ func (h *DHCPHandler) reconfig6(dhcp6Conf *DHCP6Config) error {
 	return nil
 }
 
-func (h *DHCPHandler) UpdateSubnet6(req *pb.UpdateSubnet6Request) error {
+func (h *DHCPHandler) UpdateSubnet6(ctx context.Context, req *pb.UpdateSubnet6Request) error {
 	exists := false
 	h.lock.Lock()
 	defer h.lock.Unlock()
@@ -497,13 +553,13 @@ func (h *DHCPHandler) UpdateSubnet6(req *pb.UpdateSubnet6Request) error {
 	}
 
 	if exists {
-		return h.reconfig6(dhcp6Conf)
+		return h.reconfig6(ctx, dhcp6Conf)
 	} else {
 		return fmt.Errorf("no found subnet6 %d", req.GetId())
 	}
 }
 
-func (h *DHCPHandler) DeleteSubnet6(req *pb.DeleteSubnet6Request) error {
+func (h *DHCPHandler) DeleteSubnet6(ctx context.Context, req *pb.DeleteSubnet6Request) error {
 	exists := false
 	h.lock.Lock()
 	defer h.lock.Unlock()
@@ -522,13 +578,13 @@ func (h *DHCPHandler) DeleteSubnet6(req *pb.DeleteSubnet6Request) error {
 	}
 
 	if exists {
-		return h.reconfig6(dhcp6Conf)
+		return h.reconfig6(ctx, dhcp6Conf)
 	} else {
 		return fmt.Errorf("no found subnet6 %d", req.GetId())
 	}
 }
 
-func (h *DHCPHandler) CreatePool4(req *pb.CreatePool4Request) error {
+func (h *DHCPHandler) CreatePool4(ctx context.Context, req *pb.CreatePool4Request) error {
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
@@ -547,14 +603,14 @@ func (h *DHCPHandler) CreatePool4(req *pb.CreatePool4Request) error {
 			break
 		}
 	}
-	return h.reconfig4(dhcp4Conf)
+	return h.reconfig4(ctx, dhcp4Conf)
 }
 
 func genPoolByBeginAndEnd(begin, end string) string {
 	return begin + " - " + end
 }
 
-func (h *DHCPHandler) UpdatePool4(req *pb.UpdatePool4Request) error {
+func (h *DHCPHandler) UpdatePool4(ctx context.Context, req *pb.UpdatePool4Request) error {
 	exists := false
 	updatePool := genPoolByBeginAndEnd(req.GetBeginAddress(), req.GetEndAddress())
 	h.lock.Lock()
@@ -581,14 +637,14 @@ func (h *DHCPHandler) UpdatePool4(req *pb.UpdatePool4Request) error {
 	}
 
 	if exists {
-		return h.reconfig4(dhcp4Conf)
+		return h.reconfig4(ctx, dhcp4Conf)
 	} else {
 		return fmt.Errorf("no found pool4 %s-%s in subnet4 %d",
 			req.GetBeginAddress(), req.GetEndAddress(), req.GetSubnetId())
 	}
 }
 
-func (h *DHCPHandler) DeletePool4(req *pb.DeletePool4Request) error {
+func (h *DHCPHandler) DeletePool4(ctx context.Context, req *pb.DeletePool4Request) error {
 	exists := false
 	deletePool := genPoolByBeginAndEnd(req.GetBeginAddress(), req.GetEndAddress())
 	h.lock.Lock()
@@ -614,14 +670,14 @@ func (h *DHCPHandler) DeletePool4(req *pb.DeletePool4Request) error {
 	}
 
 	if exists {
-		return h.reconfig4(dhcp4Conf)
+		return h.reconfig4(ctx, dhcp4Conf)
 	} else {
 		return fmt.Errorf("no found pool4 %s-%s in subnet4 %d",
 			req.GetBeginAddress(), req.GetEndAddress(), req.GetSubnetId())
 	}
 }
 
-func (h *DHCPHandler) CreatePool6(req *pb.CreatePool6Request) error {
+func (h *DHCPHandler) CreatePool6(ctx context.Context, req *pb.CreatePool6Request) error {
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
@@ -640,10 +696,10 @@ func (h *DHCPHandler) CreatePool6(req *pb.CreatePool6Request) error {
 			break
 		}
 	}
-	return h.reconfig6(dhcp6Conf)
+	return h.reconfig6(ctx, dhcp6Conf)
 }
 
-func (h *DHCPHandler) UpdatePool6(req *pb.UpdatePool6Request) error {
+func (h *DHCPHandler) UpdatePool6(ctx context.Context, req *pb.UpdatePool6Request) error {
 	exists := false
 	updatePool := genPoolByBeginAndEnd(req.GetBeginAddress(), req.GetEndAddress())
 	h.lock.Lock()
@@ -670,14 +726,14 @@ func (h *DHCPHandler) UpdatePool6(req *pb.UpdatePool6Request) error {
 	}
 
 	if exists {
-		return h.reconfig6(dhcp6Conf)
+		return h.reconfig6(ctx, dhcp6Conf)
 	} else {
 		return fmt.Errorf("no found pool6 %s-%s in subnet6 %d",
 			req.GetBeginAddress(), req.GetEndAddress(), req.GetSubnetId())
 	}
 }
 
-func (h *DHCPHandler) DeletePool6(req *pb.DeletePool6Request) error {
+func (h *DHCPHandler) DeletePool6(ctx context.Context, req *pb.DeletePool6Request) error {
 	exists := false
 	deletePool := genPoolByBeginAndEnd(req.GetBeginAddress(), req.GetEndAddress())
 	h.lock.Lock()
@@ -703,14 +759,14 @@ func (h *DHCPHandler) DeletePool6(req *pb.DeletePool6Request) error {
 	}
 
 	if exists {
-		return h.reconfig6(dhcp6Conf)
+		return h.reconfig6(ctx, dhcp6Conf)
 	} else {
 		return fmt.Errorf("no found pool6 %s-%s in subnet6 %d",
 			req.GetBeginAddress(), req.GetEndAddress(), req.GetSubnetId())
 	}
 }
 
-func (h *DHCPHandler) CreatePDPool(req *pb.CreatePDPoolRequest) error {
+func (h *DHCPHandler) CreatePDPool(ctx context.Context, req *pb.CreatePDPoolRequest) error {
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
@@ -731,10 +787,10 @@ func (h *DHCPHandler) CreatePDPool(req *pb.CreatePDPoolRequest) error {
 			break
 		}
 	}
-	return h.reconfig6(dhcp6Conf)
+	return h.reconfig6(ctx, dhcp6Conf)
 }
 
-func (h *DHCPHandler) UpdatePDPool(req *pb.UpdatePDPoolRequest) error {
+func (h *DHCPHandler) UpdatePDPool(ctx context.Context, req *pb.UpdatePDPoolRequest) error {
 	exists := false
 	h.lock.Lock()
 	defer h.lock.Unlock()
@@ -760,13 +816,13 @@ func (h *DHCPHandler) UpdatePDPool(req *pb.UpdatePDPoolRequest) error {
 	}
 
 	if exists {
-		return h.reconfig6(dhcp6Conf)
+		return h.reconfig6(ctx, dhcp6Conf)
 	} else {
 		return fmt.Errorf("no found pd-pool %s in subnet %d", req.GetPrefix(), req.GetSubnetId())
 	}
 }
 
-func (h *DHCPHandler) DeletePDPool(req *pb.DeletePDPoolRequest) error {
+func (h *DHCPHandler) DeletePDPool(ctx context.Context, req *pb.DeletePDPoolRequest) error {
 	exists := false
 	h.lock.Lock()
 	defer h.lock.Unlock()
@@ -791,13 +847,13 @@ func (h *DHCPHandler) DeletePDPool(req *pb.DeletePDPoolRequest) error {
 	}
 
 	if exists {
-		return h.reconfig6(dhcp6Conf)
+		return h.reconfig6(ctx, dhcp6Conf)
 	} else {
 		return fmt.Errorf("no found pd-pool %s in subnet %d", req.GetPrefix(), req.GetSubnetId())
 	}
 }
 
-func (h *DHCPHandler) CreateReservation4(req *pb.CreateReservation4Request) error {
+func (h *DHCPHandler) CreateReservation4(ctx context.Context, req *pb.CreateReservation4Request) error {
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
@@ -816,10 +872,10 @@ func (h *DHCPHandler) CreateReservation4(req *pb.CreateReservation4Request) erro
 			break
 		}
 	}
-	return h.reconfig4(dhcp4Conf)
+	return h.reconfig4(ctx, dhcp4Conf)
 }
 
-func (h *DHCPHandler) UpdateReservation4(req *pb.UpdateReservation4Request) error {
+func (h *DHCPHandler) UpdateReservation4(ctx context.Context, req *pb.UpdateReservation4Request) error {
 	exists := false
 	h.lock.Lock()
 	defer h.lock.Unlock()
@@ -844,13 +900,13 @@ func (h *DHCPHandler) UpdateReservation4(req *pb.UpdateReservation4Request) erro
 	}
 
 	if exists {
-		return h.reconfig4(dhcp4Conf)
+		return h.reconfig4(ctx, dhcp4Conf)
 	} else {
 		return fmt.Errorf("no found reservation4 %s in subnet4 %d", req.GetHwAddress(), req.GetSubnetId())
 	}
 }
 
-func (h *DHCPHandler) DeleteReservation4(req *pb.DeleteReservation4Request) error {
+func (h *DHCPHandler) DeleteReservation4(ctx context.Context, req *pb.DeleteReservation4Request) error {
 	exists := false
 	h.lock.Lock()
 	defer h.lock.Unlock()
@@ -875,13 +931,13 @@ func (h *DHCPHandler) DeleteReservation4(req *pb.DeleteReservation4Request) erro
 	}
 
 	if exists {
-		return h.reconfig4(dhcp4Conf)
+		return h.reconfig4(ctx, dhcp4Conf)
 	} else {
 		return fmt.Errorf("no found reservation4 %s in subnet4 %d", req.GetHwAddress(), req.GetSubnetId())
 	}
 }
 
-func (h *DHCPHandler) CreateReservation6(req *pb.CreateReservation6Request) error {
+func (h *DHCPHandler) CreateReservation6(ctx context.Context, req *pb.CreateReservation6Request) error {
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
@@ -900,10 +956,10 @@ func (h *DHCPHandler) CreateReservation6(req *pb.CreateReservation6Request) erro
 			break
 		}
 	}
-	return h.reconfig6(dhcp6Conf)
+	return h.reconfig6(ctx, dhcp6Conf)
 }
 
-func (h *DHCPHandler) UpdateReservation6(req *pb.UpdateReservation6Request) error {
+func (h *DHCPHandler) UpdateReservation6(ctx context.Context, req *pb.UpdateReservation6Request) error {
 	exists := false
 	h.lock.Lock()
 	defer h.lock.Unlock()
@@ -928,13 +984,13 @@ func (h *DHCPHandler) UpdateReservation6(req *pb.UpdateReservation6Request) erro
 	}
 
 	if exists {
-		return h.reconfig6(dhcp6Conf)
+		return h.reconfig6(ctx, dhcp6Conf)
 	} else {
 		return fmt.Errorf("no found reservation6 %s in subnet6 %d", req.GetHwAddress(), req.GetSubnetId())
 	}
 }
 
-func (h *DHCPHandler) DeleteReservation6(req *pb.DeleteReservation6Request) error {
+func (h *DHCPHandler) DeleteReservation6(ctx context.Context, req *pb.DeleteReservation6Request) error {
 	exists := false
 	h.lock.Lock()
 	defer h.lock.Unlock()
@@ -959,13 +1015,13 @@ func (h *DHCPHandler) DeleteReservation6(req *pb.DeleteReservation6Request) erro
 	}
 
 	if exists {
-		return h.reconfig6(dhcp6Conf)
+		return h.reconfig6(ctx, dhcp6Conf)
 	} else {
 		return fmt.Errorf("no found reservation6 %s in subnet6 %d", req.GetHwAddress(), req.GetSubnetId())
 	}
 }
 
-func (h *DHCPHandler) CreateClientClass4(req *pb.CreateClientClass4Request) error {
+func (h *DHCPHandler) CreateClientClass4(ctx context.Context, req *pb.CreateClientClass4Request) error {
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
@@ -979,10 +1035,10 @@ func (h *DHCPHandler) CreateClientClass4(req *pb.CreateClientClass4Request) erro
 		Test: req.GetRegexp(),
 	})
 
-	return h.reconfig4(dhcp4Conf)
+	return h.reconfig4(ctx, dhcp4Conf)
 }
 
-func (h *DHCPHandler) UpdateClientClass4(req *pb.UpdateClientClass4Request) error {
+func (h *DHCPHandler) UpdateClientClass4(ctx context.Context, req *pb.UpdateClientClass4Request) error {
 	exists := false
 	h.lock.Lock()
 	defer h.lock.Unlock()
@@ -1001,13 +1057,13 @@ func (h *DHCPHandler) UpdateClientClass4(req *pb.UpdateClientClass4Request) erro
 	}
 
 	if exists {
-		return h.reconfig4(dhcp4Conf)
+		return h.reconfig4(ctx, dhcp4Conf)
 	} else {
 		return fmt.Errorf("no found clientclass4 %s", req.GetName())
 	}
 }
 
-func (h *DHCPHandler) DeleteClientClass4(req *pb.DeleteClientClass4Request) error {
+func (h *DHCPHandler) DeleteClientClass4(ctx context.Context, req *pb.DeleteClientClass4Request) error {
 	exists := false
 	h.lock.Lock()
 	defer h.lock.Unlock()
@@ -1027,13 +1083,13 @@ func (h *DHCPHandler) DeleteClientClass4(req *pb.DeleteClientClass4Request) erro
 	}
 
 	if exists {
-		return h.reconfig4(dhcp4Conf)
+		return h.reconfig4(ctx, dhcp4Conf)
 	} else {
 		return fmt.Errorf("no found clientclass4 %s", req.GetName())
 	}
 }
 
-func (h *DHCPHandler) UpdateGlobalConfig(req *pb.UpdateGlobalConfigRequest) error {
+func (h *DHCPHandler) UpdateGlobalConfig(ctx context.Context, req *pb.UpdateGlobalConfigRequest) error {
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
@@ -1057,11 +1113,11 @@ func (h *DHCPHandler) UpdateGlobalConfig(req *pb.UpdateGlobalConfigRequest) erro
 	dhcp6Conf.DHCP6.MaxValidLifetime = req.GetMaxValidLifetime()
 	dhcp6Conf.DHCP6.OptionDatas = genDHCPOptionDatas(Option6DNSServers, req.GetDomainServers(), nil)
 
-	if err := h.reconfig4(dhcp4Conf); err != nil {
+	if err := h.reconfig4(ctx, dhcp4Conf); err != nil {
 		return err
 	}
 
-	return h.reconfig6(dhcp6Conf)
+	return h.reconfig6(ctx, dhcp6Conf)
 }
 
 type Lease4 struct {
@@ -20,8 +20,16 @@ func New(conf *config.AgentConfig) (*DHCPService, error) {
 	return &DHCPService{handler: handler}, nil
 }
 
+func (s *DHCPService) Close() {
+	s.handler.close()
+}
+
+func (s *DHCPService) Reload(conf *config.AgentConfig) error {
+	return s.handler.reload(conf)
+}
+
 func (s *DHCPService) CreateSubnet4(ctx context.Context, req *pb.CreateSubnet4Request) (*pb.DDIResponse, error) {
-	if err := s.handler.CreateSubnet4(req); err != nil {
+	if err := s.handler.CreateSubnet4(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -29,7 +37,7 @@ func (s *DHCPService) CreateSubnet4(ctx context.Context, req *pb.CreateSubnet4Re
 }
 
 func (s *DHCPService) UpdateSubnet4(ctx context.Context, req *pb.UpdateSubnet4Request) (*pb.DDIResponse, error) {
-	if err := s.handler.UpdateSubnet4(req); err != nil {
+	if err := s.handler.UpdateSubnet4(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -37,7 +45,7 @@ func (s *DHCPService) UpdateSubnet4(ctx context.Context, req *pb.UpdateSubnet4Re
 }
 
 func (s *DHCPService) DeleteSubnet4(ctx context.Context, req *pb.DeleteSubnet4Request) (*pb.DDIResponse, error) {
-	if err := s.handler.DeleteSubnet4(req); err != nil {
+	if err := s.handler.DeleteSubnet4(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -45,7 +53,7 @@ func (s *DHCPService) DeleteSubnet4(ctx context.Context, req *pb.DeleteSubnet4Re
 }
 
 func (s *DHCPService) CreateSubnet6(ctx context.Context, req *pb.CreateSubnet6Request) (*pb.DDIResponse, error) {
-	if err := s.handler.CreateSubnet6(req); err != nil {
+	if err := s.handler.CreateSubnet6(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -53,7 +61,7 @@ func (s *DHCPService) CreateSubnet6(ctx context.Context, req *pb.CreateSubnet6Re
 }
 
 func (s *DHCPService) DeleteSubnet6(ctx context.Context, req *pb.DeleteSubnet6Request) (*pb.DDIResponse, error) {
-	if err := s.handler.DeleteSubnet6(req); err != nil {
+	if err := s.handler.DeleteSubnet6(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -61,7 +69,7 @@ func (s *DHCPService) DeleteSubnet6(ctx context.Context, req *pb.DeleteSubnet6Re
 }
 
 func (s *DHCPService) UpdateSubnet6(ctx context.Context, req *pb.UpdateSubnet6Request) (*pb.DDIResponse, error) {
-	if err := s.handler.UpdateSubnet6(req); err != nil {
+	if err := s.handler.UpdateSubnet6(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -69,7 +77,7 @@ func (s *DHCPService) UpdateSubnet6(ctx context.Context, req *pb.UpdateSubnet6Re
 }
 
 func (s *DHCPService) CreatePool4(ctx context.Context, req *pb.CreatePool4Request) (*pb.DDIResponse, error) {
-	if err := s.handler.CreatePool4(req); err != nil {
+	if err := s.handler.CreatePool4(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -77,7 +85,7 @@ func (s *DHCPService) CreatePool4(ctx context.Context, req *pb.CreatePool4Reques
 }
 
 func (s *DHCPService) DeletePool4(ctx context.Context, req *pb.DeletePool4Request) (*pb.DDIResponse, error) {
-	if err := s.handler.DeletePool4(req); err != nil {
+	if err := s.handler.DeletePool4(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -85,7 +93,7 @@ func (s *DHCPService) DeletePool4(ctx context.Context, req *pb.DeletePool4Reques
 }
 
 func (s *DHCPService) UpdatePool4(ctx context.Context, req *pb.UpdatePool4Request) (*pb.DDIResponse, error) {
-	if err := s.handler.UpdatePool4(req); err != nil {
+	if err := s.handler.UpdatePool4(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -93,7 +101,7 @@ func (s *DHCPService) UpdatePool4(ctx context.Context, req *pb.UpdatePool4Reques
 }
 
 func (s *DHCPService) CreatePool6(ctx context.Context, req *pb.CreatePool6Request) (*pb.DDIResponse, error) {
-	if err := s.handler.CreatePool6(req); err != nil {
+	if err := s.handler.CreatePool6(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -101,7 +109,7 @@ func (s *DHCPService) CreatePool6(ctx context.Context, req *pb.CreatePool6Reques
 }
 
 func (s *DHCPService) DeletePool6(ctx context.Context, req *pb.DeletePool6Request) (*pb.DDIResponse, error) {
-	if err := s.handler.DeletePool6(req); err != nil {
+	if err := s.handler.DeletePool6(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -109,7 +117,7 @@ func (s *DHCPService) DeletePool6(ctx context.Context, req *pb.DeletePool6Reques
 }
 
 func (s *DHCPService) UpdatePool6(ctx context.Context, req *pb.UpdatePool6Request) (*pb.DDIResponse, error) {
-	if err := s.handler.UpdatePool6(req); err != nil {
+	if err := s.handler.UpdatePool6(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -117,7 +125,7 @@ func (s *DHCPService) UpdatePool6(ctx context.Context, req *pb.UpdatePool6Reques
 }
 
 func (s *DHCPService) CreatePDPool(ctx context.Context, req *pb.CreatePDPoolRequest) (*pb.DDIResponse, error) {
-	if err := s.handler.CreatePDPool(req); err != nil {
+	if err := s.handler.CreatePDPool(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -125,7 +133,7 @@ func (s *DHCPService) CreatePDPool(ctx context.Context, req *pb.CreatePDPoolRequ
 }
 
 func (s *DHCPService) DeletePDPool(ctx context.Context, req *pb.DeletePDPoolRequest) (*pb.DDIResponse, error) {
-	if err := s.handler.DeletePDPool(req); err != nil {
+	if err := s.handler.DeletePDPool(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -133,7 +141,7 @@ func (s *DHCPService) DeletePDPool(ctx context.Context, req *pb.DeletePDPoolRequ
 }
 
 func (s *DHCPService) UpdatePDPool(ctx context.Context, req *pb.UpdatePDPoolRequest) (*pb.DDIResponse, error) {
-	if err := s.handler.UpdatePDPool(req); err != nil {
+	if err := s.handler.UpdatePDPool(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -141,7 +149,7 @@ func (s *DHCPService) UpdatePDPool(ctx context.Context, req *pb.UpdatePDPoolRequ
 }
 
 func (s *DHCPService) CreateReservation4(ctx context.Context, req *pb.CreateReservation4Request) (*pb.DDIResponse, error) {
-	if err := s.handler.CreateReservation4(req); err != nil {
+	if err := s.handler.CreateReservation4(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -149,7 +157,7 @@ func (s *DHCPService) CreateReservation4(ctx context.Context, req *pb.CreateRese
 }
 
 func (s *DHCPService) DeleteReservation4(ctx context.Context, req *pb.DeleteReservation4Request) (*pb.DDIResponse, error) {
-	if err := s.handler.DeleteReservation4(req); err != nil {
+	if err := s.handler.DeleteReservation4(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -157,7 +165,7 @@ func (s *DHCPService) DeleteReservation4(ctx context.Context, req *pb.DeleteRese
 }
 
 func (s *DHCPService) UpdateReservation4(ctx context.Context, req *pb.UpdateReservation4Request) (*pb.DDIResponse, error) {
-	if err := s.handler.UpdateReservation4(req); err != nil {
+	if err := s.handler.UpdateReservation4(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -165,7 +173,7 @@ func (s *DHCPService) UpdateReservation4(ctx context.Context, req *pb.UpdateRese
 }
 
 func (s *DHCPService) CreateReservation6(ctx context.Context, req *pb.CreateReservation6Request) (*pb.DDIResponse, error) {
-	if err := s.handler.CreateReservation6(req); err != nil {
+	if err := s.handler.CreateReservation6(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -173,7 +181,7 @@ func (s *DHCPService) CreateReservation6(ctx context.Context, req *pb.CreateRese
 }
 
 func (s *DHCPService) DeleteReservation6(ctx context.Context, req *pb.DeleteReservation6Request) (*pb.DDIResponse, error) {
-	if err := s.handler.DeleteReservation6(req); err != nil {
+	if err := s.handler.DeleteReservation6(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -181,7 +189,7 @@ func (s *DHCPService) DeleteReservation6(ctx context.Context, req *pb.DeleteRese
 }
 
 func (s *DHCPService) UpdateReservation6(ctx context.Context, req *pb.UpdateReservation6Request) (*pb.DDIResponse, error) {
-	if err := s.handler.UpdateReservation6(req); err != nil {
+	if err := s.handler.UpdateReservation6(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -189,7 +197,7 @@ func (s *DHCPService) UpdateReservation6(ctx context.Context, req *pb.UpdateRese
 }
 
 func (s *DHCPService) CreateClientClass4(ctx context.Context, req *pb.CreateClientClass4Request) (*pb.DDIResponse, error) {
-	if err := s.handler.CreateClientClass4(req); err != nil {
+	if err := s.handler.CreateClientClass4(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -197,7 +205,7 @@ func (s *DHCPService) CreateClientClass4(ctx context.Context, req *pb.CreateClie
 }
 
 func (s *DHCPService) DeleteClientClass4(ctx context.Context, req *pb.DeleteClientClass4Request) (*pb.DDIResponse, error) {
-	if err := s.handler.DeleteClientClass4(req); err != nil {
+	if err := s.handler.DeleteClientClass4(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -205,7 +213,7 @@ func (s *DHCPService) DeleteClientClass4(ctx context.Context, req *pb.DeleteClie
 }
 
 func (s *DHCPService) UpdateClientClass4(ctx context.Context, req *pb.UpdateClientClass4Request) (*pb.DDIResponse, error) {
-	if err := s.handler.UpdateClientClass4(req); err != nil {
+	if err := s.handler.UpdateClientClass4(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
@@ -213,7 +221,7 @@ func (s *DHCPService) UpdateClientClass4(ctx context.Context, req *pb.UpdateClie
 }
 
 func (s *DHCPService) UpdateGlobalConfig(ctx context.Context, req *pb.UpdateGlobalConfigRequest) (*pb.DDIResponse, error) {
-	if err := s.handler.UpdateGlobalConfig(req); err != nil {
+	if err := s.handler.UpdateGlobalConfig(ctx, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	} else {
 		return &pb.DDIResponse{Succeed: true}, nil
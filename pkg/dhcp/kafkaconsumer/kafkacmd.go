@@ -1,8 +1,6 @@
 package kafkaconsumer
 
 const (
-	Topic = "dhcp"
-
 	CreateSubnet4 = "create_subnet4"
 	UpdateSubnet4 = "update_subnet4"
 	DeleteSubnet4 = "delete_subnet4"
@@ -4,44 +4,53 @@ import (
 	"context"
 
 	"github.com/golang/protobuf/proto"
-	"github.com/segmentio/kafka-go"
 	"github.com/zdnscloud/cement/log"
 	"google.golang.org/grpc"
 
 	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/agentmetric"
+	"github.com/linkingthing/ddi-agent/pkg/audit"
 	"github.com/linkingthing/ddi-agent/pkg/kafkaproducer"
 	pb "github.com/linkingthing/ddi-agent/pkg/proto"
 )
 
-func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
+func Run(ctx context.Context, conn *grpc.ClientConn, conf *config.AgentConfig) {
 	if conf.DHCP.Enabled == false {
 		return
 	}
 
-	run(conf.Server.IP, pb.NewDHCPManagerClient(conn), kafka.NewReader(kafka.ReaderConfig{
-		Brokers:  conf.Kafka.Addr,
-		Topic:    Topic,
-		GroupID:  conf.DHCP.GroupID,
-		MinBytes: 10,
-		MaxBytes: 10e6,
-	}))
+	if err := initReader(conf); err != nil {
+		log.Errorf("create dhcp kafka reader failed: %s", err.Error())
+		return
+	}
+	defer closeReader()
+	run(ctx, conf.Server.IP, pb.NewDHCPManagerClient(conn))
 }
 
-func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
+func run(ctx context.Context, node string, cli pb.DHCPManagerClient) {
 	for {
-		message, err := kafkaConsumer.ReadMessage(context.Background())
+		reader := getReader()
+		message, err := reader.ReadMessage(ctx)
 		if err != nil {
+			if ctx.Err() != nil {
+				return
+			} else if reader != getReader() {
+				continue
+			}
 			log.Warnf("read dhcp message from kafka failed: %s", err.Error())
 			continue
 		}
 
+		cmdCtx := audit.NewKafkaContext(agentmetric.NewKafkaMessageContext(context.Background(),
+			message.Topic, string(message.Key)), string(message.Key))
+
 		switch string(message.Key) {
 		case CreateSubnet4:
 			var req pb.CreateSubnet4Request
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal create subnet4 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreateSubnet4(context.Background(), &req)
+				ddiResponse, err := cli.CreateSubnet4(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("create subnet4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -55,7 +64,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal update subnet4 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateSubnet4(context.Background(), &req)
+				ddiResponse, err := cli.UpdateSubnet4(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("update subnet4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -69,7 +78,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal delete subnet4 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeleteSubnet4(context.Background(), &req)
+				ddiResponse, err := cli.DeleteSubnet4(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("delete subnet4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -83,7 +92,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal create subnet6 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreateSubnet6(context.Background(), &req)
+				ddiResponse, err := cli.CreateSubnet6(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("create subnet6 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -97,7 +106,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal update subnet6 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateSubnet6(context.Background(), &req)
+				ddiResponse, err := cli.UpdateSubnet6(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("update subnet6 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -111,7 +120,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal delete subnet6 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeleteSubnet6(context.Background(), &req)
+				ddiResponse, err := cli.DeleteSubnet6(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("delete subnet6 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -125,7 +134,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal create pool4 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreatePool4(context.Background(), &req)
+				ddiResponse, err := cli.CreatePool4(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("create pool4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -139,7 +148,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal update pool4 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdatePool4(context.Background(), &req)
+				ddiResponse, err := cli.UpdatePool4(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("update pool4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -153,7 +162,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal delete pool4 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeletePool4(context.Background(), &req)
+				ddiResponse, err := cli.DeletePool4(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("delete pool4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -167,7 +176,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal create pool6 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreatePool6(context.Background(), &req)
+				ddiResponse, err := cli.CreatePool6(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("create pool6 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -181,7 +190,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal update pool6 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdatePool6(context.Background(), &req)
+				ddiResponse, err := cli.UpdatePool6(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("update pool6 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -195,7 +204,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal delete pool6 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeletePool6(context.Background(), &req)
+				ddiResponse, err := cli.DeletePool6(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("delete pool6 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -209,7 +218,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal create pd-pool request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreatePDPool(context.Background(), &req)
+				ddiResponse, err := cli.CreatePDPool(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("create pd-pool with req %s failed: %s", req.String(), err.Error())
 				}
@@ -223,7 +232,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal update pd-pool request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdatePDPool(context.Background(), &req)
+				ddiResponse, err := cli.UpdatePDPool(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("update pd-pool with req %s failed: %s", req.String(), err.Error())
 				}
@@ -237,7 +246,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal delete pd-pool request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeletePDPool(context.Background(), &req)
+				ddiResponse, err := cli.DeletePDPool(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("delete pd-pool with req %s failed: %s", req.String(), err.Error())
 				}
@@ -251,7 +260,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal create reservation4 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreateReservation4(context.Background(), &req)
+				ddiResponse, err := cli.CreateReservation4(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("create reservation4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -265,7 +274,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal update reservation4 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateReservation4(context.Background(), &req)
+				ddiResponse, err := cli.UpdateReservation4(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("update reservation4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -279,7 +288,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal delete reservation4 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeleteReservation4(context.Background(), &req)
+				ddiResponse, err := cli.DeleteReservation4(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("delete reservation4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -293,7 +302,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal create reservation6 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreateReservation6(context.Background(), &req)
+				ddiResponse, err := cli.CreateReservation6(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("create reservation6 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -307,7 +316,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal update reservation6 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateReservation6(context.Background(), &req)
+				ddiResponse, err := cli.UpdateReservation6(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("update reservation4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -321,7 +330,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal delete reservation4 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeleteReservation6(context.Background(), &req)
+				ddiResponse, err := cli.DeleteReservation6(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("delete reservation4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -335,7 +344,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal create clientclass4 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreateClientClass4(context.Background(), &req)
+				ddiResponse, err := cli.CreateClientClass4(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("create clientclass4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -349,7 +358,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal update clientclass4 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateClientClass4(context.Background(), &req)
+				ddiResponse, err := cli.UpdateClientClass4(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("update clientclass4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -363,7 +372,7 @@ func run(node string, cli pb.DHCPManagerClient, kafkaConsumer *kafka.Reader) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Warnf("unmarshal delete clientclass4 request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeleteClientClass4(context.Background(), &req)
+				ddiResponse, err := cli.DeleteClientClass4(cmdCtx, &req)
 				if err != nil {
 					log.Warnf("delete clientclass4 with req %s failed: %s", req.String(), err.Error())
 				}
@@ -0,0 +1,189 @@
+package analytics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/dns/querylog"
+)
+
+const (
+	DefaultWindowSeconds = 300
+	DefaultTopN          = 10
+	BucketCount          = 10
+	SketchCapacityFactor = 20
+	RcodeNXDOMAIN        = "NXDOMAIN"
+)
+
+type bucket struct {
+	start     int64
+	names     *SpaceSaving
+	clients   *SpaceSaving
+	nxdomains *SpaceSaving
+	qtypes    map[string]map[string]uint64
+}
+
+func newBucket(capacity int) *bucket {
+	return &bucket{
+		names:     NewSpaceSaving(capacity),
+		clients:   NewSpaceSaving(capacity),
+		nxdomains: NewSpaceSaving(capacity),
+		qtypes:    make(map[string]map[string]uint64),
+	}
+}
+
+func (b *bucket) reset(start int64) {
+	b.start = start
+	b.names.Reset()
+	b.clients.Reset()
+	b.nxdomains.Reset()
+	b.qtypes = make(map[string]map[string]uint64)
+}
+
+type Analyzer struct {
+	lock          sync.Mutex
+	bucketSeconds int64
+	topN          int
+	buckets       []*bucket
+}
+
+type TopStats struct {
+	WindowSeconds uint32
+	TopNames      []Entry
+	TopClients    []Entry
+	TopNXDomains  []Entry
+	ViewQTypes    map[string]map[string]uint64
+}
+
+var globalAnalyzer *Analyzer
+
+func GetAnalyzer() *Analyzer {
+	return globalAnalyzer
+}
+
+func Init(conf *config.AgentConfig) *Analyzer {
+	if conf.DNS.Enabled == false || conf.DNS.Analytics.Enabled == false {
+		return nil
+	}
+
+	globalAnalyzer = New(conf.DNS.Analytics.WindowSeconds, conf.DNS.Analytics.TopN)
+	return globalAnalyzer
+}
+
+func New(windowSeconds, topN uint32) *Analyzer {
+	if windowSeconds < BucketCount {
+		windowSeconds = DefaultWindowSeconds
+	}
+
+	if topN == 0 {
+		topN = DefaultTopN
+	}
+
+	a := &Analyzer{
+		bucketSeconds: int64(windowSeconds / BucketCount),
+		topN:          int(topN),
+	}
+	for i := 0; i < BucketCount; i++ {
+		a.buckets = append(a.buckets, newBucket(int(topN)*SketchCapacityFactor))
+	}
+
+	return a
+}
+
+func (a *Analyzer) TopN() int {
+	return a.topN
+}
+
+func (a *Analyzer) WindowSeconds() uint32 {
+	return uint32(a.bucketSeconds * BucketCount)
+}
+
+func (a *Analyzer) HandleQuery(query *querylog.Query) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	b := a.getBucket(query.Time.Unix())
+	if b == nil {
+		return
+	}
+
+	if query.IsResponse {
+		if query.Rcode == RcodeNXDOMAIN {
+			b.nxdomains.Add(query.Name, 1)
+		}
+		return
+	}
+
+	b.names.Add(query.Name, 1)
+	b.clients.Add(query.Client, 1)
+	qtypes, ok := b.qtypes[query.View]
+	if ok == false {
+		qtypes = make(map[string]uint64)
+		b.qtypes[query.View] = qtypes
+	}
+	qtypes[query.Type] += 1
+}
+
+func (a *Analyzer) getBucket(timestamp int64) *bucket {
+	start := timestamp - timestamp%a.bucketSeconds
+	if a.isExpired(start, time.Now().Unix()) {
+		return nil
+	}
+
+	b := a.buckets[(start/a.bucketSeconds)%BucketCount]
+	if b.start != start {
+		if b.start > start {
+			return nil
+		}
+		b.reset(start)
+	}
+
+	return b
+}
+
+func (a *Analyzer) isExpired(bucketStart, now int64) bool {
+	return bucketStart+a.bucketSeconds*BucketCount <= now
+}
+
+func (a *Analyzer) TopStats(topN int) *TopStats {
+	if topN <= 0 {
+		topN = a.topN
+	}
+
+	names := make(map[string]uint64)
+	clients := make(map[string]uint64)
+	nxdomains := make(map[string]uint64)
+	viewQTypes := make(map[string]map[string]uint64)
+	now := time.Now().Unix()
+
+	a.lock.Lock()
+	for _, b := range a.buckets {
+		if b.start == 0 || a.isExpired(b.start, now) {
+			continue
+		}
+
+		b.names.mergeInto(names)
+		b.clients.mergeInto(clients)
+		b.nxdomains.mergeInto(nxdomains)
+		for view, qtypes := range b.qtypes {
+			counts, ok := viewQTypes[view]
+			if ok == false {
+				counts = make(map[string]uint64)
+				viewQTypes[view] = counts
+			}
+			for qtype, count := range qtypes {
+				counts[qtype] += count
+			}
+		}
+	}
+	a.lock.Unlock()
+
+	return &TopStats{
+		WindowSeconds: a.WindowSeconds(),
+		TopNames:      topEntries(names, topN),
+		TopClients:    topEntries(clients, topN),
+		TopNXDomains:  topEntries(nxdomains, topN),
+		ViewQTypes:    viewQTypes,
+	}
+}
@@ -0,0 +1,60 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	ut "github.com/zdnscloud/cement/unittest"
+
+	"github.com/linkingthing/ddi-agent/pkg/dns/querylog"
+)
+
+func TestSpaceSavingTopK(t *testing.T) {
+	s := NewSpaceSaving(3)
+	for key, count := range map[string]uint64{"a": 10, "b": 5, "c": 3} {
+		s.Add(key, count)
+	}
+	s.Add("d", 1)
+	s.Add("a", 2)
+
+	counts := make(map[string]uint64)
+	s.mergeInto(counts)
+	ut.Equal(t, len(counts), 3)
+	ut.Equal(t, counts["a"], uint64(12))
+	ut.Equal(t, counts["b"], uint64(5))
+	ut.Equal(t, counts["d"], uint64(4))
+
+	ut.Equal(t, topEntries(counts, 2), []Entry{{Key: "a", Count: 12}, {Key: "b", Count: 5}})
+
+	s.Reset()
+	counts = make(map[string]uint64)
+	s.mergeInto(counts)
+	ut.Equal(t, len(counts), 0)
+}
+
+func TestAnalyzerTopStats(t *testing.T) {
+	a := New(60, 2)
+	now := time.Now()
+	for _, q := range []*querylog.Query{
+		{Time: now, Client: "10.0.0.1", View: "v1", Name: "a.com", Type: "A"},
+		{Time: now, Client: "10.0.0.1", View: "v1", Name: "a.com", Type: "AAAA"},
+		{Time: now, Client: "10.0.0.2", View: "v2", Name: "b.com", Type: "A"},
+		{Time: now, Client: "10.0.0.3", View: "v2", Name: "c.com", Type: "A"},
+		{Time: now, Name: "nx.com", Rcode: RcodeNXDOMAIN, IsResponse: true},
+		{Time: now, Name: "a.com", Rcode: "NOERROR", IsResponse: true},
+		{Time: now.Add(-2 * time.Minute), Client: "10.0.0.9", View: "v1", Name: "old.com", Type: "A"},
+	} {
+		a.HandleQuery(q)
+	}
+
+	stats := a.TopStats(0)
+	ut.Equal(t, stats.WindowSeconds, uint32(60))
+	ut.Equal(t, stats.TopNames, []Entry{{Key: "a.com", Count: 2}, {Key: "b.com", Count: 1}})
+	ut.Equal(t, stats.TopClients, []Entry{{Key: "10.0.0.1", Count: 2}, {Key: "10.0.0.2", Count: 1}})
+	ut.Equal(t, stats.TopNXDomains, []Entry{{Key: "nx.com", Count: 1}})
+	ut.Equal(t, stats.ViewQTypes, map[string]map[string]uint64{
+		"v1": {"A": 1, "AAAA": 1},
+		"v2": {"A": 2},
+	})
+	ut.Equal(t, len(a.TopStats(5).TopNames), 3)
+}
@@ -0,0 +1,107 @@
+package analytics
+
+import (
+	"container/heap"
+	"sort"
+)
+
+type Entry struct {
+	Key   string
+	Count uint64
+}
+
+type counter struct {
+	key   string
+	count uint64
+	index int
+}
+
+type counterHeap []*counter
+
+func (h counterHeap) Len() int           { return len(h) }
+func (h counterHeap) Less(i, j int) bool { return h[i].count < h[j].count }
+func (h counterHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *counterHeap) Push(x interface{}) {
+	c := x.(*counter)
+	c.index = len(*h)
+	*h = append(*h, c)
+}
+
+func (h *counterHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	c := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return c
+}
+
+type SpaceSaving struct {
+	capacity int
+	counters map[string]*counter
+	heap     counterHeap
+}
+
+func NewSpaceSaving(capacity int) *SpaceSaving {
+	return &SpaceSaving{
+		capacity: capacity,
+		counters: make(map[string]*counter, capacity),
+	}
+}
+
+func (s *SpaceSaving) Add(key string, n uint64) {
+	if c, ok := s.counters[key]; ok {
+		c.count += n
+		heap.Fix(&s.heap, c.index)
+		return
+	}
+
+	if len(s.heap) < s.capacity {
+		c := &counter{key: key, count: n}
+		s.counters[key] = c
+		heap.Push(&s.heap, c)
+		return
+	}
+
+	min := s.heap[0]
+	delete(s.counters, min.key)
+	min.key = key
+	min.count += n
+	s.counters[key] = min
+	heap.Fix(&s.heap, 0)
+}
+
+func (s *SpaceSaving) Reset() {
+	s.counters = make(map[string]*counter, s.capacity)
+	s.heap = s.heap[:0]
+}
+
+func (s *SpaceSaving) mergeInto(counts map[string]uint64) {
+	for key, c := range s.counters {
+		counts[key] += c.count
+	}
+}
+
+func topEntries(counts map[string]uint64, n int) []Entry {
+	entries := make([]Entry, 0, len(counts))
+	for key, count := range counts {
+		entries = append(entries, Entry{Key: key, Count: count})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Count == entries[j].Count {
+			return entries[i].Key < entries[j].Key
+		}
+		return entries[i].Count > entries[j].Count
+	})
+
+	if n > 0 && len(entries) > n {
+		entries = entries[:n]
+	}
+	return entries
+}
@@ -0,0 +1,32 @@
+package detector
+
+import (
+	"time"
+
+	"github.com/linkingthing/ddi-agent/pkg/kafkaproducer"
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+const TimeFormat = "2006-01-02 15:04:05"
+
+type kafkaAlerter struct {
+	node string
+}
+
+func (a *kafkaAlerter) SendAlert(alert *Alert) error {
+	return kafkaproducer.GetKafkaProducer().SendDNSSecurityMessage(alert.ToProto(a.node))
+}
+
+func (alert *Alert) ToProto(node string) *pb.DNSSecurityAlert {
+	return &pb.DNSSecurityAlert{
+		Node:        node,
+		Client:      alert.Client,
+		View:        alert.View,
+		Score:       alert.Score,
+		Reasons:     alert.Reasons,
+		SampleNames: alert.SampleNames,
+		WindowStart: alert.WindowStart.Format(TimeFormat),
+		WindowEnd:   alert.WindowEnd.Format(TimeFormat),
+		AlertTime:   time.Now().Format(TimeFormat),
+	}
+}
@@ -0,0 +1,350 @@
+package detector
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zdnscloud/cement/log"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/dns/querylog"
+)
+
+const (
+	ReasonLongLabel        = "long_label"
+	ReasonHighEntropy      = "high_entropy"
+	ReasonUniqueSubdomains = "unique_subdomains"
+	ReasonTxtNullVolume    = "txt_null_volume"
+	ReasonDGA              = "dga"
+
+	DefaultWindowSeconds        = 300
+	DefaultMaxLabelLength       = 40
+	DefaultMaxEntropy           = 4.0
+	DefaultMaxUniqueSubdomains  = 100
+	DefaultMaxTxtNullQueries    = 50
+	DefaultMinDGAScore          = 3
+	DefaultClientAlertThreshold = 20
+
+	MaxTrackedClients = 10000
+	MaxTrackedDomains = 10000
+	MaxSampleNames    = 5
+	AlertQueueSize    = 1000
+
+	minEntropyLength  = 20
+	minDGALabelLength = 10
+	dgaEntropy        = 3.2
+	dgaVowelRatio     = 0.25
+	dgaDigitRatio     = 0.3
+	dgaConsonantRun   = 5
+
+	QTypeTXT  = "TXT"
+	QTypeNULL = "NULL"
+)
+
+type Alert struct {
+	Client      string
+	View        string
+	Score       uint64
+	Reasons     map[string]uint64
+	SampleNames []string
+	WindowStart time.Time
+	WindowEnd   time.Time
+}
+
+type Alerter interface {
+	SendAlert(*Alert) error
+}
+
+type clientState struct {
+	view           string
+	txtNullQueries uint32
+	score          uint64
+	reasons        map[string]uint64
+	sampleNames    []string
+	alerted        bool
+}
+
+type Detector struct {
+	lock         sync.Mutex
+	conf         config.DetectorConf
+	window       time.Duration
+	allowDomains []string
+	allowClients []*net.IPNet
+	alerter      Alerter
+	alertLock    sync.RWMutex
+	alerts       chan *Alert
+	done         chan struct{}
+	closed       bool
+	windowStart  time.Time
+	clients      map[string]*clientState
+	subdomains   map[string]map[string]struct{}
+}
+
+func Init(conf *config.AgentConfig) (*Detector, error) {
+	if conf.DNS.Enabled == false || conf.DNS.Detector.Enabled == false {
+		return nil, nil
+	}
+
+	return New(conf.DNS.Detector, &kafkaAlerter{node: conf.Server.IP})
+}
+
+func New(conf config.DetectorConf, alerter Alerter) (*Detector, error) {
+	d, err := NewSync(conf, alerter)
+	if err != nil {
+		return nil, err
+	}
+
+	d.alerts = make(chan *Alert, AlertQueueSize)
+	d.done = make(chan struct{})
+	go d.sendAlerts()
+	return d, nil
+}
+
+func NewSync(conf config.DetectorConf, alerter Alerter) (*Detector, error) {
+	setDefaultThresholds(&conf)
+	d := &Detector{
+		conf:       conf,
+		window:     time.Duration(conf.WindowSeconds) * time.Second,
+		alerter:    alerter,
+		clients:    make(map[string]*clientState),
+		subdomains: make(map[string]map[string]struct{}),
+	}
+
+	for _, domain := range conf.AllowDomains {
+		d.allowDomains = append(d.allowDomains, strings.ToLower(strings.TrimSuffix(domain, ".")))
+	}
+
+	for _, client := range conf.AllowClients {
+		ipnet, err := parseIPOrIPNet(client)
+		if err != nil {
+			return nil, fmt.Errorf("allow client %s is invalid: %s", client, err.Error())
+		}
+		d.allowClients = append(d.allowClients, ipnet)
+	}
+
+	return d, nil
+}
+
+func setDefaultThresholds(conf *config.DetectorConf) {
+	if conf.WindowSeconds == 0 {
+		conf.WindowSeconds = DefaultWindowSeconds
+	}
+	if conf.MaxLabelLength == 0 {
+		conf.MaxLabelLength = DefaultMaxLabelLength
+	}
+	if conf.MaxEntropy == 0 {
+		conf.MaxEntropy = DefaultMaxEntropy
+	}
+	if conf.MaxUniqueSubdomains == 0 {
+		conf.MaxUniqueSubdomains = DefaultMaxUniqueSubdomains
+	}
+	if conf.MaxTxtNullQueries == 0 {
+		conf.MaxTxtNullQueries = DefaultMaxTxtNullQueries
+	}
+	if conf.MinDGAScore == 0 {
+		conf.MinDGAScore = DefaultMinDGAScore
+	}
+	if conf.ClientAlertThreshold == 0 {
+		conf.ClientAlertThreshold = DefaultClientAlertThreshold
+	}
+}
+
+func parseIPOrIPNet(s string) (*net.IPNet, error) {
+	if ip := net.ParseIP(s); ip != nil {
+		if ip.To4() != nil {
+			return &net.IPNet{IP: ip.To4(), Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+
+	_, ipnet, err := net.ParseCIDR(s)
+	return ipnet, err
+}
+
+func (d *Detector) HandleQuery(query *querylog.Query) {
+	if query.IsResponse {
+		return
+	}
+
+	alert := d.process(query)
+	if alert == nil {
+		return
+	}
+
+	if d.alerts == nil {
+		d.sendAlert(alert)
+		return
+	}
+
+	d.alertLock.RLock()
+	defer d.alertLock.RUnlock()
+	if d.closed {
+		return
+	}
+
+	select {
+	case d.alerts <- alert:
+	default:
+		log.Warnf("alert queue is full, drop dns security alert for client %s", alert.Client)
+	}
+}
+
+func (d *Detector) sendAlerts() {
+	defer close(d.done)
+	for alert := range d.alerts {
+		d.sendAlert(alert)
+	}
+}
+
+func (d *Detector) sendAlert(alert *Alert) {
+	if err := d.alerter.SendAlert(alert); err != nil {
+		log.Warnf("send dns security alert for client %s failed: %s", alert.Client, err.Error())
+	}
+}
+
+func (d *Detector) Close() {
+	if d.alerts == nil {
+		return
+	}
+
+	d.alertLock.Lock()
+	if d.closed == false {
+		d.closed = true
+		close(d.alerts)
+	}
+	d.alertLock.Unlock()
+	<-d.done
+}
+
+func (d *Detector) process(query *querylog.Query) *Alert {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.rotateWindow(query.Time)
+	if d.isAllowed(query) {
+		return nil
+	}
+
+	client, ok := d.clients[query.Client]
+	if ok == false {
+		if len(d.clients) >= MaxTrackedClients {
+			return nil
+		}
+		client = &clientState{view: query.View, reasons: make(map[string]uint64)}
+		d.clients[query.Client] = client
+	}
+
+	reasons := d.score(query.Name)
+	if query.Type == QTypeTXT || query.Type == QTypeNULL {
+		client.txtNullQueries += 1
+		if client.txtNullQueries > d.conf.MaxTxtNullQueries {
+			reasons = append(reasons, ReasonTxtNullVolume)
+		}
+	}
+
+	if len(reasons) == 0 {
+		return nil
+	}
+
+	client.score += 1
+	for _, reason := range reasons {
+		client.reasons[reason] += 1
+	}
+	if len(client.sampleNames) < MaxSampleNames {
+		client.sampleNames = append(client.sampleNames, query.Name)
+	}
+
+	if client.alerted || client.score < uint64(d.conf.ClientAlertThreshold) {
+		return nil
+	}
+
+	client.alerted = true
+	alert := &Alert{
+		Client:      query.Client,
+		View:        client.view,
+		Score:       client.score,
+		Reasons:     make(map[string]uint64, len(client.reasons)),
+		SampleNames: append([]string{}, client.sampleNames...),
+		WindowStart: d.windowStart,
+		WindowEnd:   d.windowStart.Add(d.window),
+	}
+	for reason, count := range client.reasons {
+		alert.Reasons[reason] = count
+	}
+
+	return alert
+}
+
+func (d *Detector) rotateWindow(now time.Time) {
+	if d.windowStart.IsZero() == false && now.Before(d.windowStart.Add(d.window)) &&
+		now.After(d.windowStart.Add(-d.window)) {
+		return
+	}
+
+	d.windowStart = now.Truncate(d.window)
+	d.clients = make(map[string]*clientState)
+	d.subdomains = make(map[string]map[string]struct{})
+}
+
+func (d *Detector) isAllowed(query *querylog.Query) bool {
+	for _, domain := range d.allowDomains {
+		if query.Name == domain || strings.HasSuffix(query.Name, "."+domain) {
+			return true
+		}
+	}
+
+	if ip := net.ParseIP(query.Client); ip != nil {
+		for _, ipnet := range d.allowClients {
+			if ipnet.Contains(ip) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (d *Detector) score(name string) []string {
+	var reasons []string
+	subdomain, parent := splitDomain(name)
+	if subdomain != "" {
+		if maxLabelLength(subdomain) > int(d.conf.MaxLabelLength) {
+			reasons = append(reasons, ReasonLongLabel)
+		}
+
+		if len(subdomain) >= minEntropyLength &&
+			shannonEntropy(strings.Replace(subdomain, ".", "", -1)) > d.conf.MaxEntropy {
+			reasons = append(reasons, ReasonHighEntropy)
+		}
+
+		if d.countSubdomain(parent, subdomain) > int(d.conf.MaxUniqueSubdomains) {
+			reasons = append(reasons, ReasonUniqueSubdomains)
+		}
+	}
+
+	if dgaScore(strings.SplitN(parent, ".", 2)[0]) >= int(d.conf.MinDGAScore) {
+		reasons = append(reasons, ReasonDGA)
+	}
+
+	return reasons
+}
+
+func (d *Detector) countSubdomain(parent, subdomain string) int {
+	subdomains, ok := d.subdomains[parent]
+	if ok == false {
+		if len(d.subdomains) >= MaxTrackedDomains {
+			return 0
+		}
+		subdomains = make(map[string]struct{})
+		d.subdomains[parent] = subdomains
+	}
+
+	if len(subdomains) <= int(d.conf.MaxUniqueSubdomains) {
+		subdomains[subdomain] = struct{}{}
+	}
+
+	return len(subdomains)
+}
@@ -0,0 +1,67 @@
+package detector
+
+import (
+	"testing"
+	"time"
+
+	ut "github.com/zdnscloud/cement/unittest"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/dns/querylog"
+)
+
+type fakeAlerter struct {
+	alerts []*Alert
+}
+
+func (a *fakeAlerter) SendAlert(alert *Alert) error {
+	a.alerts = append(a.alerts, alert)
+	return nil
+}
+
+func TestHandleQuery(t *testing.T) {
+	alerter := &fakeAlerter{}
+	d, err := New(config.DetectorConf{
+		MaxTxtNullQueries:    2,
+		ClientAlertThreshold: 3,
+		AllowClients:         []string{"10.0.1.0/24"},
+	}, alerter)
+	ut.Assert(t, err == nil, "new detector should succeed")
+
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		d.HandleQuery(&querylog.Query{Time: now, Client: "10.0.0.1", View: "v1", Name: "www.example.com", Type: QTypeTXT})
+		d.HandleQuery(&querylog.Query{Time: now, Client: "10.0.1.1", View: "v1", Name: "www.example.com", Type: QTypeTXT})
+		d.HandleQuery(&querylog.Query{Time: now, Client: "10.0.0.2", View: "v1", Name: "www.example.com", Type: "A"})
+		d.HandleQuery(&querylog.Query{Time: now, Client: "10.0.0.3", Name: "www.example.com", Type: QTypeTXT, IsResponse: true})
+	}
+	d.Close()
+
+	ut.Equal(t, len(alerter.alerts), 1)
+	alert := alerter.alerts[0]
+	ut.Equal(t, alert.Client, "10.0.0.1")
+	ut.Equal(t, alert.View, "v1")
+	ut.Equal(t, alert.Score, uint64(3))
+	ut.Equal(t, alert.Reasons, map[string]uint64{ReasonTxtNullVolume: 3})
+	ut.Equal(t, alert.SampleNames, []string{"www.example.com", "www.example.com", "www.example.com"})
+}
+
+func TestNewSync(t *testing.T) {
+	alerter := &fakeAlerter{}
+	d, err := NewSync(config.DetectorConf{MaxTxtNullQueries: 1, ClientAlertThreshold: 1}, alerter)
+	ut.Assert(t, err == nil, "new sync detector should succeed")
+
+	now := time.Now()
+	d.HandleQuery(&querylog.Query{Time: now, Client: "10.0.0.1", View: "v1", Name: "www.example.com", Type: QTypeTXT})
+	d.HandleQuery(&querylog.Query{Time: now, Client: "10.0.0.1", View: "v1", Name: "www.example.com", Type: QTypeTXT})
+	ut.Equal(t, len(alerter.alerts), 1)
+	d.Close()
+
+	async, err := New(config.DetectorConf{MaxTxtNullQueries: 1, ClientAlertThreshold: 1}, alerter)
+	ut.Assert(t, err == nil, "new detector should succeed")
+	async.Close()
+	async.Close()
+	async.HandleQuery(&querylog.Query{Time: now, Client: "10.0.0.2", View: "v1", Name: "www.example.com", Type: QTypeTXT})
+	async.HandleQuery(&querylog.Query{Time: now, Client: "10.0.0.2", View: "v1", Name: "www.example.com", Type: QTypeTXT})
+	ut.Equal(t, len(alerter.alerts), 1)
+}
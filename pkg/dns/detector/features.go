@@ -0,0 +1,109 @@
+package detector
+
+import (
+	"math"
+	"strings"
+)
+
+var secondLevelSuffixes = []string{"com", "net", "org", "edu", "gov", "co", "ac"}
+
+func splitDomain(name string) (string, string) {
+	labels := strings.Split(strings.TrimSuffix(name, "."), ".")
+	parentLabels := 2
+	if len(labels) >= 3 && isSecondLevelSuffix(labels[len(labels)-2]) {
+		parentLabels = 3
+	}
+
+	if len(labels) <= parentLabels {
+		return "", strings.Join(labels, ".")
+	}
+
+	return strings.Join(labels[:len(labels)-parentLabels], "."),
+		strings.Join(labels[len(labels)-parentLabels:], ".")
+}
+
+func isSecondLevelSuffix(label string) bool {
+	for _, suffix := range secondLevelSuffixes {
+		if label == suffix {
+			return true
+		}
+	}
+
+	return false
+}
+
+func maxLabelLength(name string) int {
+	var max int
+	for _, label := range strings.Split(name, ".") {
+		if len(label) > max {
+			max = len(label)
+		}
+	}
+
+	return max
+}
+
+func shannonEntropy(s string) float64 {
+	if len(s) == 0 {
+		return 0
+	}
+
+	var counts [256]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+
+	var entropy float64
+	length := float64(len(s))
+	for _, count := range counts {
+		if count != 0 {
+			p := float64(count) / length
+			entropy -= p * math.Log2(p)
+		}
+	}
+
+	return entropy
+}
+
+func dgaScore(label string) int {
+	if len(label) < minDGALabelLength {
+		return 0
+	}
+
+	var vowels, digits, consonantRun, maxConsonantRun int
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		switch {
+		case strings.IndexByte("aeiou", c) != -1:
+			vowels++
+			consonantRun = 0
+		case c >= '0' && c <= '9':
+			digits++
+			consonantRun = 0
+		case c >= 'a' && c <= 'z':
+			consonantRun++
+			if consonantRun > maxConsonantRun {
+				maxConsonantRun = consonantRun
+			}
+		default:
+			consonantRun = 0
+		}
+	}
+
+	var score int
+	length := float64(len(label))
+	if shannonEntropy(label) >= dgaEntropy {
+		score++
+	}
+	if float64(vowels)/length < dgaVowelRatio {
+		score++
+	}
+	if float64(digits)/length > dgaDigitRatio {
+		score++
+	}
+	if maxConsonantRun >= dgaConsonantRun {
+		score++
+	}
+
+	return score
+}
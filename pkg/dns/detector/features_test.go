@@ -0,0 +1,27 @@
+package detector
+
+import (
+	"testing"
+
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+func TestSplitDomain(t *testing.T) {
+	sub, parent := splitDomain("a.b.example.com")
+	ut.Equal(t, sub, "a.b")
+	ut.Equal(t, parent, "example.com")
+
+	sub, parent = splitDomain("www.example.com.cn")
+	ut.Equal(t, sub, "www")
+	ut.Equal(t, parent, "example.com.cn")
+
+	sub, parent = splitDomain("example.com")
+	ut.Equal(t, sub, "")
+	ut.Equal(t, parent, "example.com")
+}
+
+func TestDGAScore(t *testing.T) {
+	ut.Assert(t, dgaScore("google") == 0, "short label should not be scored")
+	ut.Assert(t, dgaScore("linkingthing") < DefaultMinDGAScore, "normal label should not be dga")
+	ut.Assert(t, dgaScore("xkqztvbwrmnpdf") >= DefaultMinDGAScore, "random label should be dga")
+}
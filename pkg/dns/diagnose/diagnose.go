@@ -0,0 +1,283 @@
+package diagnose
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/zdnscloud/g53"
+
+	"github.com/linkingthing/ddi-agent/pkg/dns/dnsclient"
+)
+
+const (
+	DNSPort       = "53"
+	MaxTraceSteps = 32
+	MaxTimeout    = 30 * time.Second
+)
+
+type Request struct {
+	Name             string
+	Type             string
+	KeyName          string
+	Secret           string
+	Source           string
+	RecursionDesired bool
+	DnssecOK         bool
+	ClientSubnet     string
+	Timeout          time.Duration
+
+	name   *g53.Name
+	typ    g53.RRType
+	subnet *net.IPNet
+}
+
+type Step struct {
+	Server  string
+	Zone    string
+	Message *g53.Message
+	Elapsed time.Duration
+	Err     error
+}
+
+type Diagnoser struct {
+	address string
+	port    string
+}
+
+func New(address string) *Diagnoser {
+	return &Diagnoser{address: address, port: DNSPort}
+}
+
+func (req *Request) validate() error {
+	name, err := g53.NameFromString(req.Name)
+	if err != nil {
+		return fmt.Errorf("invalid query name %s: %s", req.Name, err.Error())
+	}
+	req.name = name
+
+	if req.Type == "" {
+		req.typ = g53.RR_A
+	} else if typ, err := g53.TypeFromString(req.Type); err != nil {
+		return fmt.Errorf("invalid query type %s: %s", req.Type, err.Error())
+	} else {
+		req.typ = typ
+	}
+
+	if req.Source != "" && net.ParseIP(req.Source) == nil {
+		return fmt.Errorf("invalid source address %s", req.Source)
+	}
+
+	if req.ClientSubnet != "" {
+		subnet, err := parseClientSubnet(req.ClientSubnet)
+		if err != nil {
+			return err
+		}
+		req.subnet = subnet
+	}
+
+	if req.Timeout == 0 {
+		req.Timeout = dnsclient.DefaultTimeout
+	} else if req.Timeout > MaxTimeout {
+		req.Timeout = MaxTimeout
+	}
+
+	return nil
+}
+
+func parseClientSubnet(s string) (*net.IPNet, error) {
+	if ip := net.ParseIP(s); ip != nil {
+		if ip.To4() != nil {
+			return &net.IPNet{IP: ip.To4(), Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+
+	_, subnet, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client subnet %s: %s", s, err.Error())
+	}
+
+	return subnet, nil
+}
+
+func (req *Request) makeQuery(name *g53.Name, typ g53.RRType, recursionDesired, sign bool) (*g53.Message, error) {
+	msg := g53.MakeQuery(name, typ, dnsclient.EDNSUdpSize, req.DnssecOK)
+	msg.Header.SetFlag(g53.FLAG_RD, recursionDesired)
+	if req.subnet != nil {
+		msg.Edns.Options = append(msg.Edns.Options, &clientSubnetOpt{subnet: req.subnet})
+	}
+
+	if sign && req.Secret != "" {
+		if err := dnsclient.Sign(msg, req.KeyName, req.Secret); err != nil {
+			return nil, err
+		}
+	}
+
+	return msg, nil
+}
+
+func (d *Diagnoser) localClient(req *Request) *dnsclient.Client {
+	return &dnsclient.Client{Address: d.address, Source: req.Source, Timeout: req.Timeout}
+}
+
+func exchange(client *dnsclient.Client, zone string, msg *g53.Message) *Step {
+	start := time.Now()
+	resp, err := client.Exchange(msg)
+	return &Step{Server: client.Address, Zone: zone, Message: resp, Elapsed: time.Since(start), Err: err}
+}
+
+func (d *Diagnoser) Query(req *Request) (*Step, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	msg, err := req.makeQuery(req.name, req.typ, req.RecursionDesired, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return exchange(d.localClient(req), "", msg), nil
+}
+
+func (d *Diagnoser) Trace(req *Request) ([]*Step, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	msg, err := req.makeQuery(g53.Root, g53.RR_NS, true, true)
+	if err != nil {
+		return nil, err
+	}
+
+	local := d.localClient(req)
+	step := exchange(local, g53.Root.String(false), msg)
+	steps := []*Step{step}
+	if step.Err != nil {
+		return steps, nil
+	}
+
+	ns := findNS(step.Message.GetSection(g53.AnswerSection), g53.Root)
+	if ns == nil {
+		step.Err = fmt.Errorf("no root name servers found")
+		return steps, nil
+	}
+
+	for len(steps) < MaxTraceSteps {
+		servers := d.serverAddresses(req, ns, step.Message)
+		if len(servers) == 0 {
+			step.Err = fmt.Errorf("no address found for name servers of %s", ns.Name.String(false))
+			return steps, nil
+		}
+
+		var resp *g53.Message
+		for _, server := range servers {
+			if len(steps) >= MaxTraceSteps {
+				break
+			}
+
+			msg, err := req.makeQuery(req.name, req.typ, false, false)
+			if err != nil {
+				return nil, err
+			}
+
+			step = exchange(&dnsclient.Client{Address: server, Timeout: req.Timeout}, ns.Name.String(false), msg)
+			steps = append(steps, step)
+			if step.Err == nil {
+				resp = step.Message
+				break
+			}
+		}
+
+		if resp == nil {
+			return steps, nil
+		}
+
+		next := referral(resp, ns.Name, req.name)
+		if next == nil {
+			return steps, nil
+		}
+		ns = next
+	}
+
+	return steps, nil
+}
+
+func findNS(section g53.Section, name *g53.Name) *g53.RRset {
+	for _, rrset := range section {
+		if rrset.Type == g53.RR_NS && rrset.Name.Equals(name) {
+			return rrset
+		}
+	}
+
+	return nil
+}
+
+func referral(msg *g53.Message, zone, qname *g53.Name) *g53.RRset {
+	if msg.Header.Rcode != g53.R_NOERROR || msg.Header.GetFlag(g53.FLAG_AA) ||
+		len(msg.GetSection(g53.AnswerSection)) != 0 {
+		return nil
+	}
+
+	for _, rrset := range msg.GetSection(g53.AuthSection) {
+		if rrset.Type == g53.RR_NS && rrset.Name.Equals(zone) == false &&
+			rrset.Name.IsSubDomain(zone) && qname.IsSubDomain(rrset.Name) {
+			return rrset
+		}
+	}
+
+	return nil
+}
+
+func (d *Diagnoser) serverAddresses(req *Request, ns *g53.RRset, msg *g53.Message) []string {
+	var addresses []string
+	for _, rdata := range ns.Rdatas {
+		nsName := rdata.(*g53.NS).Name
+		ips := glueAddresses(msg, nsName)
+		if len(ips) == 0 {
+			ips = d.resolveAddresses(req, nsName)
+		}
+
+		for _, ip := range ips {
+			addresses = append(addresses, net.JoinHostPort(ip.String(), d.port))
+		}
+	}
+
+	return addresses
+}
+
+func glueAddresses(msg *g53.Message, name *g53.Name) []net.IP {
+	var ips []net.IP
+	for _, rrset := range msg.GetSection(g53.AdditionalSection) {
+		if rrset.Type == g53.RR_A && rrset.Name.Equals(name) {
+			for _, rdata := range rrset.Rdatas {
+				ips = append(ips, rdata.(*g53.A).Host)
+			}
+		}
+	}
+
+	return ips
+}
+
+func (d *Diagnoser) resolveAddresses(req *Request, name *g53.Name) []net.IP {
+	msg, err := req.makeQuery(name, g53.RR_A, true, true)
+	if err != nil {
+		return nil
+	}
+
+	resp, err := d.localClient(req).Exchange(msg)
+	if err != nil {
+		return nil
+	}
+
+	var ips []net.IP
+	for _, rrset := range resp.GetSection(g53.AnswerSection) {
+		if rrset.Type == g53.RR_A {
+			for _, rdata := range rrset.Rdatas {
+				ips = append(ips, rdata.(*g53.A).Host)
+			}
+		}
+	}
+
+	return ips
+}
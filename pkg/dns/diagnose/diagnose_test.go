@@ -0,0 +1,141 @@
+package diagnose
+
+import (
+	"net"
+	"strconv"
+	"testing"
+
+	ut "github.com/zdnscloud/cement/unittest"
+	"github.com/zdnscloud/g53"
+	"github.com/zdnscloud/g53/util"
+
+	"github.com/linkingthing/ddi-agent/pkg/dns/dnsclient"
+)
+
+type zoneServer struct {
+	answers   []string
+	authority []string
+	glue      []string
+	queries   []*g53.Message
+}
+
+func mustRRset(t *testing.T, s string) *g53.RRset {
+	rrset, err := g53.RRsetFromString(s)
+	ut.Assert(t, err == nil, "rrset %s should be valid", s)
+	return rrset
+}
+
+func (s *zoneServer) serve(t *testing.T, conn net.PacketConn) {
+	buf := make([]byte, dnsclient.EDNSUdpSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		query, err := g53.MessageFromWire(util.NewInputBuffer(buf[:n]))
+		if err != nil {
+			continue
+		}
+		s.queries = append(s.queries, query)
+
+		resp := query.MakeResponse()
+		for _, answer := range s.answers {
+			rrset := mustRRset(t, answer)
+			if rrset.Type == query.Question.Type && rrset.Name.Equals(query.Question.Name) {
+				resp.AddRRset(g53.AnswerSection, rrset)
+				resp.Header.SetFlag(g53.FLAG_AA, true)
+			}
+		}
+		if len(resp.GetSection(g53.AnswerSection)) == 0 {
+			for _, authority := range s.authority {
+				resp.AddRRset(g53.AuthSection, mustRRset(t, authority))
+			}
+		}
+		for _, glue := range s.glue {
+			resp.AddRRset(g53.AdditionalSection, mustRRset(t, glue))
+		}
+
+		resp.RecalculateSectionRRCount()
+		render := g53.NewMsgRender()
+		resp.Rend(render)
+		conn.WriteTo(render.Data(), addr)
+	}
+}
+
+func listen(t *testing.T, ip string, port int) net.PacketConn {
+	conn, err := net.ListenPacket("udp", net.JoinHostPort(ip, strconv.Itoa(port)))
+	ut.Assert(t, err == nil, "listen udp on %s should succeed", ip)
+	return conn
+}
+
+func TestQuery(t *testing.T) {
+	conn := listen(t, "127.0.0.1", 0)
+	defer conn.Close()
+	server := &zoneServer{answers: []string{"www.example.com. 300 IN A 1.1.1.1"}}
+	go server.serve(t, conn)
+
+	d := New(conn.LocalAddr().String())
+	step, err := d.Query(&Request{Name: "www.example.com", KeyName: "keyv1", Secret: "c2VjcmV0",
+		RecursionDesired: true, DnssecOK: true, ClientSubnet: "10.1.2.0/24"})
+	ut.Assert(t, err == nil, "query should succeed")
+	ut.Assert(t, step.Err == nil, "query should get response")
+
+	query := server.queries[0]
+	ut.Equal(t, query.Question.Type, g53.RR_A)
+	ut.Equal(t, query.Header.GetFlag(g53.FLAG_RD), true)
+	edns := messageEdns(query)
+	ut.Assert(t, edns != nil, "query should have edns")
+	ut.Equal(t, edns.DnssecAware, true)
+	ut.Equal(t, len(edns.Options), 1)
+	ut.Equal(t, edns.Options[0].String(), "; CLIENT-SUBNET: 10.1.2.0/24/0\n")
+	ut.Assert(t, query.Tsig != nil, "query should be signed")
+
+	m := step.ToProto()
+	ut.Equal(t, m.Rcode, "NOERROR")
+	ut.Equal(t, m.Flags, []string{"qr", "aa", "rd"})
+	ut.Equal(t, len(m.Answer), 1)
+	ut.Equal(t, m.Answer[0].Name, "www.example.com.")
+	ut.Equal(t, m.Answer[0].Type, "A")
+	ut.Equal(t, m.Answer[0].Rdata, "1.1.1.1")
+
+	_, err = d.Query(&Request{Name: "www.example.com", Type: "BAD"})
+	ut.Assert(t, err != nil, "invalid type should fail")
+	_, err = d.Query(&Request{Name: "www.example.com", ClientSubnet: "10.1.2.0/33"})
+	ut.Assert(t, err != nil, "invalid client subnet should fail")
+}
+
+func TestTrace(t *testing.T) {
+	local := listen(t, "127.0.0.1", 0)
+	defer local.Close()
+	port := local.LocalAddr().(*net.UDPAddr).Port
+	tld := listen(t, "127.0.0.2", port)
+	defer tld.Close()
+	auth := listen(t, "127.0.0.3", port)
+	defer auth.Close()
+
+	go (&zoneServer{
+		answers:   []string{". 518400 IN NS a.root-servers.net."},
+		authority: []string{"com. 172800 IN NS a.gtld-servers.net."},
+		glue:      []string{"a.root-servers.net. 518400 IN A 127.0.0.1", "a.gtld-servers.net. 172800 IN A 127.0.0.2"},
+	}).serve(t, local)
+	go (&zoneServer{
+		authority: []string{"example.com. 172800 IN NS ns1.example.com."},
+		glue:      []string{"ns1.example.com. 172800 IN A 127.0.0.3"},
+	}).serve(t, tld)
+	go (&zoneServer{answers: []string{"www.example.com. 300 IN A 1.1.1.1"}}).serve(t, auth)
+
+	d := &Diagnoser{address: local.LocalAddr().String(), port: strconv.Itoa(port)}
+	steps, err := d.Trace(&Request{Name: "www.example.com"})
+	ut.Assert(t, err == nil, "trace should succeed")
+	ut.Equal(t, len(steps), 4)
+	ut.Equal(t, steps[0].Zone, ".")
+	ut.Equal(t, steps[1].Zone, ".")
+	ut.Equal(t, steps[2].Zone, "com.")
+	ut.Equal(t, steps[2].Server, tld.LocalAddr().String())
+	ut.Equal(t, steps[3].Zone, "example.com.")
+	ut.Equal(t, steps[3].Server, auth.LocalAddr().String())
+	m := steps[3].ToProto()
+	ut.Equal(t, m.ErrorMessage, "")
+	ut.Equal(t, m.Answer[0].Rdata, "1.1.1.1")
+}
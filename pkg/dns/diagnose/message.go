@@ -0,0 +1,136 @@
+package diagnose
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/zdnscloud/g53"
+
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+const (
+	familyIPv4 = 1
+	familyIPv6 = 2
+)
+
+type clientSubnetOpt struct {
+	subnet *net.IPNet
+}
+
+func (opt *clientSubnetOpt) Rend(render *g53.MsgRender) {
+	ones, _ := opt.subnet.Mask.Size()
+	family, ip := uint16(familyIPv6), opt.subnet.IP.To16()
+	if ip4 := opt.subnet.IP.To4(); ip4 != nil {
+		family, ip = familyIPv4, ip4
+	}
+
+	addrLen := (ones + 7) / 8
+	render.WriteUint16(g53.EDNS_SUBNET)
+	render.WriteUint16(uint16(4 + addrLen))
+	render.WriteUint16(family)
+	render.WriteUint8(uint8(ones))
+	render.WriteUint8(0)
+	render.WriteData([]byte(ip.Mask(opt.subnet.Mask))[:addrLen])
+}
+
+func (opt *clientSubnetOpt) String() string {
+	return fmt.Sprintf("; CLIENT-SUBNET: %s/0\n", opt.subnet.String())
+}
+
+var headerFlags = []struct {
+	flag g53.FlagField
+	name string
+}{
+	{g53.FLAG_QR, "qr"},
+	{g53.FLAG_AA, "aa"},
+	{g53.FLAG_TC, "tc"},
+	{g53.FLAG_RD, "rd"},
+	{g53.FLAG_RA, "ra"},
+	{g53.FLAG_AD, "ad"},
+	{g53.FLAG_CD, "cd"},
+}
+
+func (s *Step) ToProto() *pb.DiagnoseMessage {
+	m := &pb.DiagnoseMessage{
+		Server:              s.Server,
+		Zone:                s.Zone,
+		ElapsedMicroseconds: uint64(s.Elapsed / time.Microsecond),
+	}
+	if s.Err != nil {
+		m.ErrorMessage = s.Err.Error()
+	}
+	if s.Message == nil {
+		return m
+	}
+
+	header := &s.Message.Header
+	m.Id = uint32(header.Id)
+	m.Opcode = header.Opcode.String()
+	m.Rcode = header.Rcode.String()
+	for _, f := range headerFlags {
+		if header.GetFlag(f.flag) {
+			m.Flags = append(m.Flags, f.name)
+		}
+	}
+
+	m.Answer = sectionToProto(s.Message.GetSection(g53.AnswerSection))
+	m.Authority = sectionToProto(s.Message.GetSection(g53.AuthSection))
+	for _, rrset := range s.Message.GetSection(g53.AdditionalSection) {
+		if rrset.Type != g53.RR_OPT {
+			m.Additional = append(m.Additional, rrsetToProto(rrset)...)
+		}
+	}
+
+	if edns := messageEdns(s.Message); edns != nil {
+		m.Edns = true
+		m.EdnsUdpSize = uint32(edns.UdpSize)
+		m.DnssecOk = edns.DnssecAware
+		for _, opt := range edns.Options {
+			m.EdnsOptions = append(m.EdnsOptions, strings.TrimSpace(strings.TrimPrefix(opt.String(), ";")))
+		}
+	}
+
+	m.Tsig = s.Message.Tsig != nil
+	return m
+}
+
+func messageEdns(msg *g53.Message) *g53.EDNS {
+	if msg.Edns != nil {
+		return msg.Edns
+	}
+
+	for _, rrset := range msg.GetSection(g53.AdditionalSection) {
+		if rrset.Type == g53.RR_OPT {
+			return g53.EdnsFromRRset(rrset)
+		}
+	}
+
+	return nil
+}
+
+func sectionToProto(section g53.Section) []*pb.DiagnoseRR {
+	var rrs []*pb.DiagnoseRR
+	for _, rrset := range section {
+		rrs = append(rrs, rrsetToProto(rrset)...)
+	}
+
+	return rrs
+}
+
+func rrsetToProto(rrset *g53.RRset) []*pb.DiagnoseRR {
+	rrs := make([]*pb.DiagnoseRR, 0, len(rrset.Rdatas))
+	for _, rdata := range rrset.Rdatas {
+		rrs = append(rrs, &pb.DiagnoseRR{
+			Name:  rrset.Name.String(false),
+			Ttl:   uint32(rrset.Ttl),
+			Class: rrset.Class.String(),
+			Type:  rrset.Type.String(),
+			Rdata: rdata.String(),
+		})
+	}
+
+	return rrs
+}
@@ -16,5 +16,6 @@ func PersistentResources() []restresource.Resource {
 		&resource.AgentRedirection{},
 		&resource.AgentDnsGlobalConfig{},
 		&resource.AgentNginxProxy{},
+		&resource.AgentNginxLocation{},
 	}
 }
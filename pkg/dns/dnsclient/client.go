@@ -0,0 +1,145 @@
+package dnsclient
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/zdnscloud/g53"
+	"github.com/zdnscloud/g53/util"
+)
+
+const (
+	TSIGAlgorithm  = "hmac-sha256"
+	EDNSUdpSize    = 4096
+	DefaultTimeout = 5 * time.Second
+)
+
+type Client struct {
+	Address string
+	Source  string
+	Timeout time.Duration
+}
+
+func Sign(msg *g53.Message, keyName, secret string) error {
+	tsig, err := g53.NewTSIG(keyName, secret, TSIGAlgorithm)
+	if err != nil {
+		return fmt.Errorf("create tsig with key %s failed: %s", keyName, err.Error())
+	}
+
+	msg.SetTSIG(tsig)
+	return nil
+}
+
+func (c *Client) Exchange(msg *g53.Message) (*g53.Message, error) {
+	msg.RecalculateSectionRRCount()
+	render := g53.NewMsgRender()
+	msg.Rend(render)
+	query := render.Data()
+
+	resp, err := c.exchangeUDP(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Header.GetFlag(g53.FLAG_TC) {
+		if resp, err = c.exchangeTCP(query); err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.Header.Id != msg.Header.Id {
+		return nil, fmt.Errorf("response id %d mismatch query id %d", resp.Header.Id, msg.Header.Id)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) dialer(network string) (*net.Dialer, error) {
+	dialer := &net.Dialer{Timeout: c.timeout()}
+	if c.Source == "" {
+		return dialer, nil
+	}
+
+	ip := net.ParseIP(c.Source)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid source address %s", c.Source)
+	}
+
+	if network == "udp" {
+		dialer.LocalAddr = &net.UDPAddr{IP: ip}
+	} else {
+		dialer.LocalAddr = &net.TCPAddr{IP: ip}
+	}
+	return dialer, nil
+}
+
+func (c *Client) timeout() time.Duration {
+	if c.Timeout == 0 {
+		return DefaultTimeout
+	}
+
+	return c.Timeout
+}
+
+func (c *Client) exchangeUDP(query []byte) (*g53.Message, error) {
+	dialer, err := c.dialer("udp")
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := dialer.Dial("udp", c.Address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(c.timeout()))
+	if _, err := conn.Write(query); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, EDNSUdpSize)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return g53.MessageFromWire(util.NewInputBuffer(buf[:n]))
+}
+
+func (c *Client) exchangeTCP(query []byte) (*g53.Message, error) {
+	dialer, err := c.dialer("tcp")
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := dialer.Dial("tcp", c.Address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(c.timeout()))
+	if err := binary.Write(conn, binary.BigEndian, uint16(len(query))); err != nil {
+		return nil, err
+	}
+
+	if _, err := conn.Write(query); err != nil {
+		return nil, err
+	}
+
+	var size uint16
+	if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(conn, data); err != nil {
+		return nil, err
+	}
+
+	return g53.MessageFromWire(util.NewInputBuffer(data))
+}
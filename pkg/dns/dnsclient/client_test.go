@@ -0,0 +1,31 @@
+package dnsclient
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+func TestExchangeTCPTimeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	ut.Assert(t, err == nil, "listen should succeed")
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	c := &Client{Address: listener.Addr().String(), Timeout: 100 * time.Millisecond}
+	start := time.Now()
+	_, err = c.exchangeTCP([]byte{0, 1})
+	ut.Assert(t, err != nil, "exchange with silent server should fail")
+	ut.Assert(t, time.Since(start) < time.Second, "exchange should honor timeout")
+}
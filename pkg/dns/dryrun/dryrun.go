@@ -0,0 +1,148 @@
+package dryrun
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	restdb "github.com/zdnscloud/gorest/db"
+
+	"github.com/linkingthing/ddi-agent/pkg/dns/snapshot"
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+var errDone = fmt.Errorf("dry run done")
+
+type file struct {
+	baseline    []byte
+	hasBaseline bool
+	content     []byte
+	removed     bool
+}
+
+type DryRun struct {
+	Tx    restdb.Transaction
+	files map[string]*file
+	paths []string
+}
+
+func New() *DryRun {
+	return &DryRun{files: make(map[string]*file)}
+}
+
+func Run(store restdb.ResourceStore, fn func(*DryRun) error) (*DryRun, error) {
+	d := New()
+	err := restdb.WithTx(store, func(tx restdb.Transaction) error {
+		d.Tx = tx
+		if err := fn(d); err != nil {
+			return err
+		}
+		return errDone
+	})
+	if err != errDone {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (d *DryRun) file(path string) *file {
+	f, ok := d.files[path]
+	if ok == false {
+		f = &file{}
+		d.files[path] = f
+		d.paths = append(d.paths, path)
+	}
+
+	return f
+}
+
+func (d *DryRun) Write(path string, content []byte) {
+	f := d.file(path)
+	f.content = append([]byte{}, content...)
+	f.removed = false
+}
+
+func (d *DryRun) Remove(path string) {
+	f := d.file(path)
+	f.content = nil
+	f.removed = true
+}
+
+func (d *DryRun) SetBaseline(path string, content []byte) {
+	if f := d.file(path); f.hasBaseline == false {
+		f.baseline = content
+		f.hasBaseline = true
+	}
+}
+
+func (d *DryRun) Exists(path string) (bool, bool) {
+	if f, ok := d.files[path]; ok {
+		return f.removed == false, true
+	}
+
+	return false, false
+}
+
+func (d *DryRun) Overlay(dir string) (map[string][]byte, []string) {
+	files := make(map[string][]byte)
+	var removed []string
+	for _, path := range d.paths {
+		if filepath.Dir(path) != dir {
+			continue
+		}
+
+		if f := d.files[path]; f.removed {
+			removed = append(removed, path)
+		} else {
+			files[path] = f.content
+		}
+	}
+
+	return files, removed
+}
+
+func (d *DryRun) Diffs() ([]*pb.ConfigFileDiff, error) {
+	var diffs []*pb.ConfigFileDiff
+	for _, path := range d.paths {
+		f := d.files[path]
+		before, existed := f.baseline, f.hasBaseline && f.baseline != nil
+		if f.hasBaseline == false {
+			data, err := ioutil.ReadFile(path)
+			if err != nil && os.IsNotExist(err) == false {
+				return nil, fmt.Errorf("read file %s failed: %s", path, err.Error())
+			}
+			before, existed = data, err == nil
+		}
+
+		diff := &pb.ConfigFileDiff{Path: path}
+		switch {
+		case f.removed && existed == false:
+			continue
+		case f.removed:
+			diff.Status = snapshot.StatusRemoved
+		case existed == false:
+			diff.Status = snapshot.StatusAdded
+		case bytes.Equal(before, f.content):
+			continue
+		default:
+			diff.Status = snapshot.StatusModified
+		}
+
+		diff.Diff = snapshot.UnifiedDiff(splitLines(before), splitLines(f.content))
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, nil
+}
+
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+
+	return strings.Split(string(content), "\n")
+}
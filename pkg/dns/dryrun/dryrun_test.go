@@ -0,0 +1,99 @@
+package dryrun
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ut "github.com/zdnscloud/cement/unittest"
+	restdb "github.com/zdnscloud/gorest/db"
+
+	"github.com/linkingthing/ddi-agent/pkg/dns/snapshot"
+)
+
+type fakeStore struct {
+	restdb.ResourceStore
+	tx *fakeTx
+}
+
+func (s *fakeStore) Begin() (restdb.Transaction, error) {
+	s.tx = &fakeTx{}
+	return s.tx, nil
+}
+
+type fakeTx struct {
+	restdb.Transaction
+	committed  bool
+	rolledBack bool
+}
+
+func (tx *fakeTx) Commit() error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *fakeTx) Rollback() error {
+	tx.rolledBack = true
+	return nil
+}
+
+func TestRunRollsBack(t *testing.T) {
+	store := &fakeStore{}
+	d, err := Run(store, func(d *DryRun) error {
+		ut.Assert(t, d.Tx == store.tx, "dry run should use the store transaction")
+		d.Write("/etc/named.conf", []byte("options {};"))
+		return nil
+	})
+	ut.Assert(t, err == nil, "dry run should succeed: %v", err)
+	ut.Assert(t, d != nil, "dry run should return its state")
+	ut.Equal(t, store.tx.committed, false)
+	ut.Equal(t, store.tx.rolledBack, true)
+
+	_, err = Run(store, func(d *DryRun) error {
+		return fmt.Errorf("invalid view")
+	})
+	ut.Assert(t, err != nil && err.Error() == "invalid view", "dry run should return apply error: %v", err)
+	ut.Equal(t, store.tx.committed, false)
+	ut.Equal(t, store.tx.rolledBack, true)
+}
+
+func TestDiffs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dryrun")
+	ut.Assert(t, err == nil, "create temp dir should succeed")
+	defer os.RemoveAll(dir)
+
+	modified := filepath.Join(dir, "named_view.conf")
+	unchanged := filepath.Join(dir, "named_acl.conf")
+	removed := filepath.Join(dir, "redirect_v1")
+	added := filepath.Join(dir, "example.com#v1.zone")
+	for _, path := range []string{modified, unchanged, removed} {
+		ut.Assert(t, ioutil.WriteFile(path, []byte("a\nb"), 0644) == nil, "write %s should succeed", path)
+	}
+
+	d := New()
+	d.Write(modified, []byte("a\nc"))
+	d.Write(unchanged, []byte("a\nb"))
+	d.Remove(removed)
+	d.Remove(filepath.Join(dir, "missing"))
+	d.SetBaseline(added, nil)
+	d.Write(added, []byte("a"))
+
+	diffs, err := d.Diffs()
+	ut.Assert(t, err == nil, "diffs should succeed")
+	ut.Equal(t, len(diffs), 3)
+	ut.Equal(t, diffs[0].Path, modified)
+	ut.Equal(t, diffs[0].Status, snapshot.StatusModified)
+	ut.Equal(t, diffs[0].Diff, snapshot.UnifiedDiff([]string{"a", "b"}, []string{"a", "c"}))
+	ut.Equal(t, diffs[1].Path, removed)
+	ut.Equal(t, diffs[1].Status, snapshot.StatusRemoved)
+	ut.Equal(t, diffs[2].Path, added)
+	ut.Equal(t, diffs[2].Status, snapshot.StatusAdded)
+
+	exists, tracked := d.Exists(removed)
+	ut.Assert(t, exists == false && tracked, "removed file should be tracked as missing")
+	files, removedPaths := d.Overlay(dir)
+	ut.Equal(t, len(files), 3)
+	ut.Equal(t, removedPaths, []string{removed, filepath.Join(dir, "missing")})
+}
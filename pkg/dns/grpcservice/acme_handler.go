@@ -0,0 +1,175 @@
+package grpcservice
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"time"
+
+	"github.com/zdnscloud/cement/log"
+	restdb "github.com/zdnscloud/gorest/db"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/acmeclient"
+	"github.com/linkingthing/ddi-agent/pkg/agentmetric"
+	"github.com/linkingthing/ddi-agent/pkg/db"
+	"github.com/linkingthing/ddi-agent/pkg/dns/resource"
+	"github.com/linkingthing/ddi-agent/pkg/kafkaproducer"
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+const (
+	CertificateEventIssued  = "issued"
+	CertificateEventRenewed = "renewed"
+	CertificateEventFailed  = "failed"
+	CertificateTimeFormat   = "2006-01-02 15:04:05"
+	acmeObtainTimeout       = 5 * time.Minute
+)
+
+func (handler *DNSHandler) initACME(conf config.ACMEConf) error {
+	if conf.Enabled == false {
+		return nil
+	}
+
+	client, err := acmeclient.New(&conf)
+	if err != nil {
+		return fmt.Errorf("init acme client failed: %s", err.Error())
+	}
+
+	handler.acme = client
+	handler.acmeConf = conf
+	handler.acmeKick = make(chan struct{}, 1)
+	handler.acmeQuit = make(chan struct{})
+	handler.acmeDone = make(chan struct{})
+	return nil
+}
+
+func (handler *DNSHandler) startCertificateRenewal() {
+	if handler.acme == nil {
+		return
+	}
+
+	go handler.keepCertificatesRenewed(acmeclient.CheckInterval(&handler.acmeConf))
+}
+
+func (handler *DNSHandler) stopCertificateRenewal() {
+	if handler.acmeQuit != nil {
+		close(handler.acmeQuit)
+		<-handler.acmeDone
+	}
+}
+
+func (handler *DNSHandler) kickCertificateRenewal() {
+	if handler.acmeKick == nil {
+		return
+	}
+
+	select {
+	case handler.acmeKick <- struct{}{}:
+	default:
+	}
+}
+
+func (handler *DNSHandler) keepCertificatesRenewed(interval time.Duration) {
+	defer close(handler.acmeDone)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		handler.renewCertificates()
+		select {
+		case <-handler.acmeQuit:
+			return
+		case <-ticker.C:
+		case <-handler.acmeKick:
+		}
+	}
+}
+
+func (handler *DNSHandler) renewCertificates() {
+	var proxies []*resource.AgentNginxProxy
+	if err := db.GetResources(map[string]interface{}{"is_https": true, "acme_managed": true}, &proxies); err != nil {
+		log.Warnf("list acme managed nginx proxies failed: %s", err.Error())
+		return
+	}
+
+	renewBefore := acmeclient.RenewBefore(&handler.acmeConf)
+	for _, proxy := range proxies {
+		notAfter, err := handler.certificateExpiry(proxy.Domain)
+		if err == nil {
+			agentmetric.SetCertificateExpiry(proxy.Domain, notAfter)
+			if time.Until(notAfter) > renewBefore {
+				continue
+			}
+		}
+
+		if err := handler.obtainCertificate(proxy.Domain); err != nil {
+			log.Warnf("obtain certificate for %s failed: %s", proxy.Domain, err.Error())
+			handler.sendCertificateEvent(proxy.Domain, CertificateEventFailed, time.Time{}, err)
+		}
+	}
+}
+
+func (handler *DNSHandler) obtainCertificate(domain string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), acmeObtainTimeout)
+	defer cancel()
+
+	_, err := handler.certificateExpiry(domain)
+	event := CertificateEventIssued
+	if err == nil {
+		event = CertificateEventRenewed
+	}
+
+	cert, err := handler.acme.Obtain(ctx, domain)
+	if err != nil {
+		return err
+	}
+
+	if err := handler.withTx(ctx, func(tx restdb.Transaction) error {
+		var proxies []*resource.AgentNginxProxy
+		if err := tx.Fill(map[string]interface{}{"domain": domain, "is_https": true, "acme_managed": true}, &proxies); err != nil {
+			return fmt.Errorf("get nginx proxy %s failed: %s", domain, err.Error())
+		} else if len(proxies) == 0 {
+			return fmt.Errorf("nginx proxy %s has been deleted", domain)
+		}
+
+		return handler.addNginxHttpsFile(tx, cert.Key, cert.Crt, proxies[0])
+	}); err != nil {
+		return fmt.Errorf("install certificate failed: %s", err.Error())
+	}
+
+	agentmetric.SetCertificateExpiry(domain, cert.NotAfter)
+	handler.sendCertificateEvent(domain, event, cert.NotAfter, nil)
+	log.Infof("%s certificate for %s, expires at %s", event, domain, cert.NotAfter.Format(CertificateTimeFormat))
+	return nil
+}
+
+func (handler *DNSHandler) certificateExpiry(domain string) (time.Time, error) {
+	crt, err := ioutil.ReadFile(path.Join(handler.nginxKeyDir, domain+".crt"))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("read certificate of %s failed: %s", domain, err.Error())
+	}
+
+	return acmeclient.CertExpiry(crt)
+}
+
+func (handler *DNSHandler) sendCertificateEvent(domain, event string, notAfter time.Time, err error) {
+	certEvent := &pb.CertificateEvent{
+		Node:      handler.localip,
+		Domain:    domain,
+		Event:     event,
+		EventTime: time.Now().Format(CertificateTimeFormat),
+	}
+	if notAfter.IsZero() == false {
+		certEvent.NotAfter = notAfter.Format(CertificateTimeFormat)
+	}
+	if err != nil {
+		certEvent.ErrorMessage = err.Error()
+	}
+
+	if producer := kafkaproducer.GetKafkaProducer(); producer != nil {
+		if err := producer.SendCertificateMessage(certEvent); err != nil {
+			log.Warnf("send certificate event of %s failed: %s", domain, err.Error())
+		}
+	}
+}
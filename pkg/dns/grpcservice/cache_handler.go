@@ -0,0 +1,116 @@
+package grpcservice
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/zdnscloud/g53"
+
+	"github.com/linkingthing/ddi-agent/pkg/dns/rndc"
+	"github.com/linkingthing/ddi-agent/pkg/metric"
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+func (handler *DNSHandler) FlushDNSCache(req *pb.FlushDNSCacheReq) error {
+	if req.GetView() != "" {
+		if _, err := getViewByName(req.GetView()); err != nil {
+			return err
+		}
+	}
+
+	r := rndc.New(handler.rndcPath, handler.rndcConfPath)
+	if req.GetName() == "" {
+		if err := r.Flush(req.GetView()); err != nil {
+			return fmt.Errorf("flush cache of view %s failed: %s", req.GetView(), err.Error())
+		}
+		return nil
+	}
+
+	name, err := g53.NameFromString(req.GetName())
+	if err != nil {
+		return fmt.Errorf("invalid name %s: %s", req.GetName(), err.Error())
+	}
+
+	if req.GetTree() {
+		err = r.FlushTree(name.String(false), req.GetView())
+	} else {
+		err = r.FlushName(name.String(false), req.GetView())
+	}
+	if err != nil {
+		return fmt.Errorf("flush cache of %s with view %s failed: %s", name.String(false), req.GetView(), err.Error())
+	}
+
+	return nil
+}
+
+func (handler *DNSHandler) GetDNSCacheStats(req *pb.GetDNSCacheStatsReq) (*pb.GetDNSCacheStatsResponse, error) {
+	collector := metric.GetDNSCollector()
+	if collector == nil {
+		return nil, fmt.Errorf("dns collector is not initialized")
+	}
+
+	views, err := collector.CacheStats()
+	if err != nil {
+		return nil, fmt.Errorf("get dns cache stats failed: %s", err.Error())
+	}
+
+	resp := &pb.GetDNSCacheStatsResponse{Succeed: true}
+	for _, v := range views {
+		if req.GetView() != "" && v.View != req.GetView() {
+			continue
+		}
+
+		resp.Views = append(resp.Views, &pb.DNSCacheStats{
+			View:        v.View,
+			Size:        v.Size,
+			Entries:     v.Entries,
+			QueryHits:   v.QueryHits,
+			QueryMisses: v.QueryMisses,
+			HitRatio:    v.HitRatio,
+			Counters:    v.Counters,
+			Rrsets:      v.RRsets,
+		})
+	}
+
+	if req.GetView() != "" && len(resp.Views) == 0 {
+		return nil, fmt.Errorf("no cache stats found for view %s", req.GetView())
+	}
+
+	return resp, nil
+}
+
+func (handler *DNSHandler) DumpDNSCache(req *pb.DumpDNSCacheReq) (*pb.DumpDNSCacheResponse, error) {
+	if req.GetName() == "" {
+		return nil, fmt.Errorf("name is required to dump cache")
+	}
+
+	name, err := g53.NameFromString(req.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("invalid name %s: %s", req.GetName(), err.Error())
+	}
+
+	if req.GetView() != "" {
+		if _, err := getViewByName(req.GetView()); err != nil {
+			return nil, err
+		}
+	}
+
+	entries, err := rndc.New(handler.rndcPath, handler.rndcConfPath).DumpCache(
+		req.GetView(), filepath.Join(handler.dnsConfPath, rndc.DumpFileName), name.String(false))
+	if err != nil {
+		return nil, fmt.Errorf("dump cache of view %s failed: %s", req.GetView(), err.Error())
+	}
+
+	resp := &pb.DumpDNSCacheResponse{Succeed: true}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &pb.DNSCacheEntry{
+			View:  entry.View,
+			Name:  entry.Name,
+			Ttl:   entry.TTL,
+			Type:  entry.Type,
+			Rdata: entry.Rdata,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,83 @@
+package grpcservice
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	restdb "github.com/zdnscloud/gorest/db"
+
+	"github.com/linkingthing/ddi-agent/pkg/db"
+	"github.com/linkingthing/ddi-agent/pkg/dns/dbhandler"
+	"github.com/linkingthing/ddi-agent/pkg/dns/diagnose"
+	"github.com/linkingthing/ddi-agent/pkg/dns/resource"
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+func (handler *DNSHandler) DiagnoseQuery(req *pb.DiagnoseQueryReq) (*pb.DiagnoseQueryResponse, error) {
+	diagnoseReq := &diagnose.Request{
+		Name:             req.GetName(),
+		Type:             req.GetType(),
+		Source:           req.GetSourceAddress(),
+		RecursionDesired: req.GetRecursionDesired(),
+		DnssecOK:         req.GetDnssecOk(),
+		ClientSubnet:     req.GetClientSubnet(),
+		Timeout:          time.Duration(req.GetTimeoutSeconds()) * time.Second,
+	}
+
+	if req.GetView() != "" {
+		key, err := getViewKey(req.GetView())
+		if err != nil {
+			return nil, err
+		}
+		diagnoseReq.KeyName = "key" + req.GetView()
+		diagnoseReq.Secret = key
+	}
+
+	diagnoser := diagnose.New(net.JoinHostPort(handler.dnsServerIP, diagnose.DNSPort))
+	var steps []*diagnose.Step
+	if req.GetTrace() {
+		traceSteps, err := diagnoser.Trace(diagnoseReq)
+		if err != nil {
+			return nil, err
+		}
+		steps = traceSteps
+	} else {
+		step, err := diagnoser.Query(diagnoseReq)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+
+	resp := &pb.DiagnoseQueryResponse{Succeed: true}
+	for _, step := range steps {
+		resp.Messages = append(resp.Messages, step.ToProto())
+	}
+
+	return resp, nil
+}
+
+func getViewKey(name string) (string, error) {
+	view, err := getViewByName(name)
+	if err != nil {
+		return "", err
+	}
+
+	return view.Key, nil
+}
+
+func getViewByName(name string) (*resource.AgentView, error) {
+	var views []*resource.AgentView
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return dbhandler.ListByConditionWithTx(&views, map[string]interface{}{"name": name}, tx)
+	}); err != nil {
+		return nil, fmt.Errorf("get view %s from db failed: %s", name, err.Error())
+	}
+
+	if len(views) == 0 {
+		return nil, fmt.Errorf("view %s not found", name)
+	}
+
+	return views[0], nil
+}
@@ -10,19 +10,29 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"text/template"
 	"time"
 
 	monitorpb "github.com/linkingthing/ddi-monitor/pkg/proto"
+	"github.com/zdnscloud/cement/log"
 	"github.com/zdnscloud/cement/uuid"
 	"github.com/zdnscloud/g53"
 	restdb "github.com/zdnscloud/gorest/db"
 
 	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/acmeclient"
+	"github.com/linkingthing/ddi-agent/pkg/agentmetric"
+	"github.com/linkingthing/ddi-agent/pkg/audit"
 	"github.com/linkingthing/ddi-agent/pkg/db"
 	"github.com/linkingthing/ddi-agent/pkg/dns/dbhandler"
+	"github.com/linkingthing/ddi-agent/pkg/dns/dryrun"
 	"github.com/linkingthing/ddi-agent/pkg/dns/resource"
+	"github.com/linkingthing/ddi-agent/pkg/dns/snapshot"
+	"github.com/linkingthing/ddi-agent/pkg/dns/validator"
 	"github.com/linkingthing/ddi-agent/pkg/grpcclient"
+	"github.com/linkingthing/ddi-agent/pkg/journal"
+	"github.com/linkingthing/ddi-agent/pkg/nginxlog"
 	pb "github.com/linkingthing/ddi-agent/pkg/proto"
 )
 
@@ -51,6 +61,10 @@ const (
 	defaultGlobalConfigID = "globalConfig"
 	TemplateDir           = "/etc/dns/templates"
 	FilePermissions       = 0777
+	ConfFilePermissions   = 0644
+	KeyFilePermissions    = 0600
+	journalReloadNamed    = "named"
+	journalReloadNginx    = "nginx"
 )
 
 const updateTtlSql = `update gr_agent_auth_rr set ttl = $1 WHERE id in (SELECT rr.id from gr_agent_auth_rr rr JOIN gr_agent_auth_zone z ON rr.zone=z.name and rr.agent_view=z.agent_view WHERE z.role = $2);`
@@ -61,14 +75,30 @@ type DNSHandler struct {
 	tplPath             string
 	ticker              *time.Ticker
 	quit                chan int
+	uploads             sync.WaitGroup
+	applyLock           sync.Mutex
+	journal             *journal.Journal
+	auditEntry          *audit.Entry
+	checker             *validator.Checker
+	nginxChecker        *validator.NginxChecker
+	dryRun              *dryrun.DryRun
+	snapshots           *snapshot.Store
+	drift               *snapshot.Drift
+	acme                *acmeclient.Client
+	acmeConf            config.ACMEConf
+	acmeKick            chan struct{}
+	acmeQuit            chan struct{}
+	acmeDone            chan struct{}
 	nginxDefaultConfDir string
 	nginxKeyDir         string
+	nginxAccessLogPath  string
 	localip             string
 	interfaceIPs        []string
 	localipv6           string
 	dnsServerIP         string
 	rndcConfPath        string
 	rndcPath            string
+	namedUser           string
 	nginxConfPath       string
 	namedViewPath       string
 	namedOptionPath     string
@@ -79,26 +109,49 @@ func newDNSHandler(conf *config.AgentConfig) (*DNSHandler, error) {
 	instance := &DNSHandler{
 		dnsConfPath:         filepath.Join(conf.DNS.ConfDir),
 		tplPath:             TemplateDir,
+		rndcPath:            conf.DNS.RndcPath,
+		namedUser:           conf.DNS.NamedUser,
+		rndcConfPath:        filepath.Join(TemplateDir, "rndc.conf"),
 		nginxDefaultConfDir: conf.NginxDefaultDir,
 		nginxKeyDir:         conf.NginxDefaultDir + "/key",
 		localip:             conf.Server.IP,
 		localipv6:           conf.Server.IPV6,
 		dnsServerIP:         conf.DNS.ServerIp,
+		checker:             validator.NewChecker(conf.DNS.Checker.CheckConfPath, conf.DNS.Checker.CheckZonePath),
+		nginxChecker:        validator.NewNginxChecker(conf.NginxCheckPath, filepath.Dir(conf.NginxDefaultDir)),
 	}
 	instance.interfaceIPs, _ = getInterfaceIPs()
+	if conf.NginxAccessLog.Enabled {
+		instance.nginxAccessLogPath = nginxlog.LogPath(&conf.NginxAccessLog)
+	}
+	if conf.DNS.Snapshot.Enabled {
+		snapshots, err := snapshot.New(instance.dnsConfPath, conf.DNS.Snapshot.Dir, int(conf.DNS.Snapshot.MaxVersions))
+		if err != nil {
+			return nil, err
+		}
+		instance.snapshots = snapshots
+	}
+	if err := instance.initACME(conf.ACME); err != nil {
+		return nil, err
+	}
 	instance.tpl = template.Must(template.ParseGlob(filepath.Join(instance.tplPath, "*.tpl")))
 	instance.ticker = time.NewTicker(checkPeriod * time.Second)
-	instance.quit = make(chan int)
+	instance.quit = make(chan int, 1)
 
-	if err := instance.StartDNS(&pb.DNSStartReq{}); err != nil {
+	if err := instance.startDNS(false); err != nil {
 		return nil, err
 	}
 
+	instance.startCertificateRenewal()
 	return instance, nil
 }
 
 func (handler *DNSHandler) StartDNS(req *pb.DNSStartReq) error {
-	if err := handler.reconfigOrStartDNS(true); err != nil {
+	return handler.startDNS(true)
+}
+
+func (handler *DNSHandler) startDNS(regenerate bool) error {
+	if err := handler.reconfigOrStartDNS(true, regenerate); err != nil {
 		return err
 	}
 
@@ -106,16 +159,66 @@ func (handler *DNSHandler) StartDNS(req *pb.DNSStartReq) error {
 	return nil
 }
 
-func (handler *DNSHandler) reconfigOrStartDNS(init bool) error {
-	err := initDefaultDbData()
+func (handler *DNSHandler) withTx(ctx context.Context, fn func(tx restdb.Transaction) error) error {
+	handler.applyLock.Lock()
+	defer handler.applyLock.Unlock()
+
+	handler.drift = handler.snapshotDrift()
+	files, err := handler.withJournal(audit.FromContext(ctx), fn)
+	handler.drift = nil
 	if err != nil {
-		return fmt.Errorf("initDefaultDbData failed:%s", err.Error())
+		return err
 	}
 
-	if err := handler.initFiles(); err != nil {
+	if len(files) != 0 {
+		handler.takeSnapshot()
+	}
+	return nil
+}
+
+func (handler *DNSHandler) withJournal(entry *audit.Entry, fn func(tx restdb.Transaction) error) ([]string, error) {
+	handler.journal = journal.New()
+	handler.auditEntry = entry
+	err := db.WithAuditTx(entry, fn)
+	j := handler.journal
+	handler.journal = nil
+	handler.auditEntry = nil
+	if err == nil {
+		return j.Files(), nil
+	}
+
+	if rollbackErr := j.Rollback(); rollbackErr != nil {
+		log.Errorf("rollback files %v failed: %s", j.Files(), rollbackErr.Error())
+		return nil, fmt.Errorf("%s, and rollback failed: %s", err.Error(), rollbackErr.Error())
+	}
+
+	log.Warnf("apply failed, rollback files %v succeed: %s", j.Files(), err.Error())
+	return nil, err
+}
+
+func (handler *DNSHandler) reconfigOrStartDNS(init, regenerate bool) error {
+	handler.applyLock.Lock()
+	defer handler.applyLock.Unlock()
+
+	if err := handler.initPaths(); err != nil {
 		return err
 	}
 
+	if drift := handler.snapshotDrift(); drift != nil && regenerate == false {
+		log.Warnf("dns config is rolled back to snapshot %s, skip regenerating it from db", drift.SnapshotID)
+	} else {
+		if err := initDefaultDbData(); err != nil {
+			return fmt.Errorf("initDefaultDbData failed:%s", err.Error())
+		}
+
+		if err := handler.initFiles(); err != nil {
+			return err
+		}
+		handler.clearSnapshotDrift()
+		handler.takeSnapshot()
+	}
+
+	var err error
 	if init {
 		if resp, err := grpcclient.GetDDIMonitorGrpcClient().GetDNSState(context.Background(),
 			&monitorpb.GetDNSStateRequest{}); err != nil {
@@ -142,7 +245,26 @@ func (handler *DNSHandler) StopDNS() error {
 	return nil
 }
 
-func (handler *DNSHandler) Close() {}
+func (handler *DNSHandler) Close(ctx context.Context) error {
+	select {
+	case handler.quit <- 1:
+	default:
+	}
+
+	done := make(chan struct{})
+	go func() {
+		handler.stopCertificateRenewal()
+		handler.uploads.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("wait for certificate renewal and log uploads failed: %s", ctx.Err().Error())
+	}
+}
 
 func (handler *DNSHandler) keepDNSAlive() {
 	defer handler.ticker.Stop()
@@ -153,7 +275,8 @@ func (handler *DNSHandler) keepDNSAlive() {
 				&monitorpb.GetDNSStateRequest{}); err != nil {
 				continue
 			} else if resp.GetIsRunning() == false {
-				handler.reconfigOrStartDNS(false)
+				agentmetric.IncServiceRestart(agentmetric.ServiceDNS)
+				handler.reconfigOrStartDNS(false, false)
 			}
 		case <-handler.quit:
 			return
@@ -210,11 +333,11 @@ func initDefaultDbData() error {
 	})
 }
 
-func (handler *DNSHandler) CreateACL(req *pb.CreateAclReq) error {
+func (handler *DNSHandler) CreateACL(ctx context.Context, req *pb.CreateAclReq) error {
 	acl := &resource.AgentAcl{Name: req.GetAcl().GetName(), Ips: req.GetAcl().GetIps()}
 	acl.SetID(req.GetAcl().GetId())
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.withTx(ctx, func(tx restdb.Transaction) error {
 		if _, err := tx.Insert(acl); err != nil {
 			return fmt.Errorf("CreateACL insert acl db id:%s failed: %s ", req.GetAcl().Id, err.Error())
 		}
@@ -226,8 +349,8 @@ func (handler *DNSHandler) CreateACL(req *pb.CreateAclReq) error {
 	})
 }
 
-func (handler *DNSHandler) BatchCreateACL(req *pb.BatchCreateAclReq) error {
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) BatchCreateACL(ctx context.Context, req *pb.BatchCreateAclReq) error {
+	return handler.withTx(ctx, func(tx restdb.Transaction) error {
 		for _, pbAcl := range req.Acls {
 			acl := &resource.AgentAcl{Name: pbAcl.Name, Ips: pbAcl.Ips}
 			acl.SetID(pbAcl.GetId())
@@ -243,8 +366,8 @@ func (handler *DNSHandler) BatchCreateACL(req *pb.BatchCreateAclReq) error {
 	})
 }
 
-func (handler *DNSHandler) UpdateACL(req *pb.UpdateAclReq) error {
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) UpdateACL(ctx context.Context, req *pb.UpdateAclReq) error {
+	return handler.withTx(ctx, func(tx restdb.Transaction) error {
 		if _, err := tx.Update(
 			resource.TableAcl,
 			map[string]interface{}{"ips": req.GetAcl().Ips},
@@ -259,8 +382,8 @@ func (handler *DNSHandler) UpdateACL(req *pb.UpdateAclReq) error {
 	})
 }
 
-func (handler *DNSHandler) DeleteACL(req *pb.DeleteAclReq) error {
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) DeleteACL(ctx context.Context, req *pb.DeleteAclReq) error {
+	return handler.withTx(ctx, func(tx restdb.Transaction) error {
 		if _, err := tx.Delete(resource.TableAcl, map[string]interface{}{restdb.IDField: req.Id}); err != nil {
 			return fmt.Errorf("DeleteACL acl id:%s from db failed: %s", req.Id, err.Error())
 		}
@@ -272,17 +395,26 @@ func (handler *DNSHandler) DeleteACL(req *pb.DeleteAclReq) error {
 	})
 }
 
-func (handler *DNSHandler) CreateView(req *pb.CreateViewReq) error {
+func (handler *DNSHandler) CreateView(ctx context.Context, req *pb.CreateViewReq) ([]*pb.ConfigFileDiff, error) {
 	view := &resource.AgentView{
-		Name:      req.Name,
-		Priority:  uint(req.Priority),
-		Acls:      req.Acls,
-		Dns64:     req.Dns64,
-		Key:       req.Key,
-		Recursion: req.Recursion,
+		Name:               req.Name,
+		Priority:           uint(req.Priority),
+		Acls:               req.Acls,
+		Dns64:              req.Dns64,
+		Key:                req.Key,
+		Recursion:          req.Recursion,
+		EncryptedAddresses: req.EncryptedAddresses,
 	}
 	view.SetID(req.Id)
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
+		if err := view.ValidateEncryptedAddresses(); err != nil {
+			return err
+		}
+
+		if err := handler.saveDNSCertificate(viewTLSName(view.Name), req.TlsKey, req.TlsCrt); err != nil {
+			return err
+		}
+
 		if _, err := tx.Insert(view); err != nil {
 			return fmt.Errorf("CreateView id:%s Insert to db failed:%s", req.Id, err.Error())
 		}
@@ -301,19 +433,39 @@ func (handler *DNSHandler) CreateView(req *pb.CreateViewReq) error {
 			return fmt.Errorf("CreateView id:%s rewriteNamedViewFile failed:%s", req.Id, err.Error())
 		}
 
+		if len(view.EncryptedAddresses) != 0 {
+			return handler.rewriteNamedOptionsFile(tx)
+		}
+
 		return nil
 	})
 }
 
-func (handler *DNSHandler) UpdateView(req *pb.UpdateViewReq) error {
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) UpdateView(ctx context.Context, req *pb.UpdateViewReq) ([]*pb.ConfigFileDiff, error) {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
+		viewRes, err := dbhandler.GetWithTx(req.Id, &[]*resource.AgentView{}, tx)
+		if err != nil {
+			return fmt.Errorf("UpdateView id:%s get view failed:%s", req.Id, err.Error())
+		}
+
+		oldView := viewRes.(*resource.AgentView)
+		view := &resource.AgentView{Name: oldView.Name, EncryptedAddresses: req.EncryptedAddresses}
+		if err := view.ValidateEncryptedAddresses(); err != nil {
+			return err
+		}
+
+		if err := handler.saveDNSCertificate(viewTLSName(view.Name), req.TlsKey, req.TlsCrt); err != nil {
+			return err
+		}
+
 		if _, err := tx.Update(
 			resource.TableView,
 			map[string]interface{}{
-				"priority":  req.Priority,
-				"acls":      req.Acls,
-				"dns64":     req.Dns64,
-				"recursion": req.Recursion,
+				"priority":            req.Priority,
+				"acls":                req.Acls,
+				"dns64":               req.Dns64,
+				"recursion":           req.Recursion,
+				"encrypted_addresses": req.EncryptedAddresses,
 			},
 			map[string]interface{}{restdb.IDField: req.Id}); err != nil {
 			return fmt.Errorf("UpdateView id:%s update to db failed:%s", req.Id, err.Error())
@@ -330,12 +482,22 @@ func (handler *DNSHandler) UpdateView(req *pb.UpdateViewReq) error {
 		if err := handler.rewriteNamedViewFile(tx, false); err != nil {
 			return fmt.Errorf("UpdateView id:%s rewriteNamedViewFile failed:%s", req.Id, err.Error())
 		}
+
+		if len(oldView.EncryptedAddresses) != 0 || len(view.EncryptedAddresses) != 0 {
+			return handler.rewriteNamedOptionsFile(tx)
+		}
 		return nil
 	})
 }
 
-func (handler *DNSHandler) DeleteView(req *pb.DeleteViewReq) error {
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) DeleteView(ctx context.Context, req *pb.DeleteViewReq) ([]*pb.ConfigFileDiff, error) {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
+		viewRes, err := dbhandler.GetWithTx(req.Id, &[]*resource.AgentView{}, tx)
+		if err != nil {
+			return fmt.Errorf("DeleteView id:%s get view failed:%s", req.Id, err.Error())
+		}
+
+		view := viewRes.(*resource.AgentView)
 		if _, err := tx.Delete(resource.TableView, map[string]interface{}{
 			restdb.IDField: req.Id,
 		}); err != nil {
@@ -349,31 +511,37 @@ func (handler *DNSHandler) DeleteView(req *pb.DeleteViewReq) error {
 			}
 		}
 
-		if err := removeFiles(
+		if err := handler.removeFiles(
 			filepath.Join(handler.dnsConfPath), req.Id+"#", ""); err != nil {
 			return fmt.Errorf("DeleteView zonefile in %s err: %s",
 				filepath.Join(handler.dnsConfPath, "redirection"), err.Error())
 		}
-		if err := removeFile(
+		if err := handler.removeFile(
 			filepath.Join(handler.dnsConfPath, req.Id) + nzfSuffix); err != nil {
 			return fmt.Errorf("DeleteView delete nzf failed:%s", err.Error())
 		}
-		if err := removeFile(
+		if err := handler.removeFile(
 			filepath.Join(handler.dnsConfPath, "redirection", "rpz_"+req.Id)); err != nil {
 			return fmt.Errorf("DeleteView delete rpz failed:%s", err.Error())
 		}
-		if err := removeFile(
+		if err := handler.removeFile(
 			filepath.Join(handler.dnsConfPath, "redirection", "redirect_"+req.Id)); err != nil {
 			return fmt.Errorf("DeleteView delete redirect failed:%s", err.Error())
 		}
 		if err := handler.rewriteNamedViewFile(tx, false); err != nil {
 			return fmt.Errorf("DeleteView rewriteNamedViewFile  failed:%s", err.Error())
 		}
+		if err := handler.removeDNSCertificate(viewTLSName(view.Name)); err != nil {
+			return err
+		}
+		if len(view.EncryptedAddresses) != 0 {
+			return handler.rewriteNamedOptionsFile(tx)
+		}
 		return nil
 	})
 }
 
-func (handler *DNSHandler) CreateAuthZone(req *pb.CreateAuthZoneReq) error {
+func (handler *DNSHandler) CreateAuthZone(ctx context.Context, req *pb.CreateAuthZoneReq) ([]*pb.ConfigFileDiff, error) {
 	zone := &resource.AgentAuthZone{
 		Name:      req.GetAuthZone().Name,
 		Ttl:       req.GetAuthZone().Ttl,
@@ -382,10 +550,10 @@ func (handler *DNSHandler) CreateAuthZone(req *pb.CreateAuthZoneReq) error {
 		Masters:   req.GetAuthZone().Masters,
 		Slaves:    req.GetAuthZone().Slaves}
 	if err := zone.Validate(); err != nil {
-		return fmt.Errorf("auth zone name %s is invalid %s", zone.Name, err.Error())
+		return nil, fmt.Errorf("auth zone name %s is invalid %s", zone.Name, err.Error())
 	}
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		if _, err := tx.Insert(zone); err != nil {
 			return fmt.Errorf("create auth zone %s with view %s failed:%s", zone.Name, zone.AgentView, err.Error())
 		}
@@ -402,7 +570,7 @@ func (handler *DNSHandler) CreateAuthZone(req *pb.CreateAuthZoneReq) error {
 	})
 }
 
-func (handler *DNSHandler) UpdateAuthZone(req *pb.UpdateAuthZoneReq) error {
+func (handler *DNSHandler) UpdateAuthZone(ctx context.Context, req *pb.UpdateAuthZoneReq) ([]*pb.ConfigFileDiff, error) {
 	zone := &resource.AgentAuthZone{
 		Name:      req.GetAuthZone().Name,
 		Ttl:       req.GetAuthZone().Ttl,
@@ -412,10 +580,10 @@ func (handler *DNSHandler) UpdateAuthZone(req *pb.UpdateAuthZoneReq) error {
 		Slaves:    req.GetAuthZone().Slaves}
 
 	if err := zone.Validate(); err != nil {
-		return fmt.Errorf("auth zone name %s is invalid %s", zone.Name, err.Error())
+		return nil, fmt.Errorf("auth zone name %s is invalid %s", zone.Name, err.Error())
 	}
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		if _, err := tx.Update(resource.TableAgentAuthZone,
 			map[string]interface{}{
 				"ttl": req.GetAuthZone().Ttl, "role": req.GetAuthZone().Role,
@@ -438,13 +606,13 @@ func (handler *DNSHandler) UpdateAuthZone(req *pb.UpdateAuthZoneReq) error {
 	})
 }
 
-func (handler *DNSHandler) DeleteAuthZone(req *pb.DeleteAuthZoneReq) error {
+func (handler *DNSHandler) DeleteAuthZone(ctx context.Context, req *pb.DeleteAuthZoneReq) ([]*pb.ConfigFileDiff, error) {
 	zone := &resource.AgentAuthZone{Name: req.Name, AgentView: req.View}
 	if err := zone.Validate(); err != nil {
-		return fmt.Errorf("auth zone name %s is invalid %s", zone.Name, err.Error())
+		return nil, fmt.Errorf("auth zone name %s is invalid %s", zone.Name, err.Error())
 	}
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		if _, err := tx.Delete(resource.TableAgentAuthZone, map[string]interface{}{
 			"agent_view": zone.AgentView, "name": zone.Name,
 		}); err != nil {
@@ -471,7 +639,7 @@ func (handler *DNSHandler) deleteZoneAuthRR(tx restdb.Transaction, zone *resourc
 	return nil
 }
 
-func (handler *DNSHandler) CreateAuthZoneAuthRRs(req *pb.CreateAuthZoneAuthRRsReq) error {
+func (handler *DNSHandler) CreateAuthZoneAuthRRs(ctx context.Context, req *pb.CreateAuthZoneAuthRRsReq) error {
 	zone := &resource.AgentAuthZone{
 		Name:      req.GetAuthZone().Name,
 		Ttl:       req.GetAuthZone().Ttl,
@@ -488,7 +656,7 @@ func (handler *DNSHandler) CreateAuthZoneAuthRRs(req *pb.CreateAuthZoneAuthRRsRe
 		return err
 	}
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.withTx(ctx, func(tx restdb.Transaction) error {
 		if _, err := tx.Insert(zone); err != nil {
 			return fmt.Errorf(
 				"create auth zone %s with view %s failed:%s", zone.Name, zone.AgentView, err.Error())
@@ -514,7 +682,7 @@ func (handler *DNSHandler) CreateAuthZoneAuthRRs(req *pb.CreateAuthZoneAuthRRsRe
 	})
 }
 
-func (handler *DNSHandler) UpdateAuthZoneAXFR(req *pb.UpdateAuthZoneAXFRReq) error {
+func (handler *DNSHandler) UpdateAuthZoneAXFR(ctx context.Context, req *pb.UpdateAuthZoneAXFRReq) error {
 	if len(req.AuthZoneRrs) == 0 {
 		return nil
 	}
@@ -524,7 +692,7 @@ func (handler *DNSHandler) UpdateAuthZoneAXFR(req *pb.UpdateAuthZoneAXFRReq) err
 		return err
 	}
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.withTx(ctx, func(tx restdb.Transaction) error {
 		var zones []*resource.AgentAuthZone
 		for _, authZone := range req.AuthZones {
 			zone := &resource.AgentAuthZone{
@@ -562,8 +730,8 @@ func (handler *DNSHandler) UpdateAuthZoneAXFR(req *pb.UpdateAuthZoneAXFRReq) err
 	})
 }
 
-func (handler *DNSHandler) UpdateAuthZoneIXFR(req *pb.UpdateAuthZoneIXFRReq) error {
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) UpdateAuthZoneIXFR(ctx context.Context, req *pb.UpdateAuthZoneIXFRReq) error {
+	return handler.withTx(ctx, func(tx restdb.Transaction) error {
 		for _, soa := range req.GetSoas() {
 			if err := handler.updateSoaRdata(tx, soa); err != nil {
 				return err
@@ -586,20 +754,29 @@ func (handler *DNSHandler) UpdateAuthZoneIXFR(req *pb.UpdateAuthZoneIXFRReq) err
 	})
 }
 
-func (handler *DNSHandler) CreateForwardZone(req *pb.CreateForwardZoneReq) error {
+func (handler *DNSHandler) CreateForwardZone(ctx context.Context, req *pb.CreateForwardZoneReq) ([]*pb.ConfigFileDiff, error) {
 	forwardZone := &resource.AgentForwardZone{
-		Name:         req.Name,
-		ForwardStyle: req.ForwardStyle,
-		AgentView:    req.View,
-		Addresses:    req.Addresses,
+		Name:          req.Name,
+		ForwardStyle:  req.ForwardStyle,
+		AgentView:     req.View,
+		Addresses:     req.Addresses,
+		TlsEnable:     req.TlsEnable,
+		TlsServerName: req.TlsServerName,
+	}
+	if err := probeForwardZone(forwardZone, req.TlsCaBundle); err != nil {
+		return nil, err
 	}
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		if _, err := tx.Insert(forwardZone); err != nil {
 			return fmt.Errorf("insert forward zone %s with view %s to db failed:%s",
 				forwardZone.Name, forwardZone.AgentView, err.Error())
 		}
 
+		if err := handler.saveForwardCABundle(forwardZone, req.TlsCaBundle); err != nil {
+			return err
+		}
+
 		if err := handler.rewriteNamedViewFile(tx, false); err != nil {
 			return fmt.Errorf("add forward zone %s with view %s to dns failed:%s",
 				forwardZone.Name, forwardZone.AgentView, err.Error())
@@ -608,10 +785,23 @@ func (handler *DNSHandler) CreateForwardZone(req *pb.CreateForwardZoneReq) error
 	})
 }
 
-func (handler *DNSHandler) UpdateForwardZone(req *pb.UpdateForwardZoneReq) error {
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) UpdateForwardZone(ctx context.Context, req *pb.UpdateForwardZoneReq) ([]*pb.ConfigFileDiff, error) {
+	forwardZone := &resource.AgentForwardZone{
+		Name:          req.Name,
+		ForwardStyle:  req.ForwardStyle,
+		AgentView:     req.View,
+		Addresses:     req.Addresses,
+		TlsEnable:     req.TlsEnable,
+		TlsServerName: req.TlsServerName,
+	}
+	if err := probeForwardZone(forwardZone, handler.forwardCABundle(forwardZone, req.TlsCaBundle)); err != nil {
+		return nil, err
+	}
+
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		if _, err := tx.Update(resource.TableAgentForwardZone, map[string]interface{}{
 			"forward_style": req.ForwardStyle, "addresses": req.Addresses,
+			"tls_enable": req.TlsEnable, "tls_server_name": req.TlsServerName,
 		}, map[string]interface{}{
 			"agent_view": req.View, "zone": req.Name,
 		}); err != nil {
@@ -619,6 +809,10 @@ func (handler *DNSHandler) UpdateForwardZone(req *pb.UpdateForwardZoneReq) error
 				req.Name, req.View, err.Error())
 		}
 
+		if err := handler.saveForwardCABundle(forwardZone, req.TlsCaBundle); err != nil {
+			return err
+		}
+
 		if err := handler.rewriteNamedViewFile(tx, false); err != nil {
 			return fmt.Errorf("update forward zone %s with view %s to dns failed:%s",
 				req.Name, req.View, err.Error())
@@ -627,8 +821,8 @@ func (handler *DNSHandler) UpdateForwardZone(req *pb.UpdateForwardZoneReq) error
 	})
 }
 
-func (handler *DNSHandler) DeleteForwardZone(req *pb.DeleteForwardZoneReq) error {
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) DeleteForwardZone(ctx context.Context, req *pb.DeleteForwardZoneReq) ([]*pb.ConfigFileDiff, error) {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		if _, err := tx.Delete(resource.TableAgentForwardZone, map[string]interface{}{
 			"agent_view": req.View, "zone": req.Name,
 		}); err != nil {
@@ -636,6 +830,11 @@ func (handler *DNSHandler) DeleteForwardZone(req *pb.DeleteForwardZoneReq) error
 				req.Name, req.View, err.Error())
 		}
 
+		forwardZone := &resource.AgentForwardZone{Name: req.Name, AgentView: req.View}
+		if err := handler.saveForwardCABundle(forwardZone, nil); err != nil {
+			return err
+		}
+
 		if err := handler.rewriteNamedViewFile(tx, false); err != nil {
 			return fmt.Errorf("delete forward zone %s with view %s from dns failed:%s",
 				req.Name, req.View, err.Error())
@@ -644,14 +843,19 @@ func (handler *DNSHandler) DeleteForwardZone(req *pb.DeleteForwardZoneReq) error
 	})
 }
 
-func (handler *DNSHandler) FlushForwardZone(req *pb.FlushForwardZoneReq) error {
+func (handler *DNSHandler) FlushForwardZone(ctx context.Context, req *pb.FlushForwardZoneReq) error {
+	newForwardZones, err := pbForwardZonesToAgentForwardZones(req.NewForwardZones)
+	if err != nil {
+		return err
+	}
+
 	oldSql := getDeleteForwardZonesSql(req.OldForwardZones)
-	newSql := getAddForwardZonesSql(req.NewForwardZones)
+	newSql := getAddForwardZonesSql(newForwardZones)
 	if oldSql == "" && newSql == "" {
 		return nil
 	}
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.withTx(ctx, func(tx restdb.Transaction) error {
 		if oldSql != "" {
 			if _, err := tx.Exec(oldSql); err != nil {
 				return fmt.Errorf("delete forward zones from db failed:%s", err.Error())
@@ -663,6 +867,19 @@ func (handler *DNSHandler) FlushForwardZone(req *pb.FlushForwardZoneReq) error {
 			}
 		}
 
+		for _, forwardZone := range req.OldForwardZones {
+			if err := handler.saveForwardCABundle(
+				&resource.AgentForwardZone{Name: forwardZone.Name, AgentView: forwardZone.View}, nil); err != nil {
+				return err
+			}
+		}
+
+		for i, forwardZone := range newForwardZones {
+			if err := handler.saveForwardCABundle(forwardZone, req.NewForwardZones[i].TlsCaBundle); err != nil {
+				return err
+			}
+		}
+
 		if err := handler.rewriteNamedViewFile(tx, false); err != nil {
 			return fmt.Errorf("reflush forward zones from dns failed:%s", err.Error())
 		}
@@ -671,6 +888,26 @@ func (handler *DNSHandler) FlushForwardZone(req *pb.FlushForwardZoneReq) error {
 	})
 }
 
+func pbForwardZonesToAgentForwardZones(pbForwardZones []*pb.FlushForwardZoneReqForwardZone) ([]*resource.AgentForwardZone, error) {
+	var forwardZones []*resource.AgentForwardZone
+	for _, zone := range pbForwardZones {
+		forwardZone := &resource.AgentForwardZone{
+			Name:          zone.Name,
+			ForwardStyle:  zone.ForwardStyle,
+			AgentView:     zone.View,
+			Addresses:     zone.ForwardIps,
+			TlsEnable:     zone.TlsEnable,
+			TlsServerName: zone.TlsServerName,
+		}
+		if err := forwardZone.ValidateTLS(); err != nil {
+			return nil, err
+		}
+		forwardZones = append(forwardZones, forwardZone)
+	}
+
+	return forwardZones, nil
+}
+
 func getDeleteForwardZonesSql(forwardZones []*pb.FlushForwardZoneReqForwardZone) string {
 	if len(forwardZones) == 0 {
 		return ""
@@ -696,13 +933,13 @@ func getDeleteForwardZonesSql(forwardZones []*pb.FlushForwardZoneReqForwardZone)
 		strings.Join(views, "','") + "') and name in ('" + strings.Join(zones, "','") + "');"
 }
 
-func getAddForwardZonesSql(forwardZones []*pb.FlushForwardZoneReqForwardZone) string {
+func getAddForwardZonesSql(forwardZones []*resource.AgentForwardZone) string {
 	if len(forwardZones) == 0 {
 		return ""
 	}
 
 	var buf bytes.Buffer
-	buf.WriteString("insert into gr_agent_forward_zone (id, create_time, name, forward_style, addresses, agent_view) values")
+	buf.WriteString("insert into gr_agent_forward_zone (id, create_time, name, forward_style, addresses, agent_view, tls_enable, tls_server_name) values")
 	for _, zone := range forwardZones {
 		buf.WriteString("('")
 		id, _ := uuid.Gen()
@@ -714,9 +951,13 @@ func getAddForwardZonesSql(forwardZones []*pb.FlushForwardZoneReqForwardZone) st
 		buf.WriteString("','")
 		buf.WriteString(zone.ForwardStyle)
 		buf.WriteString("','{")
-		buf.WriteString(strings.Join(zone.GetForwardIps(), ","))
+		buf.WriteString(strings.Join(zone.Addresses, ","))
 		buf.WriteString("}','")
-		buf.WriteString(zone.View)
+		buf.WriteString(zone.AgentView)
+		buf.WriteString("',")
+		buf.WriteString(strconv.FormatBool(zone.TlsEnable))
+		buf.WriteString(",'")
+		buf.WriteString(zone.TlsServerName)
 		buf.WriteString("')")
 		buf.WriteString(",")
 	}
@@ -724,8 +965,8 @@ func getAddForwardZonesSql(forwardZones []*pb.FlushForwardZoneReqForwardZone) st
 	return strings.TrimSuffix(buf.String(), ",") + ";"
 }
 
-func (handler *DNSHandler) CreateAuthRR(req *pb.CreateAuthRRReq) error {
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) CreateAuthRR(ctx context.Context, req *pb.CreateAuthRRReq) ([]*pb.ConfigFileDiff, error) {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		if err := handler.updateSoaRdata(tx, req.GetSoa()); err != nil {
 			return err
 		}
@@ -791,6 +1032,10 @@ func pbAuthRRToAgentAuthRRAndRRset(pbRR *pb.AuthZoneRR) (*resource.AgentAuthRr,
 }
 
 func (handler *DNSHandler) updateRR(key string, secret string, rrset *g53.RRset, zone string, isAdd bool) error {
+	if handler.dryRun != nil {
+		return nil
+	}
+
 	serverAddr, err := net.ResolveUDPAddr("udp", handler.dnsServerIP+":53")
 	if err != nil {
 		return err
@@ -835,21 +1080,24 @@ func (handler *DNSHandler) updateRR(key string, secret string, rrset *g53.RRset,
 		return err
 	}
 
+	handler.journal.Undo("update rrset "+rrset.String(), func() error {
+		return handler.updateRR(key, secret, rrset, zone, !isAdd)
+	})
 	return nil
 }
 
-func (handler *DNSHandler) UpdateAuthRR(req *pb.UpdateAuthRRReq) error {
+func (handler *DNSHandler) UpdateAuthRR(ctx context.Context, req *pb.UpdateAuthRRReq) ([]*pb.ConfigFileDiff, error) {
 	oldRR, oldRRset, err := pbAuthRRToAgentAuthRRAndRRset(req.OldRr)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	newRR, newRRset, err := pbAuthRRToAgentAuthRRAndRRset(req.NewRr)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		if req.NewRr.View == DefaultView {
 			rrRes, err := dbhandler.GetWithTx(DefaultView, &[]*resource.AgentView{}, tx)
 			if err != nil {
@@ -887,8 +1135,8 @@ func (handler *DNSHandler) UpdateAuthRR(req *pb.UpdateAuthRRReq) error {
 	})
 }
 
-func (handler *DNSHandler) DeleteAuthRR(req *pb.DeleteAuthRRReq) error {
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) DeleteAuthRR(ctx context.Context, req *pb.DeleteAuthRRReq) ([]*pb.ConfigFileDiff, error) {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		if err := handler.updateSoaRdata(tx, req.GetSoa()); err != nil {
 			return err
 		}
@@ -924,19 +1172,19 @@ func (handler *DNSHandler) deleteAuthRRFromDB(tx restdb.Transaction, authZoneRr
 	return nil
 }
 
-func (handler *DNSHandler) BatchCreateAuthRRs(req *pb.BatchCreateAuthRRsReq) error {
+func (handler *DNSHandler) BatchCreateAuthRRs(ctx context.Context, req *pb.BatchCreateAuthRRsReq) ([]*pb.ConfigFileDiff, error) {
 	if len(req.AuthZoneRrs) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	reqView := req.AuthZoneRrs[0].View
 	reqZone := req.AuthZoneRrs[0].Zone
 	sql, err := genBatchInsertAuthRRsSql(req.AuthZoneRrs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		if err := handler.updateSoaRdata(tx, req.GetSoa()); err != nil {
 			return err
 		}
@@ -1001,13 +1249,13 @@ func genBatchInsertAuthRRsSql(authZoneRrs []*pb.AuthZoneRR) (string, error) {
 	return strings.TrimSuffix(buf.String(), ",") + ";", nil
 }
 
-func (handler *DNSHandler) CreateRedirection(req *pb.CreateRedirectionReq) error {
+func (handler *DNSHandler) CreateRedirection(ctx context.Context, req *pb.CreateRedirectionReq) ([]*pb.ConfigFileDiff, error) {
 	redirect, err := pbRedirectionToAgentRedirection(req.Redirection)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		if _, err := tx.Insert(redirect); err != nil {
 			return fmt.Errorf("insert redirection %s with view %s to db failed: %s",
 				redirect.Name, redirect.AgentView, err.Error())
@@ -1062,18 +1310,18 @@ func (handler *DNSHandler) rewriteRpzOrRedirectFile(tx restdb.Transaction, redir
 	return nil
 }
 
-func (handler *DNSHandler) UpdateRedirection(req *pb.UpdateRedirectionReq) error {
+func (handler *DNSHandler) UpdateRedirection(ctx context.Context, req *pb.UpdateRedirectionReq) ([]*pb.ConfigFileDiff, error) {
 	oldRedirect, err := pbRedirectionToAgentRedirection(req.OldRedirection)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	newRedirect, err := pbRedirectionToAgentRedirection(req.NewRedirection)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		if _, err := tx.Update(resource.TableAgentRedirection, map[string]interface{}{
 			"rdata":         newRedirect.Rdata,
 			"ttl":           newRedirect.Ttl,
@@ -1091,13 +1339,13 @@ func (handler *DNSHandler) UpdateRedirection(req *pb.UpdateRedirectionReq) error
 	})
 }
 
-func (handler *DNSHandler) DeleteRedirection(req *pb.DeleteRedirectionReq) error {
+func (handler *DNSHandler) DeleteRedirection(ctx context.Context, req *pb.DeleteRedirectionReq) ([]*pb.ConfigFileDiff, error) {
 	oldRedirect, err := pbRedirectionToAgentRedirection(req.Redirection)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		if _, err := tx.Delete(resource.TableAgentRedirection, map[string]interface{}{
 			"agent_view": oldRedirect.AgentView,
 			"name":       oldRedirect.Name,
@@ -1112,74 +1360,129 @@ func (handler *DNSHandler) DeleteRedirection(req *pb.DeleteRedirectionReq) error
 	})
 }
 
-func (handler *DNSHandler) CreateNginxProxy(req *pb.CreateNginxProxyReq) error {
-	urlRedirect := &resource.AgentNginxProxy{
-		Domain:  req.Domain,
-		Url:     req.Url,
-		IsHttps: req.IsHttps,
+func (handler *DNSHandler) CreateNginxProxy(ctx context.Context, req *pb.CreateNginxProxyReq) error {
+	urlRedirect, locations := nginxProxyFromReq(req)
+	useACME := urlRedirect.IsHttps && len(req.Key) == 0 && len(req.Crt) == 0
+	if useACME && handler.acme == nil {
+		return fmt.Errorf("create nginx proxy %s failed: key and crt are required when acme is disabled", urlRedirect.Domain)
 	}
+	urlRedirect.AcmeManaged = useACME
+
+	if err := handler.withTx(ctx, func(tx restdb.Transaction) error {
+		if urlRedirect.IsHttps {
+			if err := checkHttpsProxyConflict(tx); err != nil {
+				return err
+			}
+		}
 
-	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
 		if _, err := tx.Insert(urlRedirect); err != nil {
 			return err
 		}
 
-		if urlRedirect.IsHttps {
-			return handler.addNginxHttpsFile(req.Key, req.Crt, urlRedirect)
+		if err := handler.saveNginxLocations(tx, urlRedirect, locations); err != nil {
+			return err
 		}
 
-		return handler.rewriteNginxHttpFile(tx)
+		if err := handler.rewriteNginxHttpFile(tx); err != nil {
+			return err
+		}
+
+		if urlRedirect.IsHttps && useACME == false {
+			return handler.addNginxHttpsFile(tx, req.Key, req.Crt, urlRedirect)
+		}
+
+		return nil
 	}); err != nil {
 		return fmt.Errorf("create nginx proxy %s failed: %s", urlRedirect.Domain, err.Error())
 	}
-	return nil
-}
 
-func (handler *DNSHandler) UpdateNginxProxy(req *pb.UpdateNginxProxyReq) error {
-	urlRedirect := &resource.AgentNginxProxy{
-		Domain:  req.Domain,
-		Url:     req.Url,
-		IsHttps: req.IsHttps,
+	if useACME {
+		handler.kickCertificateRenewal()
+	} else if urlRedirect.IsHttps {
+		if notAfter, err := acmeclient.CertExpiry(req.Crt); err == nil {
+			agentmetric.SetCertificateExpiry(urlRedirect.Domain, notAfter)
+		}
 	}
+	return nil
+}
 
-	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) UpdateNginxProxy(ctx context.Context, req *pb.UpdateNginxProxyReq) error {
+	urlRedirect, locations := nginxProxyFromReq(req)
+	if err := handler.withTx(ctx, func(tx restdb.Transaction) error {
 		if _, err := tx.Update(resource.TableAgentNginxProxy,
-			map[string]interface{}{"url": urlRedirect.Url},
+			map[string]interface{}{
+				"url":           urlRedirect.Url,
+				"upstreams":     urlRedirect.Upstreams,
+				"lb_method":     urlRedirect.LbMethod,
+				"max_fails":     urlRedirect.MaxFails,
+				"fail_timeout":  urlRedirect.FailTimeout,
+				"redirect_url":  urlRedirect.RedirectUrl,
+				"redirect_code": urlRedirect.RedirectCode,
+				"http_to_https": urlRedirect.HttpToHttps,
+				"hsts":          urlRedirect.Hsts,
+				"hsts_max_age":  urlRedirect.HstsMaxAge,
+				"tls_profile":   urlRedirect.TlsProfile,
+				"tls_protocols": urlRedirect.TlsProtocols,
+				"tls_ciphers":   urlRedirect.TlsCiphers,
+			},
 			map[string]interface{}{"domain": urlRedirect.Domain, "is_https": urlRedirect.IsHttps}); err != nil {
 			return err
 		}
 
+		if err := handler.saveNginxLocations(tx, urlRedirect, locations); err != nil {
+			return err
+		}
+
+		if err := handler.rewriteNginxHttpFile(tx); err != nil {
+			return err
+		}
+
 		if urlRedirect.IsHttps {
-			return handler.updateNginxHttpsFile(urlRedirect)
+			return handler.updateNginxHttpsFile(tx, urlRedirect)
 		}
 
-		return handler.rewriteNginxHttpFile(tx)
+		return nil
 	}); err != nil {
 		return fmt.Errorf("update nginx proxy %s failed:%s", urlRedirect.Domain, err.Error())
 	}
 	return nil
 }
 
-func (handler *DNSHandler) DeleteNginxProxy(req *pb.DeleteNginxProxyReq) error {
-	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) DeleteNginxProxy(ctx context.Context, req *pb.DeleteNginxProxyReq) error {
+	if err := handler.withTx(ctx, func(tx restdb.Transaction) error {
+		if err := deleteNginxLocations(tx, req.Domain, req.IsHttps); err != nil {
+			return err
+		}
+
 		if _, err := tx.Delete(resource.TableAgentNginxProxy,
 			map[string]interface{}{"domain": req.Domain, "is_https": req.IsHttps}); err != nil {
 			return err
 		}
 
+		if err := handler.rewriteNginxHttpFile(tx); err != nil {
+			return err
+		}
+
 		if req.IsHttps {
 			return handler.removeNginxHttpsFile(req.Domain)
 		}
 
-		return handler.rewriteNginxHttpFile(tx)
+		return nil
 	}); err != nil {
 		return fmt.Errorf("delete nginx proxy %s from db failed:%s", req.Domain, err.Error())
 	}
+
+	if req.IsHttps {
+		agentmetric.DeleteCertificateExpiry(req.Domain)
+	}
+	if stats := nginxlog.GetStats(); stats != nil {
+		stats.Forget(req.Domain)
+	}
 	return nil
 }
 
-func (handler *DNSHandler) UpdateGlobalConfig(req *pb.UpdateGlobalConfigReq) error {
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) UpdateGlobalConfig(ctx context.Context, req *pb.UpdateGlobalConfigReq) ([]*pb.ConfigFileDiff, error) {
+	return handler.applyTx(ctx, req.GetDryRun(), func(tx restdb.Transaction) error {
 		update := make(map[string]interface{})
 		updateTtl := false
 		switch req.UpdateModel {
@@ -1202,6 +1505,19 @@ func (handler *DNSHandler) UpdateGlobalConfig(req *pb.UpdateGlobalConfigReq) err
 				return fmt.Errorf("the port %d is been used", req.TransferPort)
 			}
 			update["transfer_port"] = req.TransferPort
+		case resource.DnsConfigUpdateModelEncrypted:
+			if err := checkDohPortConflict(tx, &resource.AgentDnsGlobalConfig{
+				DohEnable: req.DohEnable, DohPort: req.DohPort}); err != nil {
+				return err
+			}
+			if err := handler.saveDNSCertificate(dnsTLSGlobalName, req.TlsKey, req.TlsCrt); err != nil {
+				return err
+			}
+			update["dot_enable"] = req.DotEnable
+			update["dot_port"] = req.DotPort
+			update["doh_enable"] = req.DohEnable
+			update["doh_port"] = req.DohPort
+			update["doh_endpoint"] = req.DohEndpoint
 		default:
 			return fmt.Errorf("unknown updateState")
 		}
@@ -1244,6 +1560,11 @@ func (handler *DNSHandler) UpdateGlobalConfig(req *pb.UpdateGlobalConfigReq) err
 			}
 		}
 
+		if err := handler.rewriteNamedViewFile(tx, false); err != nil {
+			return fmt.Errorf("updateGlobalConfig rewriteNamedViewFile failed:%s",
+				err.Error())
+		}
+
 		if err := handler.rewriteNamedOptionsFile(tx); err != nil {
 			return fmt.Errorf("updateGlobalConfig rewriteNamedOptionsFile failed:%s",
 				err.Error())
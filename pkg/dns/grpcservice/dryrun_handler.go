@@ -0,0 +1,91 @@
+package grpcservice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+
+	restdb "github.com/zdnscloud/gorest/db"
+
+	"github.com/linkingthing/ddi-agent/pkg/db"
+	"github.com/linkingthing/ddi-agent/pkg/dns/dryrun"
+	"github.com/linkingthing/ddi-agent/pkg/dns/resource"
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+func (handler *DNSHandler) applyTx(ctx context.Context, dryRun bool, fn func(tx restdb.Transaction) error) ([]*pb.ConfigFileDiff, error) {
+	if dryRun == false {
+		return nil, handler.withTx(ctx, fn)
+	}
+
+	handler.applyLock.Lock()
+	defer handler.applyLock.Unlock()
+
+	state, err := dryrun.Run(db.GetDB(), func(d *dryrun.DryRun) error {
+		handler.dryRun = d
+		defer func() { handler.dryRun = nil }()
+		if err := fn(d.Tx); err != nil {
+			return err
+		}
+
+		files, removed := d.Overlay(handler.dnsConfPath)
+		if err := handler.checker.CheckConfDir(handler.dnsConfPath, mainConfName, files, removed); err != nil {
+			return fmt.Errorf("check dns config failed: %s", err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return state.Diffs()
+}
+
+func (handler *DNSHandler) previewAuthZoneFile(zoneName, viewName string) error {
+	path, after, err := handler.renderAuthZoneFile(handler.dryRun.Tx, zoneName, viewName)
+	if err != nil {
+		return err
+	}
+
+	var beforePath string
+	var before []byte
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		beforePath, before, err = handler.renderAuthZoneFile(tx, zoneName, viewName)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	if beforePath != "" {
+		handler.dryRun.SetBaseline(beforePath, before)
+	}
+
+	if path != "" {
+		handler.dryRun.SetBaseline(path, nil)
+		handler.dryRun.Write(path, after)
+	}
+
+	return nil
+}
+
+func (handler *DNSHandler) renderAuthZoneFile(tx restdb.Transaction, zoneName, viewName string) (string, []byte, error) {
+	var zones []*resource.AgentAuthZone
+	if err := tx.Fill(map[string]interface{}{"agent_view": viewName, "name": zoneName}, &zones); err != nil {
+		return "", nil, fmt.Errorf("found zone %s with view %s failed: %s", zoneName, viewName, err.Error())
+	} else if len(zones) == 0 {
+		return "", nil, nil
+	}
+
+	authZone, err := getAuthZoneFileData(tx, zones[0])
+	if err != nil {
+		return "", nil, err
+	}
+
+	buffer := new(bytes.Buffer)
+	if err := handler.tpl.ExecuteTemplate(buffer, zoneTpl, authZone); err != nil {
+		return "", nil, fmt.Errorf("render zone %s with view %s failed: %s", zoneName, viewName, err.Error())
+	}
+
+	return filepath.Join(handler.dnsConfPath, zones[0].GetZoneFile()), buffer.Bytes(), nil
+}
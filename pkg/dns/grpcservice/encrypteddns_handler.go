@@ -0,0 +1,303 @@
+package grpcservice
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+
+	restdb "github.com/zdnscloud/gorest/db"
+
+	"github.com/linkingthing/ddi-agent/pkg/dns/dbhandler"
+	"github.com/linkingthing/ddi-agent/pkg/dns/resource"
+	"github.com/linkingthing/ddi-agent/pkg/dns/validator"
+)
+
+const (
+	dnsTLSDir        = "tls"
+	dnsTLSGlobalName = "ddi_global"
+	dnsTLSViewPrefix = "view_"
+	dnsHTTPName      = "ddi_doh"
+	dnsTLSKeySuffix  = ".key"
+	dnsTLSCrtSuffix  = ".crt"
+	dnsTLSCASuffix   = ".ca"
+	anyAddress       = "any"
+)
+
+type NamedTLS struct {
+	Name     string
+	KeyFile  string
+	CertFile string
+}
+
+type ForwardTLS struct {
+	Name       string
+	ServerName string
+	CAFile     string
+}
+
+type EncryptedListener struct {
+	Port  uint32
+	TLS   string
+	HTTP  string
+	IPv4s []string
+	IPv6s []string
+}
+
+func viewTLSName(view string) string {
+	return dnsTLSViewPrefix + view
+}
+
+func (handler *DNSHandler) dnsTLSFile(name, suffix string) string {
+	return filepath.Join(handler.dnsConfPath, dnsTLSDir, name+suffix)
+}
+
+func (handler *DNSHandler) saveDNSCertificate(name string, key, crt []byte) error {
+	if len(key) == 0 && len(crt) == 0 {
+		return nil
+	}
+
+	if _, err := tls.X509KeyPair(crt, key); err != nil {
+		return fmt.Errorf("invalid certificate of %s: %s", name, err.Error())
+	}
+
+	if err := handler.createFolder(filepath.Join(handler.dnsConfPath, dnsTLSDir)); err != nil {
+		return fmt.Errorf("create dns tls folder failed: %s", err.Error())
+	}
+
+	if err := handler.writeNamedKeyFile(handler.dnsTLSFile(name, dnsTLSKeySuffix), key); err != nil {
+		return fmt.Errorf("write key of %s failed: %s", name, err.Error())
+	}
+
+	if err := handler.writeFile(handler.dnsTLSFile(name, dnsTLSCrtSuffix), crt); err != nil {
+		return fmt.Errorf("write certificate of %s failed: %s", name, err.Error())
+	}
+
+	return nil
+}
+
+func (handler *DNSHandler) removeDNSCertificate(name string) error {
+	suffixes := []string{dnsTLSKeySuffix, dnsTLSCrtSuffix}
+	if handler.dryRun != nil {
+		suffixes = suffixes[1:]
+	}
+
+	for _, suffix := range suffixes {
+		if err := handler.removeFile(handler.dnsTLSFile(name, suffix)); err != nil {
+			return fmt.Errorf("remove certificate of %s failed: %s", name, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (handler *DNSHandler) dnsCertificateExists(name string) bool {
+	return handler.dnsTLSFileExists(name, dnsTLSCrtSuffix)
+}
+
+func (handler *DNSHandler) dnsTLSFileExists(name, suffix string) bool {
+	path := handler.dnsTLSFile(name, suffix)
+	if handler.dryRun != nil {
+		if exists, ok := handler.dryRun.Exists(path); ok {
+			return exists
+		}
+	}
+
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func (handler *DNSHandler) namedTLS(name string) NamedTLS {
+	return NamedTLS{
+		Name:     name,
+		KeyFile:  handler.dnsTLSFile(name, dnsTLSKeySuffix),
+		CertFile: handler.dnsTLSFile(name, dnsTLSCrtSuffix),
+	}
+}
+
+func (handler *DNSHandler) fillEncryptedListeners(tx restdb.Transaction, data *NamedOption, globalConfig *resource.AgentDnsGlobalConfig) error {
+	if globalConfig.EncryptedEnabled() == false {
+		return nil
+	}
+
+	if err := globalConfig.ValidateEncrypted(); err != nil {
+		return err
+	}
+
+	if handler.dnsCertificateExists(dnsTLSGlobalName) == false {
+		return fmt.Errorf("certificate is required to enable dot or doh")
+	}
+	data.TLSs = append(data.TLSs, handler.namedTLS(dnsTLSGlobalName))
+	if globalConfig.DohEnable {
+		data.DohHTTP = dnsHTTPName
+		data.DohEndpoint = globalConfig.GetDohEndpoint()
+	}
+
+	var views []*resource.AgentView
+	if err := dbhandler.ListByConditionWithTx(&views,
+		map[string]interface{}{"orderby": "priority"}, tx); err != nil {
+		return fmt.Errorf("list views failed: %s", err.Error())
+	}
+
+	addressViews := make(map[string]string)
+	for _, view := range views {
+		if len(view.EncryptedAddresses) == 0 {
+			continue
+		}
+
+		if err := view.ValidateEncryptedAddresses(); err != nil {
+			return err
+		}
+
+		tlsName := dnsTLSGlobalName
+		if handler.dnsCertificateExists(viewTLSName(view.Name)) {
+			tlsName = viewTLSName(view.Name)
+			data.TLSs = append(data.TLSs, handler.namedTLS(tlsName))
+		}
+
+		var ipv4s, ipv6s []string
+		for _, address := range view.EncryptedAddresses {
+			ip := net.ParseIP(address)
+			if other, ok := addressViews[ip.String()]; ok {
+				return fmt.Errorf("encrypted dns address %s is used by both view %s and %s", address, other, view.Name)
+			}
+			addressViews[ip.String()] = view.Name
+			if ip.To4() != nil {
+				ipv4s = append(ipv4s, ip.String())
+			} else {
+				ipv6s = append(ipv6s, ip.String())
+			}
+		}
+
+		data.EncryptedListeners = append(data.EncryptedListeners,
+			newEncryptedListeners(globalConfig, tlsName, data.DohHTTP, ipv4s, ipv6s)...)
+		data.EncryptedIPv4s = append(data.EncryptedIPv4s, ipv4s...)
+		data.EncryptedIPv6s = append(data.EncryptedIPv6s, ipv6s...)
+	}
+
+	data.EncryptedListeners = append(data.EncryptedListeners,
+		newEncryptedListeners(globalConfig, dnsTLSGlobalName, data.DohHTTP, []string{anyAddress}, []string{anyAddress})...)
+	return nil
+}
+
+func viewMatchDestinations(tx restdb.Transaction, views []*resource.AgentView) (map[string][]string, error) {
+	globalConfigRes, err := dbhandler.GetWithTx(defaultGlobalConfigID, &[]*resource.AgentDnsGlobalConfig{}, tx)
+	if err != nil {
+		return nil, fmt.Errorf("get dns global config failed: %s", err.Error())
+	}
+
+	if globalConfigRes.(*resource.AgentDnsGlobalConfig).EncryptedEnabled() == false {
+		return nil, nil
+	}
+
+	return resource.MatchDestinations(views), nil
+}
+
+func newEncryptedListeners(globalConfig *resource.AgentDnsGlobalConfig, tlsName, httpName string, ipv4s, ipv6s []string) []EncryptedListener {
+	var listeners []EncryptedListener
+	if globalConfig.DotEnable {
+		listeners = append(listeners, EncryptedListener{Port: globalConfig.GetDotPort(), TLS: tlsName, IPv4s: ipv4s, IPv6s: ipv6s})
+	}
+
+	if globalConfig.DohEnable {
+		listeners = append(listeners, EncryptedListener{Port: globalConfig.GetDohPort(), TLS: tlsName, HTTP: httpName, IPv4s: ipv4s, IPv6s: ipv6s})
+	}
+
+	return listeners
+}
+
+func checkDohPortConflict(tx restdb.Transaction, globalConfig *resource.AgentDnsGlobalConfig) error {
+	if globalConfig.DohEnable == false || globalConfig.GetDohPort() != resource.DohDefaultPort {
+		return nil
+	}
+
+	var proxies []*resource.AgentNginxProxy
+	if err := tx.Fill(map[string]interface{}{"is_https": true}, &proxies); err != nil {
+		return fmt.Errorf("list https nginx proxies failed: %s", err.Error())
+	}
+
+	if len(proxies) != 0 {
+		return fmt.Errorf("doh port %d conflicts with https nginx proxy %s", resource.DohDefaultPort, proxies[0].Domain)
+	}
+
+	return nil
+}
+
+func checkHttpsProxyConflict(tx restdb.Transaction) error {
+	globalConfigRes, err := dbhandler.GetWithTx(defaultGlobalConfigID, &[]*resource.AgentDnsGlobalConfig{}, tx)
+	if err != nil {
+		return fmt.Errorf("get dns global config failed: %s", err.Error())
+	}
+
+	globalConfig := globalConfigRes.(*resource.AgentDnsGlobalConfig)
+	if globalConfig.DohEnable && globalConfig.GetDohPort() == resource.DohDefaultPort {
+		return fmt.Errorf("https nginx proxy conflicts with doh port %d", resource.DohDefaultPort)
+	}
+
+	return nil
+}
+
+func (handler *DNSHandler) saveForwardCABundle(forwardZone *resource.AgentForwardZone, bundle []byte) error {
+	name := forwardZone.TLSName()
+	if forwardZone.TlsEnable == false {
+		return handler.removeFile(handler.dnsTLSFile(name, dnsTLSCASuffix))
+	}
+
+	if len(bundle) == 0 {
+		return nil
+	}
+
+	if _, err := validator.ParseCABundle(bundle); err != nil {
+		return fmt.Errorf("invalid ca bundle of forward zone %s: %s", forwardZone.Name, err.Error())
+	}
+
+	if err := handler.createFolder(filepath.Join(handler.dnsConfPath, dnsTLSDir)); err != nil {
+		return fmt.Errorf("create dns tls folder failed: %s", err.Error())
+	}
+
+	return handler.writeFile(handler.dnsTLSFile(name, dnsTLSCASuffix), bundle)
+}
+
+func (handler *DNSHandler) forwardCABundle(forwardZone *resource.AgentForwardZone, bundle []byte) []byte {
+	if len(bundle) != 0 || forwardZone.TlsEnable == false {
+		return bundle
+	}
+
+	existing, err := ioutil.ReadFile(handler.dnsTLSFile(forwardZone.TLSName(), dnsTLSCASuffix))
+	if err != nil {
+		return nil
+	}
+
+	return existing
+}
+
+func probeForwardZone(forwardZone *resource.AgentForwardZone, bundle []byte) error {
+	if err := forwardZone.ValidateTLS(); err != nil || forwardZone.TlsEnable == false {
+		return err
+	}
+
+	addresses, err := forwardZone.ProbeAddresses()
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		if err := validator.ProbeTLS(address, forwardZone.TlsServerName, bundle, validator.TLSProbeTimeout); err != nil {
+			return fmt.Errorf("forwarder of zone %s is unreachable: %s", forwardZone.Name, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (handler *DNSHandler) forwardTLS(forwardZone *resource.AgentForwardZone) ForwardTLS {
+	name := forwardZone.TLSName()
+	forwardTLS := ForwardTLS{Name: name, ServerName: forwardZone.TlsServerName}
+	if handler.dnsTLSFileExists(name, dnsTLSCASuffix) {
+		forwardTLS.CAFile = handler.dnsTLSFile(name, dnsTLSCASuffix)
+	}
+
+	return forwardTLS
+}
@@ -36,20 +36,21 @@ func (service *DNSService) StopDNS(context context.Context, req *pb.DNSStopReq)
 	return &pb.DDIResponse{Succeed: true}, nil
 }
 
-func (service *DNSService) Close() {
-	service.handler.Close()
+func (service *DNSService) Close(ctx context.Context) error {
+	return service.handler.Close(ctx)
 }
 
 func (service *DNSService) UpdateGlobalConfig(context context.Context, req *pb.UpdateGlobalConfigReq) (*pb.DDIResponse, error) {
-	if err := service.handler.UpdateGlobalConfig(req); err != nil {
+	diffs, err := service.handler.UpdateGlobalConfig(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) CreateAcl(context context.Context, req *pb.CreateAclReq) (*pb.DDIResponse, error) {
-	if err := service.handler.CreateACL(req); err != nil {
+	if err := service.handler.CreateACL(context, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
@@ -57,7 +58,7 @@ func (service *DNSService) CreateAcl(context context.Context, req *pb.CreateAclR
 }
 
 func (service *DNSService) UpdateAcl(context context.Context, req *pb.UpdateAclReq) (*pb.DDIResponse, error) {
-	if err := service.handler.UpdateACL(req); err != nil {
+	if err := service.handler.UpdateACL(context, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
@@ -65,7 +66,7 @@ func (service *DNSService) UpdateAcl(context context.Context, req *pb.UpdateAclR
 }
 
 func (service *DNSService) DeleteAcl(context context.Context, req *pb.DeleteAclReq) (*pb.DDIResponse, error) {
-	if err := service.handler.DeleteACL(req); err != nil {
+	if err := service.handler.DeleteACL(context, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
@@ -73,7 +74,7 @@ func (service *DNSService) DeleteAcl(context context.Context, req *pb.DeleteAclR
 }
 
 func (service *DNSService) BatchCreateAcl(context context.Context, req *pb.BatchCreateAclReq) (*pb.DDIResponse, error) {
-	if err := service.handler.BatchCreateACL(req); err != nil {
+	if err := service.handler.BatchCreateACL(context, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
@@ -81,55 +82,61 @@ func (service *DNSService) BatchCreateAcl(context context.Context, req *pb.Batch
 }
 
 func (service *DNSService) CreateView(context context.Context, req *pb.CreateViewReq) (*pb.DDIResponse, error) {
-	if err := service.handler.CreateView(req); err != nil {
+	diffs, err := service.handler.CreateView(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) UpdateView(context context.Context, req *pb.UpdateViewReq) (*pb.DDIResponse, error) {
-	if err := service.handler.UpdateView(req); err != nil {
+	diffs, err := service.handler.UpdateView(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) DeleteView(context context.Context, req *pb.DeleteViewReq) (*pb.DDIResponse, error) {
-	if err := service.handler.DeleteView(req); err != nil {
+	diffs, err := service.handler.DeleteView(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) CreateAuthZone(context context.Context, req *pb.CreateAuthZoneReq) (*pb.DDIResponse, error) {
-	if err := service.handler.CreateAuthZone(req); err != nil {
+	diffs, err := service.handler.CreateAuthZone(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) UpdateAuthZone(context context.Context, req *pb.UpdateAuthZoneReq) (*pb.DDIResponse, error) {
-	if err := service.handler.UpdateAuthZone(req); err != nil {
+	diffs, err := service.handler.UpdateAuthZone(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) DeleteAuthZone(context context.Context, req *pb.DeleteAuthZoneReq) (*pb.DDIResponse, error) {
-	if err := service.handler.DeleteAuthZone(req); err != nil {
+	diffs, err := service.handler.DeleteAuthZone(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) CreateAuthZoneAuthRRs(context context.Context, req *pb.CreateAuthZoneAuthRRsReq) (*pb.DDIResponse, error) {
-	if err := service.handler.CreateAuthZoneAuthRRs(req); err != nil {
+	if err := service.handler.CreateAuthZoneAuthRRs(context, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
@@ -137,7 +144,7 @@ func (service *DNSService) CreateAuthZoneAuthRRs(context context.Context, req *p
 }
 
 func (service *DNSService) UpdateAuthZoneAXFR(context context.Context, req *pb.UpdateAuthZoneAXFRReq) (*pb.DDIResponse, error) {
-	if err := service.handler.UpdateAuthZoneAXFR(req); err != nil {
+	if err := service.handler.UpdateAuthZoneAXFR(context, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
@@ -145,7 +152,7 @@ func (service *DNSService) UpdateAuthZoneAXFR(context context.Context, req *pb.U
 }
 
 func (service *DNSService) UpdateAuthZoneIXFR(context context.Context, req *pb.UpdateAuthZoneIXFRReq) (*pb.DDIResponse, error) {
-	if err := service.handler.UpdateAuthZoneIXFR(req); err != nil {
+	if err := service.handler.UpdateAuthZoneIXFR(context, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
@@ -153,63 +160,70 @@ func (service *DNSService) UpdateAuthZoneIXFR(context context.Context, req *pb.U
 }
 
 func (service *DNSService) CreateAuthRR(context context.Context, req *pb.CreateAuthRRReq) (*pb.DDIResponse, error) {
-	if err := service.handler.CreateAuthRR(req); err != nil {
+	diffs, err := service.handler.CreateAuthRR(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) UpdateAuthRR(context context.Context, req *pb.UpdateAuthRRReq) (*pb.DDIResponse, error) {
-	if err := service.handler.UpdateAuthRR(req); err != nil {
+	diffs, err := service.handler.UpdateAuthRR(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) DeleteAuthRR(context context.Context, req *pb.DeleteAuthRRReq) (*pb.DDIResponse, error) {
-	if err := service.handler.DeleteAuthRR(req); err != nil {
+	diffs, err := service.handler.DeleteAuthRR(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) BatchCreateAuthRRs(context context.Context, req *pb.BatchCreateAuthRRsReq) (*pb.DDIResponse, error) {
-	if err := service.handler.BatchCreateAuthRRs(req); err != nil {
+	diffs, err := service.handler.BatchCreateAuthRRs(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) CreateRedirection(context context.Context, req *pb.CreateRedirectionReq) (*pb.DDIResponse, error) {
-	if err := service.handler.CreateRedirection(req); err != nil {
+	diffs, err := service.handler.CreateRedirection(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) UpdateRedirection(context context.Context, req *pb.UpdateRedirectionReq) (*pb.DDIResponse, error) {
-	if err := service.handler.UpdateRedirection(req); err != nil {
+	diffs, err := service.handler.UpdateRedirection(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) DeleteRedirection(context context.Context, req *pb.DeleteRedirectionReq) (*pb.DDIResponse, error) {
-	if err := service.handler.DeleteRedirection(req); err != nil {
+	diffs, err := service.handler.DeleteRedirection(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) CreateNginxProxy(context context.Context, req *pb.CreateNginxProxyReq) (*pb.DDIResponse, error) {
-	if err := service.handler.CreateNginxProxy(req); err != nil {
+	if err := service.handler.CreateNginxProxy(context, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
@@ -217,7 +231,7 @@ func (service *DNSService) CreateNginxProxy(context context.Context, req *pb.Cre
 }
 
 func (service *DNSService) UpdateNginxProxy(context context.Context, req *pb.UpdateNginxProxyReq) (*pb.DDIResponse, error) {
-	if err := service.handler.UpdateNginxProxy(req); err != nil {
+	if err := service.handler.UpdateNginxProxy(context, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
@@ -225,7 +239,7 @@ func (service *DNSService) UpdateNginxProxy(context context.Context, req *pb.Upd
 }
 
 func (service *DNSService) DeleteNginxProxy(context context.Context, req *pb.DeleteNginxProxyReq) (*pb.DDIResponse, error) {
-	if err := service.handler.DeleteNginxProxy(req); err != nil {
+	if err := service.handler.DeleteNginxProxy(context, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
@@ -233,31 +247,34 @@ func (service *DNSService) DeleteNginxProxy(context context.Context, req *pb.Del
 }
 
 func (service *DNSService) CreateForwardZone(context context.Context, req *pb.CreateForwardZoneReq) (*pb.DDIResponse, error) {
-	if err := service.handler.CreateForwardZone(req); err != nil {
+	diffs, err := service.handler.CreateForwardZone(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) UpdateForwardZone(context context.Context, req *pb.UpdateForwardZoneReq) (*pb.DDIResponse, error) {
-	if err := service.handler.UpdateForwardZone(req); err != nil {
+	diffs, err := service.handler.UpdateForwardZone(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) DeleteForwardZone(context context.Context, req *pb.DeleteForwardZoneReq) (*pb.DDIResponse, error) {
-	if err := service.handler.DeleteForwardZone(req); err != nil {
+	diffs, err := service.handler.DeleteForwardZone(context, req)
+	if err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
-	return &pb.DDIResponse{Succeed: true}, nil
+	return &pb.DDIResponse{Succeed: true, DryRun: req.GetDryRun(), Diffs: diffs}, nil
 }
 
 func (service *DNSService) FlushForwardZone(context context.Context, req *pb.FlushForwardZoneReq) (*pb.DDIResponse, error) {
-	if err := service.handler.FlushForwardZone(req); err != nil {
+	if err := service.handler.FlushForwardZone(context, req); err != nil {
 		return &pb.DDIResponse{Succeed: false}, err
 	}
 
@@ -271,3 +288,73 @@ func (service *DNSService) UploadLog(context context.Context, req *pb.UploadLogR
 
 	return &pb.DDIResponse{Succeed: true}, nil
 }
+
+func (service *DNSService) GetDNSTopStats(context context.Context, req *pb.GetDNSTopStatsReq) (*pb.GetDNSTopStatsResponse, error) {
+	resp, err := service.handler.GetDNSTopStats(req)
+	if err != nil {
+		return &pb.GetDNSTopStatsResponse{Succeed: false}, err
+	}
+
+	return resp, nil
+}
+
+func (service *DNSService) ListConfigSnapshots(context context.Context, req *pb.ListConfigSnapshotsReq) (*pb.ListConfigSnapshotsResponse, error) {
+	resp, err := service.handler.ListConfigSnapshots(req)
+	if err != nil {
+		return &pb.ListConfigSnapshotsResponse{Succeed: false}, err
+	}
+
+	return resp, nil
+}
+
+func (service *DNSService) DiffConfigSnapshots(context context.Context, req *pb.DiffConfigSnapshotsReq) (*pb.DiffConfigSnapshotsResponse, error) {
+	resp, err := service.handler.DiffConfigSnapshots(req)
+	if err != nil {
+		return &pb.DiffConfigSnapshotsResponse{Succeed: false}, err
+	}
+
+	return resp, nil
+}
+
+func (service *DNSService) RollbackConfigSnapshot(context context.Context, req *pb.RollbackConfigSnapshotReq) (*pb.DDIResponse, error) {
+	if err := service.handler.RollbackConfigSnapshot(req); err != nil {
+		return &pb.DDIResponse{Succeed: false}, err
+	}
+
+	return &pb.DDIResponse{Succeed: true}, nil
+}
+
+func (service *DNSService) DiagnoseQuery(context context.Context, req *pb.DiagnoseQueryReq) (*pb.DiagnoseQueryResponse, error) {
+	resp, err := service.handler.DiagnoseQuery(req)
+	if err != nil {
+		return &pb.DiagnoseQueryResponse{Succeed: false}, err
+	}
+
+	return resp, nil
+}
+
+func (service *DNSService) FlushDNSCache(context context.Context, req *pb.FlushDNSCacheReq) (*pb.DDIResponse, error) {
+	if err := service.handler.FlushDNSCache(req); err != nil {
+		return &pb.DDIResponse{Succeed: false}, err
+	}
+
+	return &pb.DDIResponse{Succeed: true}, nil
+}
+
+func (service *DNSService) GetDNSCacheStats(context context.Context, req *pb.GetDNSCacheStatsReq) (*pb.GetDNSCacheStatsResponse, error) {
+	resp, err := service.handler.GetDNSCacheStats(req)
+	if err != nil {
+		return &pb.GetDNSCacheStatsResponse{Succeed: false}, err
+	}
+
+	return resp, nil
+}
+
+func (service *DNSService) DumpDNSCache(context context.Context, req *pb.DumpDNSCacheReq) (*pb.DumpDNSCacheResponse, error) {
+	resp, err := service.handler.DumpDNSCache(req)
+	if err != nil {
+		return &pb.DumpDNSCacheResponse{Succeed: false}, err
+	}
+
+	return resp, nil
+}
@@ -4,18 +4,21 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	restdb "github.com/zdnscloud/gorest/db"
 
-	"github.com/linkingthing/ddi-agent/pkg/db"
+	"github.com/linkingthing/ddi-agent/pkg/agentmetric"
 	"github.com/linkingthing/ddi-agent/pkg/dns/dbhandler"
 	"github.com/linkingthing/ddi-agent/pkg/dns/resource"
+	"github.com/linkingthing/ddi-agent/pkg/dns/validator"
+	"github.com/linkingthing/ddi-agent/pkg/fileutil"
 	"github.com/linkingthing/ddi-agent/pkg/grpcclient"
+	"github.com/linkingthing/ddi-agent/pkg/nginxlog"
 	monitorpb "github.com/linkingthing/ddi-monitor/pkg/proto"
 )
 
@@ -27,18 +30,24 @@ type NamedData struct {
 }
 
 type NamedOption struct {
-	ConfigPath       string
-	DnssecEnable     bool
-	LogEnable        bool
-	BlackholeEnable  bool
-	Blackholes       []string
-	RecursionEnable  bool
-	RecursiveClients uint32
-	TransferPort     uint32
+	ConfigPath         string
+	LogEnable          bool
+	BlackholeEnable    bool
+	Blackholes         []string
+	RecursionEnable    bool
+	RecursiveClients   uint32
+	TransferPort       uint32
+	TLSs               []NamedTLS
+	EncryptedListeners []EncryptedListener
+	EncryptedIPv4s     []string
+	EncryptedIPv6s     []string
+	DohHTTP            string
+	DohEndpoint        string
 }
 
 type NamedViews struct {
-	Views []View
+	Views       []View
+	ForwardTLSs []ForwardTLS
 }
 
 type NamedAcl struct {
@@ -47,15 +56,16 @@ type NamedAcl struct {
 }
 
 type View struct {
-	Name      string
-	ACLs      []ACL
-	Zones     []resource.ZoneData
-	Redirect  *Redirect
-	RPZ       *Rpz
-	DNS64s    []Dns64
-	Key       string
-	DeniedIPs []string
-	Recursion bool
+	Name              string
+	ACLs              []ACL
+	Zones             []resource.ZoneData
+	Redirect          *Redirect
+	RPZ               *Rpz
+	DNS64s            []Dns64
+	Key               string
+	DeniedIPs         []string
+	Recursion         bool
+	MatchDestinations []string
 }
 
 type ACL struct {
@@ -90,15 +100,13 @@ type nzfData struct {
 }
 
 type nginxDefaultConf struct {
-	URLRedirects []urlRedirect
-}
-
-type urlRedirect struct {
-	Domain string
-	Url    string
+	Proxies      []*resource.NginxProxyConf
+	ACMEWebroot  string
+	HTTPSDomains []httpsDomain
+	AccessLog    *resource.NginxAccessLog
 }
 
-func (handler *DNSHandler) initFiles() error {
+func (handler *DNSHandler) initPaths() error {
 	handler.nginxConfPath = filepath.Join(handler.nginxDefaultConfDir, nginxDefaultConfFile)
 	handler.namedViewPath = filepath.Join(handler.dnsConfPath, namedViewConfName)
 	handler.namedOptionPath = filepath.Join(handler.dnsConfPath, namedOptionsConfName)
@@ -107,11 +115,11 @@ func (handler *DNSHandler) initFiles() error {
 		return fmt.Errorf("init path failed path is empty")
 	}
 
-	if err := createOneFolder(filepath.Join(handler.dnsConfPath, "redirection")); err != nil {
-		return err
-	}
+	return createOneFolder(filepath.Join(handler.dnsConfPath, "redirection"))
+}
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+func (handler *DNSHandler) initFiles() error {
+	_, err := handler.withJournal(nil, func(tx restdb.Transaction) error {
 		if err := handler.initNamedConf(); err != nil {
 			return fmt.Errorf("initNamedConf failed:%s", err.Error())
 		}
@@ -142,6 +150,7 @@ func (handler *DNSHandler) initFiles() error {
 
 		return nil
 	})
+	return err
 }
 
 func (handler *DNSHandler) initNamedConf() error {
@@ -154,25 +163,49 @@ func (handler *DNSHandler) initNamedConf() error {
 }
 
 func (handler *DNSHandler) rndcReconfig() error {
+	if handler.dryRun != nil {
+		return nil
+	}
+
+	handler.journal.Reload(journalReloadNamed, handler.rndcReload)
 	_, err := grpcclient.GetDDIMonitorGrpcClient().ReconfigDNS(context.Background(), &monitorpb.ReconfigDNSRequest{})
 	return err
 }
 
 func (handler *DNSHandler) rndcReload() error {
+	if handler.dryRun != nil {
+		return nil
+	}
+
+	handler.journal.Reload(journalReloadNamed, handler.rndcReload)
 	_, err := grpcclient.GetDDIMonitorGrpcClient().ReloadDNSConfig(context.Background(), &monitorpb.ReloadDNSConfigRequest{})
 	return err
 }
 
 func (handler *DNSHandler) rndcAddZone(zone *resource.AgentAuthZone) error {
+	if handler.dryRun != nil {
+		return nil
+	}
+
 	zoneData := zone.ToZoneData()
 	_, err := grpcclient.GetDDIMonitorGrpcClient().AddDNSZone(context.Background(), &monitorpb.AddDNSZoneRequest{
 		Zone: &monitorpb.Zone{ZoneName: zoneData.Name, ZoneFile: zoneData.ZoneFile,
 			ZoneRole: zoneData.Role, ZoneMasters: zoneData.Masters, ZoneSlaves: zoneData.Slaves, ViewName: zone.AgentView},
 	})
+	if err == nil {
+		handler.journal.Undo("add zone "+zone.Name, func() error {
+			return handler.rndcDeleteZone(zone.Name, zone.AgentView)
+		})
+	}
 	return err
 }
 
 func (handler *DNSHandler) rndcModifyZone(zone *resource.AgentAuthZone) error {
+	if handler.dryRun != nil {
+		return nil
+	}
+
+	handler.journal.Reload(journalReloadNamed, handler.rndcReload)
 	zoneData := zone.ToZoneData()
 	_, err := grpcclient.GetDDIMonitorGrpcClient().UpdateDNSZone(context.Background(), &monitorpb.UpdateDNSZoneRequest{
 		Zone: &monitorpb.Zone{ZoneName: zoneData.Name, ZoneFile: zoneData.ZoneFile,
@@ -182,6 +215,11 @@ func (handler *DNSHandler) rndcModifyZone(zone *resource.AgentAuthZone) error {
 }
 
 func (handler *DNSHandler) rndcDeleteZone(zoneName string, viewName string) error {
+	if handler.dryRun != nil {
+		return nil
+	}
+
+	handler.journal.Reload(journalReloadNamed, handler.rndcReload)
 	_, err := grpcclient.GetDDIMonitorGrpcClient().DeleteDNSZone(context.Background(), &monitorpb.DeleteDNSZoneRequest{
 		ZoneName: zoneName,
 		ViewName: viewName,
@@ -190,11 +228,19 @@ func (handler *DNSHandler) rndcDeleteZone(zoneName string, viewName string) erro
 }
 
 func (handler *DNSHandler) rndcDumpJNLFile() error {
+	if handler.dryRun != nil {
+		return nil
+	}
+
 	_, err := grpcclient.GetDDIMonitorGrpcClient().DumpDNSAllZonesConfig(context.Background(), &monitorpb.DumpDNSAllZonesConfigRequest{})
 	return err
 }
 
 func (handler *DNSHandler) rndcZoneDumpJNLFile(zoneName string, viewName string) error {
+	if handler.dryRun != nil {
+		return handler.previewAuthZoneFile(zoneName, viewName)
+	}
+
 	_, err := grpcclient.GetDDIMonitorGrpcClient().DumpDNSZoneConfig(context.Background(), &monitorpb.DumpDNSZoneConfigRequest{
 		ZoneName: zoneName,
 		ViewName: viewName,
@@ -203,16 +249,32 @@ func (handler *DNSHandler) rndcZoneDumpJNLFile(zoneName string, viewName string)
 }
 
 func (handler *DNSHandler) rewriteNginxHttpFile(tx restdb.Transaction) error {
-	data := nginxDefaultConf{}
+	data := nginxDefaultConf{AccessLog: handler.nginxAccessLog(nginxlog.FormatName)}
 	var urlRedirectList []*resource.AgentNginxProxy
 	if err := dbhandler.ListWithTx(&urlRedirectList, tx); err != nil {
 		return err
 	}
 
+	httpDomains := make(map[string]bool)
 	for _, urlValue := range urlRedirectList {
 		if !urlValue.IsHttps {
-			data.URLRedirects = append(data.URLRedirects,
-				urlRedirect{Domain: urlValue.Domain, Url: urlValue.Url})
+			conf, err := getNginxProxyConf(tx, urlValue)
+			if err != nil {
+				return err
+			}
+			data.Proxies = append(data.Proxies, conf)
+			httpDomains[urlValue.Domain] = true
+		}
+	}
+
+	if handler.acme != nil {
+		data.ACMEWebroot = handler.acmeConf.Webroot
+	}
+
+	for _, urlValue := range urlRedirectList {
+		if urlValue.IsHttps && httpDomains[urlValue.Domain] == false && (urlValue.HttpToHttps || (handler.acme != nil && urlValue.AcmeManaged)) {
+			data.HTTPSDomains = append(data.HTTPSDomains,
+				httpsDomain{Domain: urlValue.Domain, Redirect: urlValue.HttpToHttps})
 		}
 	}
 
@@ -224,35 +286,37 @@ func (handler *DNSHandler) rewriteNginxHttpFile(tx restdb.Transaction) error {
 	return handler.nginxReload()
 }
 
-func (handler *DNSHandler) addNginxHttpsFile(key, crt []byte, urlRedirect *resource.AgentNginxProxy) error {
-	if err := createOneFolder(handler.nginxKeyDir); err != nil {
+func (handler *DNSHandler) addNginxHttpsFile(tx restdb.Transaction, key, crt []byte, urlRedirect *resource.AgentNginxProxy) error {
+	if err := handler.createFolder(handler.nginxKeyDir); err != nil {
 		return fmt.Errorf("create folder:%s  failed:%s", handler.nginxKeyDir, err.Error())
 	}
-	if err := ioutil.WriteFile(
-		path.Join(handler.nginxKeyDir, urlRedirect.Domain+".key"), key, FilePermissions); err != nil {
+	if err := handler.writeKeyFile(path.Join(handler.nginxKeyDir, urlRedirect.Domain+".key"), key); err != nil {
 		return fmt.Errorf("writeNginxSSLFile key failed:%s", err.Error())
 	}
-	if err := ioutil.WriteFile(
-		path.Join(handler.nginxKeyDir, urlRedirect.Domain+".crt"), crt, FilePermissions); err != nil {
+	if err := handler.writeFile(path.Join(handler.nginxKeyDir, urlRedirect.Domain+".crt"), crt); err != nil {
 		return fmt.Errorf("writeNginxSSLFile crt failed:%s", err.Error())
 	}
 
-	if err := handler.flushTemplateFiles(nginxSslTpl,
-		path.Join(handler.nginxDefaultConfDir, urlRedirect.Domain+".conf"), urlRedirect); err != nil {
-		return err
+	return handler.flushNginxHttpsFile(tx, urlRedirect)
+}
+
+func (handler *DNSHandler) updateNginxHttpsFile(tx restdb.Transaction, urlRedirect *resource.AgentNginxProxy) error {
+	if _, err := os.Stat(path.Join(handler.nginxKeyDir, urlRedirect.Domain+".crt")); os.IsNotExist(err) {
+		return nil
 	}
 
-	return handler.nginxReload()
+	return handler.flushNginxHttpsFile(tx, urlRedirect)
 }
 
-func (handler *DNSHandler) updateNginxHttpsFile(urlRedirect *resource.AgentNginxProxy) error {
-	domainConf := urlRedirect.Domain + ".conf"
-	if err := removeFile(path.Join(handler.nginxDefaultConfDir, domainConf)); err != nil {
-		return fmt.Errorf("updateNginxHttpsFile  remove file:%s  failed:%s", domainConf, err.Error())
+func (handler *DNSHandler) flushNginxHttpsFile(tx restdb.Transaction, urlRedirect *resource.AgentNginxProxy) error {
+	conf, err := getNginxProxyConf(tx, urlRedirect)
+	if err != nil {
+		return err
 	}
 
-	if err := handler.flushTemplateFiles(
-		nginxSslTpl, path.Join(handler.nginxDefaultConfDir, domainConf), urlRedirect); err != nil {
+	conf.AccessLog = handler.nginxAccessLog(nginxlog.FormatName + "_" + resource.NginxConfName(urlRedirect.Domain))
+	if err := handler.flushTemplateFiles(nginxSslTpl,
+		path.Join(handler.nginxDefaultConfDir, urlRedirect.Domain+".conf"), conf); err != nil {
 		return err
 	}
 
@@ -261,20 +325,37 @@ func (handler *DNSHandler) updateNginxHttpsFile(urlRedirect *resource.AgentNginx
 
 func (handler *DNSHandler) removeNginxHttpsFile(domain string) error {
 	domainConf := domain + ".conf"
-	if err := removeFile(path.Join(handler.nginxDefaultConfDir, domainConf)); err != nil {
+	if err := handler.removeFile(path.Join(handler.nginxDefaultConfDir, domainConf)); err != nil {
 		return fmt.Errorf("removeNginxHttpsFile file:%s  failed:%s", domainConf, err.Error())
 	}
-	if err := removeFile(path.Join(handler.nginxKeyDir, domain+".key")); err != nil {
+	if err := handler.removeFile(path.Join(handler.nginxKeyDir, domain+".key")); err != nil {
 		return fmt.Errorf("removeNginxHttpsFile file:%s  failed:%s", handler.nginxKeyDir, err.Error())
 	}
-	if err := removeFile(path.Join(handler.nginxKeyDir, domain+".crt")); err != nil {
+	if err := handler.removeFile(path.Join(handler.nginxKeyDir, domain+".crt")); err != nil {
 		return fmt.Errorf("removeNginxHttpsFile file:%s  failed:%s", handler.nginxKeyDir, err.Error())
 	}
 
 	return handler.nginxReload()
 }
 
+func (handler *DNSHandler) nginxAccessLog(formatName string) *resource.NginxAccessLog {
+	if handler.nginxAccessLogPath == "" {
+		return nil
+	}
+
+	return &resource.NginxAccessLog{
+		Path:       handler.nginxAccessLogPath,
+		FormatName: formatName,
+		Format:     nginxlog.Format,
+	}
+}
+
 func (handler *DNSHandler) nginxReload() error {
+	if handler.dryRun != nil {
+		return nil
+	}
+
+	handler.journal.Reload(journalReloadNginx, handler.nginxReload)
 	_, err := grpcclient.GetDDIMonitorGrpcClient().ReloadNginxConfig(context.Background(),
 		&monitorpb.ReloadNginxConfigRequest{})
 	return err
@@ -300,6 +381,11 @@ func (handler *DNSHandler) initNamedViewFile(tx restdb.Transaction) error {
 		return fmt.Errorf("rewriteViewFile failed:%s", err.Error())
 	}
 
+	matchDestinations, err := viewMatchDestinations(tx, viewList)
+	if err != nil {
+		return err
+	}
+
 	for _, value := range viewList {
 		var acls []ACL
 		for _, aclValue := range value.Acls {
@@ -314,6 +400,7 @@ func (handler *DNSHandler) initNamedViewFile(tx restdb.Transaction) error {
 		if len(acls) > 0 {
 			view.ACLs = acls
 		}
+		view.MatchDestinations = matchDestinations[value.Name]
 
 		for _, reValue := range redirectionList {
 			if reValue.AgentView == value.ID {
@@ -338,6 +425,9 @@ func (handler *DNSHandler) initNamedViewFile(tx restdb.Transaction) error {
 					return err
 				} else {
 					view.Zones = append(view.Zones, zoneData)
+					if zoneData.TLS != "" {
+						viewConfigData.ForwardTLSs = append(viewConfigData.ForwardTLSs, handler.forwardTLS(forwardZone))
+					}
 				}
 			}
 		}
@@ -374,6 +464,11 @@ func (handler *DNSHandler) rewriteNamedViewFile(tx restdb.Transaction, existRPZ
 		return fmt.Errorf("rewriteViewFile failed:%s", err.Error())
 	}
 
+	matchDestinations, err := viewMatchDestinations(tx, viewList)
+	if err != nil {
+		return err
+	}
+
 	for _, value := range viewList {
 		var acls []ACL
 		for _, aclValue := range value.Acls {
@@ -388,6 +483,7 @@ func (handler *DNSHandler) rewriteNamedViewFile(tx restdb.Transaction, existRPZ
 		if len(acls) > 0 {
 			view.ACLs = acls
 		}
+		view.MatchDestinations = matchDestinations[value.Name]
 
 		for _, reValue := range redirectionList {
 			if reValue.AgentView == value.ID {
@@ -414,6 +510,9 @@ func (handler *DNSHandler) rewriteNamedViewFile(tx restdb.Transaction, existRPZ
 					return err
 				} else {
 					view.Zones = append(view.Zones, zoneData)
+					if zoneData.TLS != "" {
+						viewConfigData.ForwardTLSs = append(viewConfigData.ForwardTLSs, handler.forwardTLS(forwardZone))
+					}
 				}
 			}
 		}
@@ -438,7 +537,6 @@ func (handler *DNSHandler) initNamedOptionsFile(tx restdb.Transaction) error {
 	}
 	globalConfig := globalConfigRes.(*resource.AgentDnsGlobalConfig)
 	namedOptionData.LogEnable = globalConfig.LogEnable
-	namedOptionData.DnssecEnable = globalConfig.DnssecEnable
 	namedOptionData.BlackholeEnable = globalConfig.BlackholeEnable
 	namedOptionData.Blackholes = globalConfig.Blackholes
 	namedOptionData.RecursionEnable = globalConfig.RecursionEnable
@@ -447,6 +545,10 @@ func (handler *DNSHandler) initNamedOptionsFile(tx restdb.Transaction) error {
 		namedOptionData.TransferPort = globalConfig.TransferPort
 	}
 
+	if err := handler.fillEncryptedListeners(tx, namedOptionData, globalConfig); err != nil {
+		return err
+	}
+
 	if err := handler.flushTemplateFiles(namedOptionsTpl,
 		handler.namedOptionPath, namedOptionData); err != nil {
 		return fmt.Errorf("flushTemplateFiles failed :%s", err.Error())
@@ -466,7 +568,6 @@ func (handler *DNSHandler) rewriteNamedOptionsFile(tx restdb.Transaction) error
 
 	globalConfig := globalConfigRes.(*resource.AgentDnsGlobalConfig)
 	namedOptionData.LogEnable = globalConfig.LogEnable
-	namedOptionData.DnssecEnable = globalConfig.DnssecEnable
 	namedOptionData.BlackholeEnable = globalConfig.BlackholeEnable
 	if len(globalConfig.Blackholes) == 0 {
 		namedOptionData.BlackholeEnable = false
@@ -478,6 +579,10 @@ func (handler *DNSHandler) rewriteNamedOptionsFile(tx restdb.Transaction) error
 		namedOptionData.TransferPort = globalConfig.TransferPort
 	}
 
+	if err := handler.fillEncryptedListeners(tx, namedOptionData, globalConfig); err != nil {
+		return err
+	}
+
 	if err := handler.flushTemplateFiles(namedOptionsTpl,
 		handler.namedOptionPath, namedOptionData); err != nil {
 		return fmt.Errorf("flushTemplateFiles failed :%s", err.Error())
@@ -525,7 +630,7 @@ func (handler *DNSHandler) rewriteNamedAclFile(tx restdb.Transaction) error {
 }
 
 func (handler *DNSHandler) initZoneFiles(tx restdb.Transaction) error {
-	if err := removeFiles(handler.dnsConfPath, "", zoneSuffix); err != nil {
+	if err := handler.removeFiles(handler.dnsConfPath, "", zoneSuffix); err != nil {
 		return fmt.Errorf("remvoe files for %s*.zone fail", handler.dnsConfPath)
 	}
 
@@ -568,26 +673,35 @@ func (handler *DNSHandler) createAuthZoneFile(zone *resource.AgentAuthZone) erro
 }
 
 func (handler *DNSHandler) rewriteAuthZoneFile(tx restdb.Transaction, zone *resource.AgentAuthZone) error {
+	authZone, err := getAuthZoneFileData(tx, zone)
+	if err != nil {
+		return err
+	}
+
+	zoneFile := zone.GetZoneFile()
+	if err := handler.removeFile(filepath.Join(handler.dnsConfPath, zoneFile)); err != nil {
+		return err
+	}
+
+	return handler.flushTemplateFiles(zoneTpl, filepath.Join(handler.dnsConfPath, zoneFile), authZone)
+}
+
+func getAuthZoneFileData(tx restdb.Transaction, zone *resource.AgentAuthZone) (resource.AuthZoneFileData, error) {
 	var rrs []*resource.AgentAuthRr
 	if err := tx.Fill(map[string]interface{}{"zone": zone.Name, "agent_view": zone.AgentView}, &rrs); err != nil {
-		return err
+		return resource.AuthZoneFileData{}, err
 	}
 
 	authZone := zone.ToAuthZoneFileData()
 	for _, r := range rrs {
 		rr, err := r.ToRR()
 		if err != nil {
-			return err
+			return resource.AuthZoneFileData{}, err
 		}
 		authZone.RRs = append(authZone.RRs, rr)
 	}
 
-	zoneFile := zone.GetZoneFile()
-	if err := removeFile(filepath.Join(handler.dnsConfPath, zoneFile)); err != nil {
-		return err
-	}
-
-	return handler.flushTemplateFiles(zoneTpl, filepath.Join(handler.dnsConfPath, zoneFile), authZone)
+	return authZone, nil
 }
 
 func (handler *DNSHandler) rewriteNzfsFile(tx restdb.Transaction) error {
@@ -597,7 +711,7 @@ func (handler *DNSHandler) rewriteNzfsFile(tx restdb.Transaction) error {
 		return err
 	}
 
-	if err := removeFiles(handler.dnsConfPath, "", nzfSuffix); err != nil {
+	if err := handler.removeFiles(handler.dnsConfPath, "", nzfSuffix); err != nil {
 		return fmt.Errorf("remove files for %s*.zone fail", handler.dnsConfPath)
 	}
 
@@ -621,7 +735,7 @@ func (handler *DNSHandler) rewriteNzfsFile(tx restdb.Transaction) error {
 }
 
 func (handler *DNSHandler) initRedirectFile(tx restdb.Transaction) error {
-	if err := removeFiles(
+	if err := handler.removeFiles(
 		filepath.Join(handler.dnsConfPath, "redirection"), "redirect_", ""); err != nil {
 		return fmt.Errorf("delete all the rpz file in %s err: %s",
 			filepath.Join(handler.dnsConfPath, "redirection"), err.Error())
@@ -631,7 +745,7 @@ func (handler *DNSHandler) initRedirectFile(tx restdb.Transaction) error {
 }
 
 func (handler *DNSHandler) rewriteOneRedirectFile(viewID string, tx restdb.Transaction) error {
-	if err := removeFile(
+	if err := handler.removeFile(
 		filepath.Join(handler.dnsConfPath, "redirection", "redirect_"+viewID)); err != nil {
 		return err
 	}
@@ -648,7 +762,7 @@ func (handler *DNSHandler) rewriteOneRedirectFile(viewID string, tx restdb.Trans
 }
 
 func (handler *DNSHandler) initRPZFile(tx restdb.Transaction) error {
-	if err := removeFiles(
+	if err := handler.removeFiles(
 		filepath.Join(handler.dnsConfPath, "redirection"), "rpz_", ""); err != nil {
 		return fmt.Errorf("delete all the rpz file in %s err: %s",
 			filepath.Join(handler.dnsConfPath, "redirection"), err.Error())
@@ -658,7 +772,7 @@ func (handler *DNSHandler) initRPZFile(tx restdb.Transaction) error {
 }
 
 func (handler *DNSHandler) rewriteOneRPZFile(viewID string, tx restdb.Transaction) error {
-	if err := removeFile(
+	if err := handler.removeFile(
 		filepath.Join(handler.dnsConfPath, "redirection", "rpz_"+viewID)); err != nil {
 		return err
 	}
@@ -764,20 +878,57 @@ func (handler *DNSHandler) flushTemplateFiles(tplName, tplConfName string, data
 
 func (handler *DNSHandler) rewriteFiles(tplName, tplConfName string, data interface{}, buffer *bytes.Buffer) error {
 	buffer.Reset()
+	renderStart := time.Now()
 	if err := handler.tpl.ExecuteTemplate(buffer, tplName, data); err != nil {
 		return fmt.Errorf("flushTemplateFiles tplName:%s tplConfName:%s  failed:%s",
 			tplName, tplConfName, err.Error())
 	}
+	agentmetric.ObserveTemplateRender(tplName, renderStart)
+
+	if err := handler.validateFile(tplName, tplConfName, data, buffer.Bytes()); err != nil {
+		return fmt.Errorf("validate %s failed: %s", tplConfName, err.Error())
+	}
 
-	if err := ioutil.WriteFile(tplConfName, buffer.Bytes(), FilePermissions); err != nil {
+	writeStart := time.Now()
+	if err := handler.writeFile(tplConfName, buffer.Bytes()); err != nil {
 		return fmt.Errorf("flushTemplateFiles tplName:%s tplConfName:%s  WriteFile failed:%s",
 			tplName, tplConfName, err.Error())
 	}
+	agentmetric.ObserveFileWrite(tplName, writeStart)
 
 	return nil
 }
 
-func removeFiles(dir string, prefix string, suffix string) error {
+func (handler *DNSHandler) validateFile(tplName, tplConfName string, data interface{}, content []byte) error {
+	checker := handler.checker
+	nginxChecker := handler.nginxChecker
+	if handler.dryRun != nil {
+		checker = validator.NewChecker("", "")
+		nginxChecker = validator.NewNginxChecker("", "")
+	}
+
+	switch tplName {
+	case namedTpl, namedAclTpl, namedViewTpl, namedOptionsTpl:
+		return checker.CheckConf(filepath.Join(handler.dnsConfPath, mainConfName), tplConfName, content)
+	case nzfTpl:
+		return validator.ValidateConf(content)
+	case zoneTpl:
+		if zone, ok := data.(resource.AuthZoneFileData); ok {
+			return checker.CheckZone(zone.Name, tplConfName, content)
+		}
+		return nil
+	case redirectTpl:
+		return checker.CheckZone(".", tplConfName, content)
+	case rpzTpl:
+		return checker.CheckZone("rpz", tplConfName, content)
+	case nginxDefaultTpl, nginxSslTpl:
+		return nginxChecker.Check(tplConfName, content)
+	default:
+		return nil
+	}
+}
+
+func (handler *DNSHandler) removeFiles(dir string, prefix string, suffix string) error {
 	d, err := os.Open(dir)
 	if err != nil {
 		return err
@@ -788,13 +939,15 @@ func removeFiles(dir string, prefix string, suffix string) error {
 		return err
 	}
 	for _, name := range names {
-		if prefix != "" && strings.HasPrefix(name, prefix) {
-			err = os.RemoveAll(filepath.Join(dir, name))
-			if err != nil {
+		if (prefix != "" && strings.HasPrefix(name, prefix)) || (suffix != "" && strings.HasSuffix(name, suffix)) {
+			if handler.dryRun != nil {
+				handler.dryRun.Remove(filepath.Join(dir, name))
+				continue
+			}
+
+			if err := handler.snapshotFile(filepath.Join(dir, name)); err != nil {
 				return err
 			}
-		}
-		if suffix != "" && strings.HasSuffix(name, suffix) {
 			err = os.RemoveAll(filepath.Join(dir, name))
 			if err != nil {
 				return err
@@ -814,9 +967,54 @@ func removeFolder(path string) error {
 	return nil
 }
 
-func removeFile(path string) error {
+func (handler *DNSHandler) writeFile(path string, data []byte) error {
+	return handler.writeFileWithPerm(path, data, ConfFilePermissions)
+}
+
+func (handler *DNSHandler) writeKeyFile(path string, data []byte) error {
+	if handler.dryRun != nil {
+		return nil
+	}
+
+	return handler.writeFileWithPerm(path, data, KeyFilePermissions)
+}
+
+func (handler *DNSHandler) writeNamedKeyFile(path string, data []byte) error {
+	if err := handler.writeKeyFile(path, data); err != nil || handler.dryRun != nil || handler.namedUser == "" {
+		return err
+	}
+
+	return fileutil.ChownToUser(path, handler.namedUser)
+}
+
+func (handler *DNSHandler) writeFileWithPerm(path string, data []byte, perm os.FileMode) error {
+	if handler.dryRun != nil {
+		handler.dryRun.Write(path, data)
+		return nil
+	}
+
+	if err := handler.snapshotFile(path); err != nil {
+		return err
+	}
+
+	handler.auditEntry.BeforeFileWrite(path)
+	defer handler.auditEntry.AfterFileWrite(path)
+	return fileutil.WriteFileAtomic(path, data, perm)
+}
+
+func (handler *DNSHandler) removeFile(path string) error {
+	if handler.dryRun != nil {
+		handler.dryRun.Remove(path)
+		return nil
+	}
+
 	_, err := os.Stat(path)
 	if !os.IsNotExist(err) {
+		if err := handler.snapshotFile(path); err != nil {
+			return err
+		}
+		handler.auditEntry.BeforeFileWrite(path)
+		defer handler.auditEntry.AfterFileWrite(path)
 		if err := os.Remove(path); err != nil {
 			return fmt.Errorf("remove file %s failed:%s ", path, err.Error())
 		}
@@ -824,6 +1022,14 @@ func removeFile(path string) error {
 	return nil
 }
 
+func (handler *DNSHandler) createFolder(path string) error {
+	if handler.dryRun != nil {
+		return nil
+	}
+
+	return createOneFolder(path)
+}
+
 func createOneFolder(path string) error {
 	_, err := os.Stat(path)
 	if os.IsNotExist(err) {
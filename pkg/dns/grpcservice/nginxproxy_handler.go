@@ -0,0 +1,108 @@
+package grpcservice
+
+import (
+	"fmt"
+
+	restdb "github.com/zdnscloud/gorest/db"
+
+	"github.com/linkingthing/ddi-agent/pkg/dns/resource"
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+type nginxProxyReq interface {
+	GetDomain() string
+	GetUrl() string
+	GetIsHttps() bool
+	GetUpstreams() []string
+	GetLbMethod() string
+	GetMaxFails() uint32
+	GetFailTimeout() uint32
+	GetRedirectUrl() string
+	GetRedirectCode() uint32
+	GetLocations() []*pb.NginxLocation
+	GetHttpToHttps() bool
+	GetHsts() bool
+	GetHstsMaxAge() uint32
+	GetTlsProfile() string
+	GetTlsProtocols() []string
+	GetTlsCiphers() string
+}
+
+type httpsDomain struct {
+	Domain   string
+	Redirect bool
+}
+
+func nginxProxyFromReq(req nginxProxyReq) (*resource.AgentNginxProxy, []*resource.AgentNginxLocation) {
+	proxy := &resource.AgentNginxProxy{
+		Domain:       req.GetDomain(),
+		Url:          req.GetUrl(),
+		IsHttps:      req.GetIsHttps(),
+		Upstreams:    req.GetUpstreams(),
+		LbMethod:     req.GetLbMethod(),
+		MaxFails:     req.GetMaxFails(),
+		FailTimeout:  req.GetFailTimeout(),
+		RedirectUrl:  req.GetRedirectUrl(),
+		RedirectCode: req.GetRedirectCode(),
+		HttpToHttps:  req.GetHttpToHttps(),
+		Hsts:         req.GetHsts(),
+		HstsMaxAge:   req.GetHstsMaxAge(),
+		TlsProfile:   req.GetTlsProfile(),
+		TlsProtocols: req.GetTlsProtocols(),
+		TlsCiphers:   req.GetTlsCiphers(),
+	}
+
+	var locations []*resource.AgentNginxLocation
+	for _, location := range req.GetLocations() {
+		locations = append(locations, &resource.AgentNginxLocation{
+			Domain:       proxy.Domain,
+			IsHttps:      proxy.IsHttps,
+			Path:         location.GetPath(),
+			Url:          location.GetUrl(),
+			Upstreams:    location.GetUpstreams(),
+			LbMethod:     location.GetLbMethod(),
+			MaxFails:     location.GetMaxFails(),
+			FailTimeout:  location.GetFailTimeout(),
+			RedirectUrl:  location.GetRedirectUrl(),
+			RedirectCode: location.GetRedirectCode(),
+		})
+	}
+
+	return proxy, locations
+}
+
+func (handler *DNSHandler) saveNginxLocations(tx restdb.Transaction, proxy *resource.AgentNginxProxy, locations []*resource.AgentNginxLocation) error {
+	if _, err := proxy.ToProxyConf(append([]*resource.AgentNginxLocation(nil), locations...)); err != nil {
+		return err
+	}
+
+	if err := deleteNginxLocations(tx, proxy.Domain, proxy.IsHttps); err != nil {
+		return err
+	}
+
+	for _, location := range locations {
+		if _, err := tx.Insert(location); err != nil {
+			return fmt.Errorf("insert location %s of nginx proxy %s failed: %s", location.Path, proxy.Domain, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func deleteNginxLocations(tx restdb.Transaction, domain string, isHttps bool) error {
+	if _, err := tx.Delete(resource.TableAgentNginxLocation,
+		map[string]interface{}{"domain": domain, "is_https": isHttps}); err != nil {
+		return fmt.Errorf("delete locations of nginx proxy %s failed: %s", domain, err.Error())
+	}
+
+	return nil
+}
+
+func getNginxProxyConf(tx restdb.Transaction, proxy *resource.AgentNginxProxy) (*resource.NginxProxyConf, error) {
+	var locations []*resource.AgentNginxLocation
+	if err := tx.Fill(map[string]interface{}{"domain": proxy.Domain, "is_https": proxy.IsHttps}, &locations); err != nil {
+		return nil, fmt.Errorf("get locations of nginx proxy %s failed: %s", proxy.Domain, err.Error())
+	}
+
+	return proxy.ToProxyConf(locations)
+}
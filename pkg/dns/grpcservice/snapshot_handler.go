@@ -0,0 +1,152 @@
+package grpcservice
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/zdnscloud/cement/log"
+
+	"github.com/linkingthing/ddi-agent/pkg/dns/snapshot"
+	"github.com/linkingthing/ddi-agent/pkg/journal"
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+func (handler *DNSHandler) takeSnapshot() {
+	if handler.snapshots == nil {
+		return
+	}
+
+	if _, err := handler.snapshots.Take(); err != nil {
+		log.Warnf("take dns config snapshot failed: %s", err.Error())
+	}
+}
+
+func (handler *DNSHandler) clearSnapshotDrift() {
+	if handler.snapshots == nil {
+		return
+	}
+
+	if err := handler.snapshots.ClearDrift(); err != nil {
+		log.Warnf("clear dns config drift failed: %s", err.Error())
+	}
+}
+
+func (handler *DNSHandler) snapshotDrift() *snapshot.Drift {
+	if handler.snapshots == nil {
+		return nil
+	}
+
+	drift, err := handler.snapshots.Drift()
+	if err != nil {
+		log.Warnf("get dns config drift failed: %s", err.Error())
+		return nil
+	}
+
+	return drift
+}
+
+func (handler *DNSHandler) snapshotFile(path string) error {
+	if handler.drift != nil && strings.HasPrefix(path, handler.dnsConfPath+string(filepath.Separator)) {
+		return fmt.Errorf("dns config is rolled back to snapshot %s, start dns to regenerate it from db before changing %s",
+			handler.drift.SnapshotID, path)
+	}
+
+	return handler.journal.Snapshot(path)
+}
+
+func (handler *DNSHandler) ListConfigSnapshots(req *pb.ListConfigSnapshotsReq) (*pb.ListConfigSnapshotsResponse, error) {
+	if handler.snapshots == nil {
+		return nil, fmt.Errorf("dns config snapshot is disabled")
+	}
+
+	snapshots, err := handler.snapshots.List()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListConfigSnapshotsResponse{Succeed: true}
+	for _, s := range snapshots {
+		resp.Snapshots = append(resp.Snapshots, &pb.ConfigSnapshot{
+			Id:         s.ID,
+			CreateTime: s.CreateTime.Format(snapshot.TimeFormat),
+			FileCount:  uint32(len(s.Files)),
+			Size:       uint64(s.Size()),
+		})
+	}
+
+	drift, err := handler.snapshots.Drift()
+	if err != nil {
+		return nil, err
+	}
+
+	if drift != nil {
+		resp.DriftSnapshotId = drift.SnapshotID
+		resp.DriftTime = drift.Time.Format(snapshot.TimeFormat)
+	}
+
+	return resp, nil
+}
+
+func (handler *DNSHandler) DiffConfigSnapshots(req *pb.DiffConfigSnapshotsReq) (*pb.DiffConfigSnapshotsResponse, error) {
+	if handler.snapshots == nil {
+		return nil, fmt.Errorf("dns config snapshot is disabled")
+	}
+
+	diffs, err := handler.snapshots.Diff(req.GetFromId(), req.GetToId())
+	if err != nil {
+		return nil, fmt.Errorf("diff snapshot %s with %s failed: %s", req.GetFromId(), req.GetToId(), err.Error())
+	}
+
+	resp := &pb.DiffConfigSnapshotsResponse{Succeed: true}
+	for _, diff := range diffs {
+		resp.Files = append(resp.Files, &pb.ConfigFileDiff{Path: diff.Path, Status: diff.Status, Diff: diff.Diff})
+	}
+
+	return resp, nil
+}
+
+func (handler *DNSHandler) RollbackConfigSnapshot(req *pb.RollbackConfigSnapshotReq) error {
+	if handler.snapshots == nil {
+		return fmt.Errorf("dns config snapshot is disabled")
+	}
+
+	handler.applyLock.Lock()
+	defer handler.applyLock.Unlock()
+
+	j := journal.New()
+	j.Reload(journalReloadNamed, handler.reloadNamedConf)
+	changed, err := handler.snapshots.Restore(req.GetId(), j)
+	if err == nil && len(changed) != 0 {
+		err = handler.reloadNamedConf()
+	}
+
+	if err != nil {
+		if rollbackErr := j.Rollback(); rollbackErr != nil {
+			log.Errorf("restore files %v failed: %s", j.Files(), rollbackErr.Error())
+			return fmt.Errorf("rollback to snapshot %s failed: %s, and restore files failed: %s",
+				req.GetId(), err.Error(), rollbackErr.Error())
+		}
+		return fmt.Errorf("rollback to snapshot %s failed: %s", req.GetId(), err.Error())
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	if err := handler.snapshots.MarkDrift(req.GetId()); err != nil {
+		log.Warnf("mark dns config drift failed: %s", err.Error())
+	}
+
+	log.Warnf("rollback dns config to snapshot %s, changed files %v, dns config changes are rejected until dns is started again",
+		req.GetId(), changed)
+	return nil
+}
+
+func (handler *DNSHandler) reloadNamedConf() error {
+	if err := handler.rndcReconfig(); err != nil {
+		return err
+	}
+
+	return handler.rndcReload()
+}
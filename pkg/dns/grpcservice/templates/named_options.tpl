@@ -3,20 +3,32 @@ options {
 	pid-file "named.pid";
 	allow-new-zones yes;
 	check-names master ignore;
-	listen-on {any;};
-    listen-on-v6 {any;};{{if .TransferPort}}
+	listen-on { {{range $k,$ip := .EncryptedIPv4s}}!{{$ip}}; {{end}}any;};
+    listen-on-v6 { {{range $k,$ip := .EncryptedIPv6s}}!{{$ip}}; {{end}}any;};{{if .TransferPort}}
     listen-on port {{.TransferPort}} {any;};
-    listen-on-v6 port {{.TransferPort}} {any;};{{end}}
+    listen-on-v6 port {{.TransferPort}} {any;};{{end}}{{range $k,$l := .EncryptedListeners}}{{if $l.IPv4s}}
+    listen-on port {{$l.Port}} tls {{$l.TLS}}{{if $l.HTTP}} http {{$l.HTTP}}{{end}} { {{range $kk,$ip := $l.IPv4s}}{{$ip}}; {{end}}};{{end}}{{if $l.IPv6s}}
+    listen-on-v6 port {{$l.Port}} tls {{$l.TLS}}{{if $l.HTTP}} http {{$l.HTTP}}{{end}} { {{range $kk,$ip := $l.IPv6s}}{{$ip}}; {{end}}};{{end}}{{end}}
 	allow-query {any;};
-	{{if .DnssecEnable}}dnssec-enable yes;{{else}}dnssec-enable no;{{end}}
 	dnssec-validation no;
 	recursive-clients {{.RecursiveClients}};
-	{{if .LogEnable}}querylog yes;{{else}}querylog no;{{end}}{{if .BlackholeEnable}}
+	zone-statistics yes;
+	{{if .LogEnable}}querylog yes;
+	responselog yes;{{else}}querylog no;{{end}}{{if .BlackholeEnable}}
 	blackhole{ {{range $k,$v := .Blackholes}}{{$v}}; {{end}}};{{end}}
 	{{if .RecursionEnable}}recursion yes;{{else}}recursion no;{{end}}
 };
 
-statistics-channels {
+{{range $k,$t := .TLSs}}tls {{$t.Name}} {
+	key-file "{{$t.KeyFile}}";
+	cert-file "{{$t.CertFile}}";
+};
+
+{{end}}{{if .DohHTTP}}http {{.DohHTTP}} {
+	endpoints { "{{.DohEndpoint}}"; };
+};
+
+{{end}}statistics-channels {
      inet 0.0.0.0 port 58082;
 };
 
@@ -31,4 +43,7 @@ statistics-channels {
 	category queries{
 	query_log;
 	};
+	category responses{
+	query_log;
+	};
 };{{end}}
@@ -1,4 +1,9 @@
-{{range $k, $view := .Views}}
+{{range $k, $tls := .ForwardTLSs}}
+tls {{$tls.Name}} {
+    remote-hostname "{{$tls.ServerName}}";{{if $tls.CAFile}}
+    ca-file "{{$tls.CAFile}}";{{end}}
+};
+{{end}}{{range $k, $view := .Views}}
 key key{{$view.Name}} {
     algorithm hmac-sha256;
     secret "{{$view.Key}}";
@@ -11,8 +16,11 @@ view "{{$view.Name}}" {
     match-clients {
 	key key{{$view.Name}};{{range $kk, $deniedIP := $view.DeniedIPs}}!{{$deniedIP}};{{end}}{{range $kk, $acl := $view.ACLs}}{{$acl.Name}};{{end}}
 	};
-	allow-update {key key{{$view.Name}};};{{range $i, $zone := $view.Zones}}
-	zone "{{$zone.Name}}" { type forward; forward {{$zone.ForwardStyle}}; forwarders { {{range $ii,$ip := $zone.IPs}}{{$ip}}; {{end}}}; };{{end}}{{range $k, $dns64:= .DNS64s}}
+{{if $view.MatchDestinations}}    match-destinations {
+	{{range $kk, $destination := $view.MatchDestinations}}{{$destination}};{{end}}
+	};
+{{end}}	allow-update {key key{{$view.Name}};};{{range $i, $zone := $view.Zones}}
+	zone "{{$zone.Name}}" { type forward; forward {{$zone.ForwardStyle}}; forwarders { {{range $ii,$ip := $zone.IPs}}{{$ip}}{{if $zone.TLS}} tls {{$zone.TLS}}{{end}}; {{end}}}; };{{end}}{{range $k, $dns64:= .DNS64s}}
         dns64 {{$dns64.Prefix}} {
         clients { {{$dns64.ClientACLName}}; };
         mapped { {{$dns64.AAddressACLName}}; };
@@ -1,25 +1,38 @@
-{{range $k,$v:=.URLRedirects}}
+{{template "nginxlogformat" .AccessLog}}{{range $k,$v:=.Proxies}}{{template "nginxupstreams" $v}}
 server {
     listen       80;
-    server_name         {{$v.Domain}};
+    server_name         {{$v.Domain}};{{template "nginxaccesslog" $.AccessLog}}
+{{- if $.ACMEWebroot}}
 
-    location / {
-        proxy_pass {{$v.Url}};
-        proxy_http_version 1.1;
-        proxy_set_header Upgrade $http_upgrade;
-        proxy_set_header Connection "upgrade";
-        proxy_set_header X-Real-IP $remote_addr;
-        proxy_set_header X-Forwarded-For $proxy_add_x_forwarded_for;
-        proxy_set_header Host $http_host;
-        proxy_set_header X-NginX-Proxy true;
-        proxy_buffering    off;
-        proxy_buffer_size  512k;
-        proxy_buffers 10  512k;
-        client_max_body_size 100m;
+    location ^~ /.well-known/acme-challenge/ {
+        root {{$.ACMEWebroot}};
+        default_type text/plain;
     }
-
+{{- end}}
+{{template "nginxlocations" $v}}
     location = /50x.html {
         root   /usr/share/nginx/html;
     }
 }
 {{end}}
+{{- range $k,$v:=.HTTPSDomains}}
+server {
+    listen       80;
+    server_name         {{$v.Domain}};{{template "nginxaccesslog" $.AccessLog}}
+{{- if $.ACMEWebroot}}
+
+    location ^~ /.well-known/acme-challenge/ {
+        root {{$.ACMEWebroot}};
+        default_type text/plain;
+    }
+{{- end}}
+
+    location / {
+{{- if $v.Redirect}}
+        return 301 https://$host$request_uri;
+{{- else}}
+        return 404;
+{{- end}}
+    }
+}
+{{end}}
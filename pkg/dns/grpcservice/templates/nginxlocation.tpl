@@ -0,0 +1,35 @@
+{{define "nginxlogformat"}}{{if .}}
+log_format {{.FormatName}} '{{.Format}}';
+{{end}}{{end}}
+{{define "nginxaccesslog"}}{{if .}}
+    access_log          {{.Path}} {{.FormatName}};{{end}}{{end}}
+{{define "nginxupstreams"}}{{range $k,$u:=.Upstreams}}
+upstream {{$u.Name}} {
+{{- if $u.LbMethod}}
+    {{$u.LbMethod}};
+{{- end}}
+{{- range $s:=$u.Servers}}
+    server {{$s}};
+{{- end}}
+}
+{{end}}{{end}}
+{{define "nginxlocations"}}{{range $k,$v:=.Locations}}
+    location {{$v.Path}} {
+{{- if $v.RedirectCode}}
+        return {{$v.RedirectCode}} {{$v.RedirectUrl}};
+{{- else}}
+        proxy_pass {{$v.ProxyPass}};
+        proxy_http_version 1.1;
+        proxy_set_header Upgrade $http_upgrade;
+        proxy_set_header Connection "upgrade";
+        proxy_set_header X-Real-IP $remote_addr;
+        proxy_set_header X-Forwarded-For $proxy_add_x_forwarded_for;
+        proxy_set_header Host $http_host;
+        proxy_set_header X-NginX-Proxy true;
+        proxy_buffering    off;
+        proxy_buffer_size  512k;
+        proxy_buffers 10  512k;
+        client_max_body_size 100m;
+{{- end}}
+    }
+{{end}}{{end}}
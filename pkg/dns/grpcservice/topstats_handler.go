@@ -0,0 +1,39 @@
+package grpcservice
+
+import (
+	"fmt"
+
+	"github.com/linkingthing/ddi-agent/pkg/dns/analytics"
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+func (handler *DNSHandler) GetDNSTopStats(req *pb.GetDNSTopStatsReq) (*pb.GetDNSTopStatsResponse, error) {
+	analyzer := analytics.GetAnalyzer()
+	if analyzer == nil {
+		return nil, fmt.Errorf("dns query analytics is disabled")
+	}
+
+	stats := analyzer.TopStats(int(req.GetTopN()))
+	resp := &pb.GetDNSTopStatsResponse{
+		Succeed:       true,
+		WindowSeconds: stats.WindowSeconds,
+		TopNames:      entriesToPbTopStats(stats.TopNames),
+		TopClients:    entriesToPbTopStats(stats.TopClients),
+		TopNxdomains:  entriesToPbTopStats(stats.TopNXDomains),
+	}
+
+	for view, qtypes := range stats.ViewQTypes {
+		resp.ViewQtypes = append(resp.ViewQtypes, &pb.ViewQueryTypes{View: view, Qtypes: qtypes})
+	}
+
+	return resp, nil
+}
+
+func entriesToPbTopStats(entries []analytics.Entry) []*pb.TopStat {
+	topStats := make([]*pb.TopStat, 0, len(entries))
+	for _, entry := range entries {
+		topStats = append(topStats, &pb.TopStat{Key: entry.Key, Count: entry.Count})
+	}
+
+	return topStats
+}
@@ -37,7 +37,11 @@ func (handler *DNSHandler) UploadLog(req pb.UploadLogReq) error {
 		return err
 	}
 
-	go handler.doUploadLog(conn, req.Id)
+	handler.uploads.Add(1)
+	go func() {
+		defer handler.uploads.Done()
+		handler.doUploadLog(conn, req.Id)
+	}()
 	return nil
 }
 
@@ -1,8 +1,6 @@
 package kafkaconsumer
 
 const (
-	DNSTopic = "dns"
-
 	StartDNS = "start_dns"
 	StopDNS  = "stop_dns"
 
@@ -43,4 +41,6 @@ const (
 	UpdateGlobalConfig = "update_dnsglobalconfig"
 
 	UploadLog = "upload_dnslog"
+
+	FlushDNSCache = "flush_dnscache"
 )
@@ -4,43 +4,50 @@ import (
 	"context"
 
 	"github.com/golang/protobuf/proto"
-	kg "github.com/segmentio/kafka-go"
 	"github.com/zdnscloud/cement/log"
 	"google.golang.org/grpc"
 
 	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/agentmetric"
+	"github.com/linkingthing/ddi-agent/pkg/audit"
 	"github.com/linkingthing/ddi-agent/pkg/kafkaproducer"
 	pb "github.com/linkingthing/ddi-agent/pkg/proto"
 )
 
-func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
+func Run(ctx context.Context, conn *grpc.ClientConn, conf *config.AgentConfig) {
 	if conf.DNS.Enabled == false {
 		return
 	}
 
 	cli := pb.NewAgentManagerClient(conn)
-	kafkaReader := kg.NewReader(kg.ReaderConfig{
-		Brokers:  conf.Kafka.Addr,
-		Topic:    DNSTopic,
-		GroupID:  conf.DNS.GroupID,
-		MinBytes: 10,
-		MaxBytes: 10e6,
-	})
-
+	if err := initReader(conf); err != nil {
+		log.Errorf("create dns kafka reader failed: %s", err.Error())
+		return
+	}
+	defer closeReader()
 	for {
-		message, err := kafkaReader.ReadMessage(context.Background())
+		reader := getReader()
+		message, err := reader.ReadMessage(ctx)
 		if err != nil {
+			if ctx.Err() != nil {
+				return
+			} else if reader != getReader() {
+				continue
+			}
 			log.Warnf("read dns message from kafka failed: %s", err.Error())
 			continue
 		}
 
+		cmdCtx := audit.NewKafkaContext(agentmetric.NewKafkaMessageContext(context.Background(),
+			message.Topic, string(message.Key)), string(message.Key))
+
 		switch string(message.Key) {
 		case StartDNS:
 			var req pb.DNSStartReq
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal StartDNS request failed: %s", err.Error())
 			} else {
-				if _, err := cli.StartDNS(context.Background(), &req); err != nil {
+				if _, err := cli.StartDNS(cmdCtx, &req); err != nil {
 					log.Errorf("grpc service exec StartDNS failed: %s", err.Error())
 				}
 			}
@@ -49,7 +56,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal StopDNS request failed: %s", err.Error())
 			} else {
-				if _, err := cli.StopDNS(context.Background(), &req); err != nil {
+				if _, err := cli.StopDNS(cmdCtx, &req); err != nil {
 					log.Errorf("grpc service exec StopDNS failed: %s", err.Error())
 				}
 			}
@@ -58,7 +65,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal CreateACL request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreateAcl(context.Background(), &req)
+				ddiResponse, err := cli.CreateAcl(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec CreateACL failed: %s", err.Error())
 				}
@@ -72,7 +79,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal BatchCreateAclReq request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.BatchCreateAcl(context.Background(), &req)
+				ddiResponse, err := cli.BatchCreateAcl(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec BatchCreateAclReq failed: %s", err.Error())
 				}
@@ -86,7 +93,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal UpdateACL request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateAcl(context.Background(), &req)
+				ddiResponse, err := cli.UpdateAcl(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec UpdateACL failed: %s", err.Error())
 				}
@@ -100,7 +107,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal DeleteACL request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeleteAcl(context.Background(), &req)
+				ddiResponse, err := cli.DeleteAcl(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec DeleteACL failed: %s", err.Error())
 				}
@@ -114,7 +121,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal CreateView request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreateView(context.Background(), &req)
+				ddiResponse, err := cli.CreateView(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec CreateView failed: %s", err.Error())
 				}
@@ -128,7 +135,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal UpdateView request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateView(context.Background(), &req)
+				ddiResponse, err := cli.UpdateView(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec UpdateView failed: %s", err.Error())
 				}
@@ -142,7 +149,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal DeleteView request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeleteView(context.Background(), &req)
+				ddiResponse, err := cli.DeleteView(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec DeleteView failed: %s", err.Error())
 				}
@@ -156,7 +163,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal CreateAuthZone request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreateAuthZone(context.Background(), &req)
+				ddiResponse, err := cli.CreateAuthZone(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec CreateAuthZone failed: %s", err.Error())
 				}
@@ -170,7 +177,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal UpdateZone request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateAuthZone(context.Background(), &req)
+				ddiResponse, err := cli.UpdateAuthZone(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec UpdateZone failed: %s", err.Error())
 				}
@@ -184,7 +191,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal DeleteZone request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeleteAuthZone(context.Background(), &req)
+				ddiResponse, err := cli.DeleteAuthZone(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec DeleteZone failed: %s", err.Error())
 				}
@@ -198,7 +205,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal CreateAuthZoneAuthRRs request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreateAuthZoneAuthRRs(context.Background(), &req)
+				ddiResponse, err := cli.CreateAuthZoneAuthRRs(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec CreateAuthZoneAuthRRs failed: %s", err.Error())
 				}
@@ -212,7 +219,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal UpdateAuthZoneAXFR request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateAuthZoneAXFR(context.Background(), &req)
+				ddiResponse, err := cli.UpdateAuthZoneAXFR(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec UpdateAuthZoneAXFR failed: %s", err.Error())
 				}
@@ -226,7 +233,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal UpdateAuthZoneIXFR request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateAuthZoneIXFR(context.Background(), &req)
+				ddiResponse, err := cli.UpdateAuthZoneIXFR(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec UpdateAuthZoneIXFR failed: %s", err.Error())
 				}
@@ -240,7 +247,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal CreateForwardZone request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreateForwardZone(context.Background(), &req)
+				ddiResponse, err := cli.CreateForwardZone(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec CreateForwardZone failed: %s", err.Error())
 				}
@@ -254,7 +261,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal UpdateForwardZone request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateForwardZone(context.Background(), &req)
+				ddiResponse, err := cli.UpdateForwardZone(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec UpdateForwardZone failed: %s", err.Error())
 				}
@@ -268,7 +275,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal DeleteForwardZone request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeleteForwardZone(context.Background(), &req)
+				ddiResponse, err := cli.DeleteForwardZone(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec DeleteForwardZone failed: %s", err.Error())
 				}
@@ -282,7 +289,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal CreateAuthRR request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreateAuthRR(context.Background(), &req)
+				ddiResponse, err := cli.CreateAuthRR(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec CreateAuthRR failed: %s", err.Error())
 				}
@@ -296,7 +303,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal UpdateAuthRR request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateAuthRR(context.Background(), &req)
+				ddiResponse, err := cli.UpdateAuthRR(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec UpdateAuthRR failed: %s", err.Error())
 				}
@@ -310,7 +317,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal DeleteAuthRR request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeleteAuthRR(context.Background(), &req)
+				ddiResponse, err := cli.DeleteAuthRR(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec DeleteAuthRR failed: %s", err.Error())
 				}
@@ -324,7 +331,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal BatchCreateAuthRRs request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.BatchCreateAuthRRs(context.Background(), &req)
+				ddiResponse, err := cli.BatchCreateAuthRRs(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec BatchCreateAuthRRs failed: %s", err.Error())
 				}
@@ -338,7 +345,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal CreateRedirection request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreateRedirection(context.Background(), &req)
+				ddiResponse, err := cli.CreateRedirection(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec CreateRedirection failed: %s", err.Error())
 				}
@@ -352,7 +359,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal UpdateRedirection request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateRedirection(context.Background(), &req)
+				ddiResponse, err := cli.UpdateRedirection(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec UpdateRedirection failed: %s", err.Error())
 				}
@@ -366,7 +373,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal DeleteRedirection request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeleteRedirection(context.Background(), &req)
+				ddiResponse, err := cli.DeleteRedirection(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec DeleteRedirection failed: %s", err.Error())
 				}
@@ -380,7 +387,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal CreateNginxProxy request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.CreateNginxProxy(context.Background(), &req)
+				ddiResponse, err := cli.CreateNginxProxy(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec CreateNginxProxy failed: %s", err.Error())
 				}
@@ -394,7 +401,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal UpdateNginxProxy request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateNginxProxy(context.Background(), &req)
+				ddiResponse, err := cli.UpdateNginxProxy(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec UpdateNginxProxy failed: %s", err.Error())
 				}
@@ -408,7 +415,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal DeleteNginxProxy request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.DeleteNginxProxy(context.Background(), &req)
+				ddiResponse, err := cli.DeleteNginxProxy(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec DeleteNginxProxy failed: %s", err.Error())
 				}
@@ -422,7 +429,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal UpdateGlobalConfig request failed: %s", err.Error())
 			} else {
-				ddiResponse, err := cli.UpdateGlobalConfig(context.Background(), &req)
+				ddiResponse, err := cli.UpdateGlobalConfig(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec UpdateGlobalConfig failed:%s", err.Error())
 				}
@@ -436,7 +443,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("message %s Unmarshal failed:%s", message.Key, err.Error())
 			} else {
-				if _, err := cli.UploadLog(context.Background(), &req); err != nil {
+				if _, err := cli.UploadLog(cmdCtx, &req); err != nil {
 					log.Errorf("grpc service exec FtpTransport failed:%s", err.Error())
 				}
 			}
@@ -445,7 +452,7 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 			if err := proto.Unmarshal(message.Value, &req); err != nil {
 				log.Errorf("unmarshal FlushForwardZone failed:%s", err.Error())
 			} else {
-				ddiResponse, err := cli.FlushForwardZone(context.Background(), &req)
+				ddiResponse, err := cli.FlushForwardZone(cmdCtx, &req)
 				if err != nil {
 					log.Errorf("grpc service exec FlushForwardZone failed:%s", err.Error())
 				}
@@ -454,6 +461,20 @@ func Run(conn *grpc.ClientConn, conf *config.AgentConfig) {
 					log.Errorf("SendAgentEventMessage ddiResponse key:%s failed:%s", message.Key, err.Error())
 				}
 			}
+		case FlushDNSCache:
+			var req pb.FlushDNSCacheReq
+			if err := proto.Unmarshal(message.Value, &req); err != nil {
+				log.Errorf("unmarshal FlushDNSCache failed:%s", err.Error())
+			} else {
+				ddiResponse, err := cli.FlushDNSCache(cmdCtx, &req)
+				if err != nil {
+					log.Errorf("grpc service exec FlushDNSCache failed:%s", err.Error())
+				}
+				if err := kafkaproducer.GetKafkaProducer().SendAgentEventMessage(
+					conf.Server.IP, "dns", message.Key, &req, ddiResponse, err); err != nil {
+					log.Errorf("SendAgentEventMessage ddiResponse key:%s failed:%s", message.Key, err.Error())
+				}
+			}
 		}
 	}
 }
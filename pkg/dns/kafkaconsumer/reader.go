@@ -0,0 +1,86 @@
+package kafkaconsumer
+
+import (
+	"sync"
+
+	kg "github.com/segmentio/kafka-go"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/agentmetric"
+	"github.com/linkingthing/ddi-agent/pkg/kafkaclient"
+)
+
+var (
+	readerLock  sync.RWMutex
+	kafkaReader *kg.Reader
+)
+
+func newReader(conf *config.AgentConfig) (*kg.Reader, error) {
+	dialer, err := kafkaclient.NewDialer(&conf.Kafka)
+	if err != nil {
+		return nil, err
+	}
+
+	return kg.NewReader(kg.ReaderConfig{
+		Brokers:  conf.Kafka.Addr,
+		Topic:    kafkaclient.DNSTopic(&conf.Kafka),
+		GroupID:  conf.DNS.GroupID,
+		Dialer:   dialer,
+		MinBytes: 10,
+		MaxBytes: 10e6,
+	}), nil
+}
+
+func initReader(conf *config.AgentConfig) error {
+	readerLock.Lock()
+	defer readerLock.Unlock()
+	if kafkaReader == nil {
+		reader, err := newReader(conf)
+		if err != nil {
+			return err
+		}
+		kafkaReader = reader
+		agentmetric.RegisterKafkaReader(reader)
+	}
+
+	return nil
+}
+
+func getReader() *kg.Reader {
+	readerLock.RLock()
+	defer readerLock.RUnlock()
+	return kafkaReader
+}
+
+func Reload(conf *config.AgentConfig) error {
+	if conf.DNS.Enabled == false {
+		return nil
+	}
+
+	reader, err := newReader(conf)
+	if err != nil {
+		return err
+	}
+
+	readerLock.Lock()
+	oldReader := kafkaReader
+	kafkaReader = reader
+	readerLock.Unlock()
+
+	if oldReader != nil {
+		agentmetric.UnregisterKafkaReader(oldReader)
+		oldReader.Close()
+	}
+	agentmetric.RegisterKafkaReader(reader)
+	return nil
+}
+
+func closeReader() {
+	readerLock.Lock()
+	defer readerLock.Unlock()
+	if kafkaReader != nil {
+		agentmetric.UnregisterKafkaReader(kafkaReader)
+		kafkaReader.Close()
+		kafkaReader = nil
+	}
+}
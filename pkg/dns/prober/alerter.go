@@ -0,0 +1,37 @@
+package prober
+
+import (
+	"time"
+
+	"github.com/linkingthing/ddi-agent/pkg/kafkaproducer"
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+const TimeFormat = "2006-01-02 15:04:05"
+
+type kafkaAlerter struct {
+	node string
+}
+
+func (a *kafkaAlerter) SendAlert(alert *Alert) error {
+	producer := kafkaproducer.GetKafkaProducer()
+	if producer == nil {
+		return nil
+	}
+
+	return producer.SendDNSZoneHealthMessage(alert.ToProto(a.node))
+}
+
+func (alert *Alert) ToProto(node string) *pb.DNSZoneHealthAlert {
+	return &pb.DNSZoneHealthAlert{
+		Node:                node,
+		View:                alert.View,
+		Zone:                alert.Zone,
+		Event:               alert.Event,
+		Reason:              alert.Reason,
+		Serial:              alert.Serial,
+		ExpectedSerial:      alert.ExpectedSerial,
+		ConsecutiveFailures: alert.ConsecutiveFailures,
+		AlertTime:           time.Now().Format(TimeFormat),
+	}
+}
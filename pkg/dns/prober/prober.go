@@ -0,0 +1,327 @@
+package prober
+
+import (
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zdnscloud/cement/log"
+	"github.com/zdnscloud/g53"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/dns/dnsclient"
+)
+
+const (
+	ReasonTimeout          = "timeout"
+	ReasonQueryFailed      = "query_failed"
+	ReasonNotAuthoritative = "not_authoritative"
+	ReasonMissingSOA       = "missing_soa"
+	ReasonStaleSerial      = "stale_serial"
+	ReasonRdataMismatch    = "rdata_mismatch"
+	ReasonRcodePrefix      = "rcode_"
+
+	EventZoneDown      = "down"
+	EventZoneRecovered = "recovered"
+
+	DefaultIntervalSeconds  = 60
+	DefaultTimeoutSeconds   = 3
+	DefaultFailureThreshold = 3
+
+	DNSPort = "53"
+)
+
+type Alert struct {
+	View                string
+	Zone                string
+	Event               string
+	Reason              string
+	Serial              uint32
+	ExpectedSerial      uint32
+	ConsecutiveFailures uint32
+}
+
+type Alerter interface {
+	SendAlert(*Alert) error
+}
+
+type Result struct {
+	Latency  time.Duration
+	Answered bool
+	Serial   uint32
+	Reason   string
+}
+
+type Prober struct {
+	lock          sync.Mutex
+	conf          config.ProberConf
+	client        *dnsclient.Client
+	interval      time.Duration
+	alerter       Alerter
+	zones         map[string]*ZoneStats
+	sampleOffsets map[string]int
+	quit          chan struct{}
+	done          chan struct{}
+}
+
+var globalProber *Prober
+
+func GetProber() *Prober {
+	return globalProber
+}
+
+func Init(conf *config.AgentConfig) *Prober {
+	if conf.DNS.Enabled == false || conf.DNS.Prober.Enabled == false {
+		return nil
+	}
+
+	globalProber = New(conf.DNS.Prober, net.JoinHostPort(conf.DNS.ServerIp, DNSPort),
+		&kafkaAlerter{node: conf.Server.IP})
+	return globalProber
+}
+
+func New(conf config.ProberConf, address string, alerter Alerter) *Prober {
+	if conf.IntervalSeconds == 0 {
+		conf.IntervalSeconds = DefaultIntervalSeconds
+	}
+	if conf.TimeoutSeconds == 0 {
+		conf.TimeoutSeconds = DefaultTimeoutSeconds
+	}
+	if conf.FailureThreshold == 0 {
+		conf.FailureThreshold = DefaultFailureThreshold
+	}
+
+	return &Prober{
+		conf:          conf,
+		client:        &dnsclient.Client{Address: address, Timeout: time.Duration(conf.TimeoutSeconds) * time.Second},
+		interval:      time.Duration(conf.IntervalSeconds) * time.Second,
+		alerter:       alerter,
+		zones:         make(map[string]*ZoneStats),
+		sampleOffsets: make(map[string]int),
+		quit:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+}
+
+func (p *Prober) Run() {
+	defer close(p.done)
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.quit:
+			return
+		case <-ticker.C:
+		}
+
+		targets, err := p.loadTargets()
+		if err != nil {
+			log.Warnf("load dns probe targets failed: %s", err.Error())
+			continue
+		}
+
+		p.ProbeTargets(targets)
+	}
+}
+
+func (p *Prober) Stop() {
+	close(p.quit)
+	<-p.done
+}
+
+func (p *Prober) ProbeTargets(targets []*ZoneTarget) {
+	probed := make(map[string]bool, len(targets))
+	for _, target := range targets {
+		probed[target.ID()] = true
+		if alert := p.record(target, p.Probe(target)); alert != nil {
+			if err := p.alerter.SendAlert(alert); err != nil {
+				log.Warnf("send dns zone %s health alert of view %s failed: %s",
+					alert.Zone, alert.View, err.Error())
+			}
+		}
+	}
+
+	p.lock.Lock()
+	for id := range p.zones {
+		if probed[id] == false {
+			delete(p.zones, id)
+			delete(p.sampleOffsets, id)
+		}
+	}
+	p.lock.Unlock()
+}
+
+func (p *Prober) Probe(target *ZoneTarget) *Result {
+	zoneName, err := g53.NameFromString(target.Zone)
+	if err != nil {
+		return &Result{Reason: ReasonQueryFailed}
+	}
+
+	start := time.Now()
+	resp, err := p.query(target, zoneName, g53.RR_SOA)
+	if err != nil {
+		return &Result{Reason: queryFailedReason(err)}
+	}
+
+	result := &Result{Latency: time.Since(start), Answered: true}
+	if resp.Header.Rcode != g53.R_NOERROR {
+		result.Reason = rcodeReason(resp.Header.Rcode)
+		return result
+	}
+
+	if resp.Header.GetFlag(g53.FLAG_AA) == false {
+		result.Reason = ReasonNotAuthoritative
+		return result
+	}
+
+	soa := findRRset(resp, zoneName, g53.RR_SOA)
+	if soa == nil || len(soa.Rdatas) == 0 {
+		result.Reason = ReasonMissingSOA
+		return result
+	}
+
+	result.Serial = soa.Rdatas[0].(*g53.SOA).Serial
+	if target.HasSerial && serialBehind(result.Serial, target.Serial) {
+		result.Reason = ReasonStaleSerial
+		return result
+	}
+
+	for _, rrset := range target.RRsets {
+		resp, err := p.query(target, rrset.Name, rrset.Type)
+		if err != nil {
+			result.Reason = queryFailedReason(err)
+			return result
+		}
+
+		if resp.Header.Rcode != g53.R_NOERROR {
+			result.Reason = rcodeReason(resp.Header.Rcode)
+			return result
+		}
+
+		if answer := findRRset(resp, rrset.Name, rrset.Type); answer == nil || answer.Equals(rrset) == false {
+			result.Reason = ReasonRdataMismatch
+			return result
+		}
+	}
+
+	return result
+}
+
+func (p *Prober) query(target *ZoneTarget, name *g53.Name, typ g53.RRType) (*g53.Message, error) {
+	msg := g53.MakeQuery(name, typ, dnsclient.EDNSUdpSize, false)
+	msg.Header.SetFlag(g53.FLAG_RD, false)
+	if target.Key != "" {
+		if err := dnsclient.Sign(msg, target.KeyName(), target.Key); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.client.Exchange(msg)
+}
+
+func queryFailedReason(err error) string {
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return ReasonTimeout
+	}
+
+	return ReasonQueryFailed
+}
+
+func rcodeReason(rcode g53.Rcode) string {
+	return ReasonRcodePrefix + strings.ToLower(rcode.String())
+}
+
+func findRRset(msg *g53.Message, name *g53.Name, typ g53.RRType) *g53.RRset {
+	for _, section := range []g53.SectionType{g53.AnswerSection, g53.AuthSection} {
+		for _, rrset := range msg.GetSection(section) {
+			if rrset.Type == typ && rrset.Name.Equals(name) {
+				return rrset
+			}
+		}
+	}
+
+	return nil
+}
+
+func serialBehind(serial, expected uint32) bool {
+	return int32(expected-serial) > 0
+}
+
+func (p *Prober) record(target *ZoneTarget, result *Result) *Alert {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	stats, ok := p.zones[target.ID()]
+	if ok == false {
+		stats = newZoneStats(target.View, target.Zone)
+		p.zones[target.ID()] = stats
+	}
+
+	if result.Answered {
+		stats.observeLatency(result.Latency.Seconds())
+		stats.Serial = result.Serial
+	}
+
+	if result.Reason == "" {
+		stats.Available = true
+		stats.ConsecutiveFailures = 0
+		stats.LastReason = ""
+		if stats.alerted == false {
+			return nil
+		}
+
+		stats.alerted = false
+		return &Alert{View: target.View, Zone: target.Zone, Event: EventZoneRecovered,
+			Serial: result.Serial, ExpectedSerial: target.Serial}
+	}
+
+	stats.Available = false
+	stats.ConsecutiveFailures += 1
+	stats.LastReason = result.Reason
+	stats.Failures[result.Reason] += 1
+	if stats.alerted || stats.ConsecutiveFailures < p.conf.FailureThreshold {
+		return nil
+	}
+
+	stats.alerted = true
+	return &Alert{View: target.View, Zone: target.Zone, Event: EventZoneDown, Reason: result.Reason,
+		Serial: result.Serial, ExpectedSerial: target.Serial, ConsecutiveFailures: stats.ConsecutiveFailures}
+}
+
+func (p *Prober) Zones() []*ZoneStats {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	zones := make([]*ZoneStats, 0, len(p.zones))
+	for _, stats := range p.zones {
+		zones = append(zones, stats.clone())
+	}
+
+	sort.Slice(zones, func(i, j int) bool {
+		if zones[i].View != zones[j].View {
+			return zones[i].View < zones[j].View
+		}
+		return zones[i].Zone < zones[j].Zone
+	})
+	return zones
+}
+
+func (p *Prober) nextSampleOffset(id string) int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.sampleOffsets[id]
+}
+
+func (p *Prober) advanceSampleOffset(id string, count int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.sampleOffsets[id] += count
+}
+
+func (p *Prober) resetSampleOffset(id string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.sampleOffsets, id)
+}
@@ -0,0 +1,137 @@
+package prober
+
+import (
+	"net"
+	"testing"
+
+	ut "github.com/zdnscloud/cement/unittest"
+	"github.com/zdnscloud/g53"
+	"github.com/zdnscloud/g53/util"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/dns/dnsclient"
+)
+
+type fakeAlerter struct {
+	alerts []*Alert
+}
+
+func (a *fakeAlerter) SendAlert(alert *Alert) error {
+	a.alerts = append(a.alerts, alert)
+	return nil
+}
+
+func serveZone(t *testing.T, rrsets []string) (string, func()) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	ut.Assert(t, err == nil, "listen udp should succeed")
+
+	var answers []*g53.RRset
+	for _, s := range rrsets {
+		rrset, err := g53.RRsetFromString(s)
+		ut.Assert(t, err == nil, "rrset %s should be valid", s)
+		answers = append(answers, rrset)
+	}
+
+	go func() {
+		buf := make([]byte, dnsclient.EDNSUdpSize)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			query, err := g53.MessageFromWire(util.NewInputBuffer(buf[:n]))
+			if err != nil {
+				continue
+			}
+
+			resp := query.MakeResponse()
+			resp.Header.SetFlag(g53.FLAG_AA, true)
+			resp.Header.Rcode = g53.R_NXDOMAIN
+			for _, answer := range answers {
+				if answer.Type == query.Question.Type && answer.Name.Equals(query.Question.Name) {
+					resp.AddRRset(g53.AnswerSection, answer)
+					resp.Header.Rcode = g53.R_NOERROR
+				}
+			}
+
+			resp.RecalculateSectionRRCount()
+			render := g53.NewMsgRender()
+			resp.Rend(render)
+			conn.WriteTo(render.Data(), addr)
+		}
+	}()
+
+	return conn.LocalAddr().String(), func() { conn.Close() }
+}
+
+func newTarget(t *testing.T, serial uint32, rrsets ...string) *ZoneTarget {
+	target := &ZoneTarget{View: "v1", Key: "c2VjcmV0", Zone: "example.com", HasSerial: true, Serial: serial}
+	for _, s := range rrsets {
+		rrset, err := g53.RRsetFromString(s)
+		ut.Assert(t, err == nil, "rrset %s should be valid", s)
+		target.RRsets = append(target.RRsets, rrset)
+	}
+
+	return target
+}
+
+func TestProbe(t *testing.T) {
+	address, stop := serveZone(t, []string{
+		"example.com. 3600 IN SOA ns.example.com. root.example.com. 2020061001 3600 900 604800 300",
+		"www.example.com. 3600 IN A 1.1.1.1",
+	})
+	defer stop()
+
+	alerter := &fakeAlerter{}
+	p := New(config.ProberConf{TimeoutSeconds: 1, FailureThreshold: 2}, address, alerter)
+
+	result := p.Probe(newTarget(t, 2020061001, "www.example.com. 600 IN A 1.1.1.1"))
+	ut.Equal(t, result.Reason, "")
+	ut.Equal(t, result.Serial, uint32(2020061001))
+	ut.Assert(t, p.Probe(newTarget(t, 2020060901)).Reason == "", "newer serial on server should pass")
+	ut.Equal(t, p.Probe(newTarget(t, 2020061002)).Reason, ReasonStaleSerial)
+	ut.Equal(t, p.Probe(newTarget(t, 2020061001, "www.example.com. 600 IN A 2.2.2.2")).Reason, ReasonRdataMismatch)
+	ut.Equal(t, p.Probe(newTarget(t, 2020061001, "ftp.example.com. 600 IN A 1.1.1.1")).Reason, "rcode_nxdomain")
+
+	stale := newTarget(t, 2020061002)
+	p.ProbeTargets([]*ZoneTarget{stale})
+	ut.Equal(t, len(alerter.alerts), 0)
+	p.ProbeTargets([]*ZoneTarget{stale})
+	ut.Equal(t, len(alerter.alerts), 1)
+	ut.Equal(t, alerter.alerts[0].Event, EventZoneDown)
+	ut.Equal(t, alerter.alerts[0].Reason, ReasonStaleSerial)
+	p.ProbeTargets([]*ZoneTarget{stale})
+	ut.Equal(t, len(alerter.alerts), 1)
+
+	zones := p.Zones()
+	ut.Equal(t, len(zones), 1)
+	ut.Equal(t, zones[0].Available, false)
+	ut.Equal(t, zones[0].Failures[ReasonStaleSerial], uint64(3))
+	ut.Equal(t, zones[0].LatencyCount, uint64(3))
+
+	p.ProbeTargets([]*ZoneTarget{newTarget(t, 2020061001)})
+	ut.Equal(t, len(alerter.alerts), 2)
+	ut.Equal(t, alerter.alerts[1].Event, EventZoneRecovered)
+	ut.Equal(t, p.Zones()[0].Available, true)
+
+	p.ProbeTargets(nil)
+	ut.Equal(t, len(p.Zones()), 0)
+}
+
+func TestProbeTimeout(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	ut.Assert(t, err == nil, "listen udp should succeed")
+	defer conn.Close()
+
+	p := New(config.ProberConf{TimeoutSeconds: 1}, conn.LocalAddr().String(), &fakeAlerter{})
+	result := p.Probe(newTarget(t, 1))
+	ut.Equal(t, result.Answered, false)
+	ut.Equal(t, result.Reason, ReasonTimeout)
+}
+
+func TestStop(t *testing.T) {
+	p := New(config.ProberConf{IntervalSeconds: 3600}, "127.0.0.1:53", &fakeAlerter{})
+	go p.Run()
+	p.Stop()
+}
@@ -0,0 +1,58 @@
+package prober
+
+var LatencyBuckets = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5}
+
+type ZoneStats struct {
+	View                string
+	Zone                string
+	Available           bool
+	Serial              uint32
+	ConsecutiveFailures uint32
+	LastReason          string
+	Failures            map[string]uint64
+	LatencyCount        uint64
+	LatencySum          float64
+	LatencyHits         []uint64
+	alerted             bool
+}
+
+func newZoneStats(view, zone string) *ZoneStats {
+	return &ZoneStats{
+		View:        view,
+		Zone:        zone,
+		Failures:    make(map[string]uint64),
+		LatencyHits: make([]uint64, len(LatencyBuckets)),
+	}
+}
+
+func (s *ZoneStats) clone() *ZoneStats {
+	c := *s
+	c.Failures = make(map[string]uint64, len(s.Failures))
+	for reason, count := range s.Failures {
+		c.Failures[reason] = count
+	}
+	c.LatencyHits = append([]uint64(nil), s.LatencyHits...)
+	return &c
+}
+
+func (s *ZoneStats) observeLatency(seconds float64) {
+	s.LatencyCount += 1
+	s.LatencySum += seconds
+	for i, bound := range LatencyBuckets {
+		if seconds <= bound {
+			s.LatencyHits[i] += 1
+			break
+		}
+	}
+}
+
+func (s *ZoneStats) CumulativeLatencyHits() map[float64]uint64 {
+	buckets := make(map[float64]uint64, len(LatencyBuckets))
+	var total uint64
+	for i, bound := range LatencyBuckets {
+		total += s.LatencyHits[i]
+		buckets[bound] = total
+	}
+
+	return buckets
+}
@@ -0,0 +1,151 @@
+package prober
+
+import (
+	"fmt"
+
+	"github.com/zdnscloud/g53"
+	restdb "github.com/zdnscloud/gorest/db"
+
+	"github.com/linkingthing/ddi-agent/pkg/db"
+	"github.com/linkingthing/ddi-agent/pkg/dns/dbhandler"
+	"github.com/linkingthing/ddi-agent/pkg/dns/resource"
+)
+
+const RRTypeSOA = "SOA"
+
+type ZoneTarget struct {
+	View      string
+	Key       string
+	Zone      string
+	HasSerial bool
+	Serial    uint32
+	RRsets    []*g53.RRset
+}
+
+func (t *ZoneTarget) ID() string {
+	return t.View + "/" + t.Zone
+}
+
+func (t *ZoneTarget) KeyName() string {
+	return "key" + t.View
+}
+
+func (p *Prober) loadTargets() ([]*ZoneTarget, error) {
+	var targets []*ZoneTarget
+	err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		var views []*resource.AgentView
+		if err := dbhandler.ListWithTx(&views, tx); err != nil {
+			return err
+		}
+
+		keys := make(map[string]string, len(views))
+		for _, view := range views {
+			keys[view.Name] = view.Key
+		}
+
+		var zones []*resource.AgentAuthZone
+		if err := dbhandler.ListByConditionWithTx(&zones,
+			map[string]interface{}{"role": string(resource.AuthZoneRoleMaster)}, tx); err != nil {
+			return err
+		}
+
+		for _, zone := range zones {
+			key, ok := keys[zone.AgentView]
+			if ok == false {
+				continue
+			}
+
+			target, err := p.loadTarget(tx, zone, key)
+			if err != nil {
+				return fmt.Errorf("load probe target of zone %s in view %s failed: %s",
+					zone.Name, zone.AgentView, err.Error())
+			}
+			targets = append(targets, target)
+		}
+
+		return nil
+	})
+
+	return targets, err
+}
+
+func (p *Prober) loadTarget(tx restdb.Transaction, zone *resource.AgentAuthZone, key string) (*ZoneTarget, error) {
+	target := &ZoneTarget{View: zone.AgentView, Key: key, Zone: zone.Name}
+	var soas []*resource.AgentAuthRr
+	if err := dbhandler.ListByConditionWithTx(&soas, map[string]interface{}{
+		"agent_view": zone.AgentView, "zone": zone.Name, "rr_type": RRTypeSOA}, tx); err != nil {
+		return nil, err
+	}
+
+	if len(soas) != 0 {
+		rdata, err := g53.RdataFromString(g53.RR_SOA, soas[0].Rdata)
+		if err != nil {
+			return nil, fmt.Errorf("soa rdata %s is invalid: %s", soas[0].Rdata, err.Error())
+		}
+		target.HasSerial = true
+		target.Serial = rdata.(*g53.SOA).Serial
+	}
+
+	if p.conf.SampleRecords == 0 {
+		return target, nil
+	}
+
+	offset := p.nextSampleOffset(target.ID())
+	var samples []*resource.AgentAuthRr
+	if err := dbhandler.ListByConditionWithTx(&samples, map[string]interface{}{
+		"agent_view": zone.AgentView, "zone": zone.Name,
+		"orderby": "name", "limit": int(p.conf.SampleRecords), "offset": offset}, tx); err != nil {
+		return nil, err
+	}
+
+	if len(samples) < int(p.conf.SampleRecords) {
+		p.resetSampleOffset(target.ID())
+	} else {
+		p.advanceSampleOffset(target.ID(), len(samples))
+	}
+
+	sampled := make(map[string]bool)
+	for _, sample := range samples {
+		if sample.RrType == RRTypeSOA || sampled[sample.Name+"/"+sample.RrType] {
+			continue
+		}
+		sampled[sample.Name+"/"+sample.RrType] = true
+
+		rrset, err := loadRRset(tx, sample)
+		if err != nil {
+			return nil, err
+		}
+		target.RRsets = append(target.RRsets, rrset)
+	}
+
+	return target, nil
+}
+
+func loadRRset(tx restdb.Transaction, sample *resource.AgentAuthRr) (*g53.RRset, error) {
+	var rrs []*resource.AgentAuthRr
+	if err := dbhandler.ListByConditionWithTx(&rrs, map[string]interface{}{
+		"agent_view": sample.AgentView, "zone": sample.Zone,
+		"name": sample.Name, "rr_type": sample.RrType}, tx); err != nil {
+		return nil, err
+	}
+
+	var rrset *g53.RRset
+	for _, rr := range rrs {
+		rrset_, err := rr.ToRRset()
+		if err != nil {
+			return nil, err
+		}
+
+		if rrset == nil {
+			rrset = rrset_
+		} else if err := rrset.AddRdata(rrset_.Rdatas[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	if rrset == nil {
+		return sample.ToRRset()
+	}
+
+	return rrset, nil
+}
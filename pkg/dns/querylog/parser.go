@@ -0,0 +1,112 @@
+package querylog
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	QueryLogName = "query.log"
+	TimeLayout   = "02-Jan-2006 15:04:05.000"
+
+	tokenClient   = "client"
+	tokenView     = "view"
+	tokenQuery    = "query:"
+	tokenResponse = "response:"
+)
+
+type Query struct {
+	Time       time.Time
+	Client     string
+	View       string
+	Name       string
+	Class      string
+	Type       string
+	Flags      string
+	Rcode      string
+	IsResponse bool
+}
+
+func ParseLine(line string) (*Query, error) {
+	fields := strings.Fields(line)
+	clientIndex := -1
+	for i, field := range fields {
+		if field == tokenClient {
+			clientIndex = i
+			break
+		}
+	}
+
+	if clientIndex == -1 || clientIndex+2 >= len(fields) {
+		return nil, fmt.Errorf("no client found in query log line")
+	}
+
+	query := &Query{Time: parseTime(fields[:clientIndex])}
+	i := clientIndex + 1
+	if strings.HasPrefix(fields[i], "@0x") {
+		i++
+	}
+
+	if i >= len(fields) {
+		return nil, fmt.Errorf("no client address found in query log line")
+	}
+
+	client := fields[i]
+	if index := strings.LastIndex(client, "#"); index != -1 {
+		client = client[:index]
+	}
+
+	if net.ParseIP(client) == nil {
+		return nil, fmt.Errorf("client address %s is invalid", fields[i])
+	}
+	query.Client = client
+
+	for i++; i < len(fields); i++ {
+		switch fields[i] {
+		case tokenView:
+			if i+1 < len(fields) {
+				query.View = strings.TrimSuffix(fields[i+1], ":")
+				i++
+			}
+		case tokenQuery, tokenResponse:
+			query.IsResponse = fields[i] == tokenResponse
+			return query, parseQuestion(query, fields[i+1:])
+		}
+	}
+
+	return nil, fmt.Errorf("no question found in query log line")
+}
+
+func parseQuestion(query *Query, fields []string) error {
+	if len(fields) < 3 {
+		return fmt.Errorf("question in query log line is incomplete")
+	}
+
+	query.Name = strings.ToLower(strings.TrimSuffix(fields[0], "."))
+	if query.Name == "" {
+		query.Name = "."
+	}
+	query.Class = fields[1]
+	query.Type = fields[2]
+	if len(fields) > 3 {
+		if query.IsResponse {
+			query.Rcode = fields[3]
+		} else {
+			query.Flags = fields[3]
+		}
+	}
+
+	return nil
+}
+
+func parseTime(fields []string) time.Time {
+	if len(fields) >= 2 {
+		if t, err := time.ParseInLocation(TimeLayout, fields[0]+" "+fields[1], time.Local); err == nil {
+			return t
+		}
+	}
+
+	return time.Now()
+}
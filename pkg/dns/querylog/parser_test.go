@@ -0,0 +1,34 @@
+package querylog
+
+import (
+	"testing"
+
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+func TestParseQueryLine(t *testing.T) {
+	query, err := ParseLine("19-Oct-2020 10:32:19.123 queries: info: client @0x7f3c0c0a2b10 10.0.0.1#53422 (WWW.Example.com): view v1: query: WWW.Example.com IN AAAA +E(0)K (10.0.0.2)")
+	ut.Assert(t, err == nil, "parse query line failed: %v", err)
+	ut.Equal(t, query.Client, "10.0.0.1")
+	ut.Equal(t, query.View, "v1")
+	ut.Equal(t, query.Name, "www.example.com")
+	ut.Equal(t, query.Type, "AAAA")
+	ut.Equal(t, query.Flags, "+E(0)K")
+	ut.Equal(t, query.IsResponse, false)
+	ut.Equal(t, query.Time.Format(TimeLayout), "19-Oct-2020 10:32:19.123")
+}
+
+func TestParseResponseLine(t *testing.T) {
+	query, err := ParseLine("19-Oct-2020 10:32:19.123 responses: info: client @0x7f3c0c0a2b10 2001:db8::1#53422 (nx.example.com): view default: response: nx.example.com IN A NXDOMAIN +E(0) 0 1 0")
+	ut.Assert(t, err == nil, "parse response line failed: %v", err)
+	ut.Equal(t, query.Client, "2001:db8::1")
+	ut.Equal(t, query.Rcode, "NXDOMAIN")
+	ut.Equal(t, query.IsResponse, true)
+}
+
+func TestParseInvalidLine(t *testing.T) {
+	_, err := ParseLine("19-Oct-2020 10:32:19.123 general: info: zone example.com/IN: loaded serial 1")
+	ut.Assert(t, err != nil, "parse line without client should fail")
+	_, err = ParseLine("client 10.0.0.1#53 (a.com): query: a.com")
+	ut.Assert(t, err != nil, "parse line with incomplete question should fail")
+}
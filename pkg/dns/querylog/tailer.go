@@ -0,0 +1,86 @@
+package querylog
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/linkingthing/ddi-agent/pkg/fileutil"
+)
+
+type Handler interface {
+	HandleQuery(*Query)
+}
+
+type Tailer struct {
+	path     string
+	lock     sync.RWMutex
+	handlers []Handler
+	quit     chan struct{}
+	done     chan struct{}
+}
+
+func NewTailer(path string) *Tailer {
+	return &Tailer{path: path, quit: make(chan struct{}), done: make(chan struct{})}
+}
+
+func (t *Tailer) AddHandler(handler Handler) {
+	t.lock.Lock()
+	t.handlers = append(t.handlers, handler)
+	t.lock.Unlock()
+}
+
+func (t *Tailer) handlerCount() int {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return len(t.handlers)
+}
+
+func (t *Tailer) dispatch(line string) {
+	query, err := ParseLine(line)
+	if err != nil {
+		return
+	}
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	for _, handler := range t.handlers {
+		handler.HandleQuery(query)
+	}
+}
+
+func (t *Tailer) Run() {
+	defer close(t.done)
+	if t.handlerCount() == 0 {
+		return
+	}
+
+	fileutil.Tail(t.path, t.dispatch, t.quit)
+}
+
+func (t *Tailer) Stop() {
+	close(t.quit)
+	<-t.done
+}
+
+func ReadFile(path string, handler Handler) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open query log %s failed: %s", path, err.Error())
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if query, err := ParseLine(scanner.Text()); err == nil {
+			handler.HandleQuery(query)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read query log %s failed: %s", path, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package querylog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+type fakeHandler struct {
+	queries chan *Query
+}
+
+func (h *fakeHandler) HandleQuery(query *Query) {
+	h.queries <- query
+}
+
+func TestTailerStop(t *testing.T) {
+	dir, err := ioutil.TempDir("", "querylog")
+	ut.Assert(t, err == nil, "create temp dir should succeed")
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, QueryLogName)
+	ut.Assert(t, ioutil.WriteFile(path, nil, 0644) == nil, "create query log should succeed")
+	handler := &fakeHandler{queries: make(chan *Query, 1)}
+	tailer := NewTailer(path)
+	tailer.AddHandler(handler)
+	go tailer.Run()
+
+	time.Sleep(100 * time.Millisecond)
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	ut.Assert(t, err == nil, "open query log should succeed")
+	file.WriteString("19-Oct-2020 10:32:19.123 queries: info: client @0x7f3c0c0a2b10 10.0.0.1#53422 (www.example.com): view v1: query: www.example.com IN A +E(0)K (10.0.0.2)\n")
+	file.Close()
+
+	select {
+	case query := <-handler.queries:
+		ut.Equal(t, query.Client, "10.0.0.1")
+	case <-time.After(5 * time.Second):
+		t.Fatal("tailer should dispatch appended query")
+	}
+
+	tailer.Stop()
+}
@@ -1,6 +1,9 @@
 package resource
 
 import (
+	"fmt"
+	"strings"
+
 	restdb "github.com/zdnscloud/gorest/db"
 	restresource "github.com/zdnscloud/gorest/resource"
 )
@@ -17,6 +20,11 @@ type AgentDnsGlobalConfig struct {
 	RecursionEnable           bool     `json:"recursionEnable"`
 	RecursiveClients          uint32   `json:"recursiveClients" rest:"required=true"`
 	TransferPort              uint32   `json:"transferPort"`
+	DotEnable                 bool     `json:"dotEnable"`
+	DotPort                   uint32   `json:"dotPort"`
+	DohEnable                 bool     `json:"dohEnable"`
+	DohPort                   uint32   `json:"dohPort"`
+	DohEndpoint               string   `json:"dohEndpoint"`
 }
 
 const (
@@ -27,11 +35,72 @@ const (
 	DnsConfigUpdateModelRecursion    = "recursion"
 	DnsConfigUpdateModelRecursive    = "recursive"
 	DnsConfigUpdateModelTransferPort = "transferPort"
+	DnsConfigUpdateModelEncrypted    = "encryptedDNS"
 )
 
-const DNSDefaultPort = 53
+const (
+	DNSDefaultPort        = 53
+	DotDefaultPort        = 853
+	DohDefaultPort        = 443
+	DohDefaultEndpoint    = "/dns-query"
+	maxDohEndpointLength  = 255
+	dohEndpointValidChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-._~/"
+)
 
 func CreateDefaultResource() restresource.Resource {
 	return &AgentDnsGlobalConfig{LogEnable: true, Ttl: 3600, RecursiveClients: 1000,
-		DnssecEnable: false, BlackholeEnable: false, RecursionEnable: true}
+		DnssecEnable: false, BlackholeEnable: false, RecursionEnable: true,
+		DotPort: DotDefaultPort, DohPort: DohDefaultPort, DohEndpoint: DohDefaultEndpoint}
+}
+
+func (c *AgentDnsGlobalConfig) GetDotPort() uint32 {
+	if c.DotPort == 0 {
+		return DotDefaultPort
+	}
+
+	return c.DotPort
+}
+
+func (c *AgentDnsGlobalConfig) GetDohPort() uint32 {
+	if c.DohPort == 0 {
+		return DohDefaultPort
+	}
+
+	return c.DohPort
+}
+
+func (c *AgentDnsGlobalConfig) GetDohEndpoint() string {
+	if c.DohEndpoint == "" {
+		return DohDefaultEndpoint
+	}
+
+	return c.DohEndpoint
+}
+
+func (c *AgentDnsGlobalConfig) EncryptedEnabled() bool {
+	return c.DotEnable || c.DohEnable
+}
+
+func (c *AgentDnsGlobalConfig) ValidateEncrypted() error {
+	if c.GetDotPort() > 65535 || c.GetDohPort() > 65535 {
+		return fmt.Errorf("encrypted dns port must not be larger than 65535")
+	}
+
+	if c.DotEnable && c.DohEnable && c.GetDotPort() == c.GetDohPort() {
+		return fmt.Errorf("dot and doh can not share port %d", c.GetDotPort())
+	}
+
+	for _, port := range []uint32{DNSDefaultPort, c.TransferPort} {
+		if (c.DotEnable && c.GetDotPort() == port) || (c.DohEnable && c.GetDohPort() == port) {
+			return fmt.Errorf("encrypted dns port %d is used by plain dns", port)
+		}
+	}
+
+	endpoint := c.GetDohEndpoint()
+	if strings.HasPrefix(endpoint, "/") == false || len(endpoint) > maxDohEndpointLength ||
+		strings.Trim(endpoint, dohEndpointValidChars) != "" {
+		return fmt.Errorf("invalid doh endpoint %q", endpoint)
+	}
+
+	return nil
 }
@@ -0,0 +1,46 @@
+package resource
+
+import (
+	"testing"
+
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+func TestValidateEncrypted(t *testing.T) {
+	conf := &AgentDnsGlobalConfig{DotEnable: true, DohEnable: true}
+	ut.Assert(t, conf.ValidateEncrypted() == nil, "default encrypted dns config should be valid")
+	ut.Equal(t, conf.GetDotPort(), uint32(DotDefaultPort))
+	ut.Equal(t, conf.GetDohPort(), uint32(DohDefaultPort))
+	ut.Equal(t, conf.GetDohEndpoint(), DohDefaultEndpoint)
+
+	for _, invalid := range []*AgentDnsGlobalConfig{
+		{DotEnable: true, DohEnable: true, DotPort: 8443, DohPort: 8443},
+		{DotEnable: true, DotPort: DNSDefaultPort},
+		{DohEnable: true, DohPort: 5353, TransferPort: 5353},
+		{DohEnable: true, DohEndpoint: "dns-query"},
+		{DohEnable: true, DohEndpoint: "/dns query"},
+		{DotEnable: true, DotPort: 70000},
+	} {
+		ut.Assert(t, invalid.ValidateEncrypted() != nil, "invalid encrypted dns config %v should fail", invalid)
+	}
+
+	view := &AgentView{Name: "v1", EncryptedAddresses: []string{"10.0.0.1", "2001:db8::1"}}
+	ut.Assert(t, view.ValidateEncryptedAddresses() == nil, "valid view addresses should succeed")
+	for _, addresses := range [][]string{{"10.0.0"}, {"0.0.0.0"}, {"10.0.0.1", "10.0.0.1"}} {
+		view.EncryptedAddresses = addresses
+		ut.Assert(t, view.ValidateEncryptedAddresses() != nil, "invalid view addresses %v should fail", addresses)
+	}
+}
+
+func TestMatchDestinations(t *testing.T) {
+	views := []*AgentView{
+		{Name: "v1", EncryptedAddresses: []string{"10.0.0.1", "2001:0db8::1"}},
+		{Name: "v2", EncryptedAddresses: []string{"10.0.0.2"}},
+		{Name: "default"},
+	}
+	destinations := MatchDestinations(views)
+	ut.Equal(t, destinations["v1"], []string{"10.0.0.1", "2001:db8::1"})
+	ut.Equal(t, destinations["v2"], []string{"10.0.0.2"})
+	ut.Equal(t, destinations["default"], []string{"!10.0.0.1", "!2001:db8::1", "!10.0.0.2", "any"})
+	ut.Equal(t, len(MatchDestinations([]*AgentView{{Name: "default"}})), 0)
+}
@@ -1,7 +1,9 @@
 package resource
 
 import (
+	"fmt"
 	"net"
+	"regexp"
 	"strconv"
 
 	restdb "github.com/zdnscloud/gorest/db"
@@ -10,25 +12,97 @@ import (
 
 var TableAgentForwardZone = restdb.ResourceDBType(&AgentForwardZone{})
 
+const (
+	ForwardTLSDefaultPort = 853
+	forwardTLSPrefix      = "fwd_"
+)
+
+var forwardTLSNameChars = regexp.MustCompile(`[^A-Za-z0-9]`)
+var forwardServerNameChars = regexp.MustCompile(`^[A-Za-z0-9*]([A-Za-z0-9.*-]*[A-Za-z0-9])?$`)
+
 type AgentForwardZone struct {
 	resource.ResourceBase `json:",inline"`
 	Name                  string   `json:"name" rest:"required=true,minLen=1,maxLen=254" db:"uk"`
 	ForwardStyle          string   `json:"forwardStyle" rest:"required=true,options=only|first"`
 	Addresses             []string `json:"addresses"`
 	AgentView             string   `json:"-" db:"ownby,uk"`
+	TlsEnable             bool     `json:"tlsEnable"`
+	TlsServerName         string   `json:"tlsServerName"`
 }
 
 func (forwardZone AgentForwardZone) ToZoneData() (ZoneData, error) {
 	var addresses []string
 	for _, address := range forwardZone.Addresses {
-		if net.ParseIP(address) != nil {
-			addresses = append(addresses, address)
-		} else if addr, err := net.ResolveTCPAddr("tcp", address); err != nil {
+		ip, port, err := forwardZone.parseAddress(address)
+		if err != nil {
 			return ZoneData{}, err
+		}
+
+		if port == 0 {
+			addresses = append(addresses, ip)
 		} else {
-			addresses = append(addresses, addr.IP.String()+" port "+strconv.Itoa(addr.Port))
+			addresses = append(addresses, ip+" port "+strconv.Itoa(port))
 		}
 	}
 
-	return ZoneData{Name: forwardZone.Name, ForwardStyle: forwardZone.ForwardStyle, IPs: addresses}, nil
+	zoneData := ZoneData{Name: forwardZone.Name, ForwardStyle: forwardZone.ForwardStyle, IPs: addresses}
+	if forwardZone.TlsEnable {
+		zoneData.TLS = forwardZone.TLSName()
+	}
+
+	return zoneData, nil
+}
+
+func (forwardZone AgentForwardZone) TLSName() string {
+	return forwardTLSPrefix + forwardTLSNameChars.ReplaceAllString(forwardZone.AgentView+"_"+forwardZone.Name, "_")
+}
+
+func (forwardZone AgentForwardZone) ValidateTLS() error {
+	if forwardZone.TlsEnable == false {
+		return nil
+	}
+
+	if forwardServerNameChars.MatchString(forwardZone.TlsServerName) == false {
+		return fmt.Errorf("invalid tls server name %q of forward zone %s", forwardZone.TlsServerName, forwardZone.Name)
+	}
+
+	if len(forwardZone.Addresses) == 0 {
+		return fmt.Errorf("forward zone %s with tls requires forwarders", forwardZone.Name)
+	}
+
+	_, err := forwardZone.ProbeAddresses()
+	return err
+}
+
+func (forwardZone AgentForwardZone) ProbeAddresses() ([]string, error) {
+	var addresses []string
+	for _, address := range forwardZone.Addresses {
+		ip, port, err := forwardZone.parseAddress(address)
+		if err != nil {
+			return nil, err
+		}
+
+		if port == 0 {
+			port = DNSDefaultPort
+		}
+		addresses = append(addresses, net.JoinHostPort(ip, strconv.Itoa(port)))
+	}
+
+	return addresses, nil
+}
+
+func (forwardZone AgentForwardZone) parseAddress(address string) (string, int, error) {
+	if ip := net.ParseIP(address); ip != nil {
+		if forwardZone.TlsEnable {
+			return ip.String(), ForwardTLSDefaultPort, nil
+		}
+		return address, 0, nil
+	}
+
+	addr, err := net.ResolveTCPAddr("tcp", address)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return addr.IP.String(), addr.Port, nil
 }
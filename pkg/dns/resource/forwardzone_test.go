@@ -0,0 +1,31 @@
+package resource
+
+import (
+	"testing"
+
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+func TestForwardZoneTLS(t *testing.T) {
+	zone := AgentForwardZone{Name: "corp.example", AgentView: "v1", Addresses: []string{"1.1.1.1", "8.8.8.8:53"}}
+	zoneData, err := zone.ToZoneData()
+	ut.Assert(t, err == nil, "plain forward zone should succeed")
+	ut.Equal(t, zoneData.IPs, []string{"1.1.1.1", "8.8.8.8 port 53"})
+	ut.Equal(t, zoneData.TLS, "")
+
+	zone.TlsEnable = true
+	zone.TlsServerName = "dns.example.com"
+	ut.Assert(t, zone.ValidateTLS() == nil, "tls forward zone should be valid")
+	zoneData, err = zone.ToZoneData()
+	ut.Assert(t, err == nil, "tls forward zone should succeed")
+	ut.Equal(t, zoneData.IPs, []string{"1.1.1.1 port 853", "8.8.8.8 port 53"})
+	ut.Equal(t, zoneData.TLS, "fwd_v1_corp_example")
+	addresses, err := zone.ProbeAddresses()
+	ut.Assert(t, err == nil, "get probe addresses should succeed")
+	ut.Equal(t, addresses, []string{"1.1.1.1:853", "8.8.8.8:53"})
+
+	for _, serverName := range []string{"", "dns example.com", "dns.example.com\";", "-dns.example.com"} {
+		zone.TlsServerName = serverName
+		ut.Assert(t, zone.ValidateTLS() != nil, "invalid server name %q should fail", serverName)
+	}
+}
@@ -1,15 +1,341 @@
 package resource
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
 	restdb "github.com/zdnscloud/gorest/db"
 	"github.com/zdnscloud/gorest/resource"
 )
 
 var TableAgentNginxProxy = restdb.ResourceDBType(&AgentNginxProxy{})
+var TableAgentNginxLocation = restdb.ResourceDBType(&AgentNginxLocation{})
+
+const (
+	NginxRootPath          = "/"
+	NginxLbRoundRobin      = "round_robin"
+	NginxLbLeastConn       = "least_conn"
+	NginxLbIPHash          = "ip_hash"
+	NginxLbRandom          = "random"
+	NginxTLSProfileLegacy  = "legacy"
+	NginxTLSProfileInter   = "intermediate"
+	NginxTLSProfileModern  = "modern"
+	NginxTLSProfileCustom  = "custom"
+	DefaultNginxHstsMaxAge = 31536000
+
+	nginxNameHashSize = 4
+)
+
+type nginxTLSProfile struct {
+	Protocols string
+	Ciphers   string
+}
+
+var nginxTLSProfiles = map[string]nginxTLSProfile{
+	NginxTLSProfileLegacy: {Protocols: "TLSv1 TLSv1.1 TLSv1.2", Ciphers: "HIGH:!aNULL:!MD5"},
+	NginxTLSProfileInter: {Protocols: "TLSv1.2 TLSv1.3", Ciphers: "ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-GCM-SHA256:" +
+		"ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384:ECDHE-ECDSA-CHACHA20-POLY1305:" +
+		"ECDHE-RSA-CHACHA20-POLY1305:DHE-RSA-AES128-GCM-SHA256:DHE-RSA-AES256-GCM-SHA384"},
+	NginxTLSProfileModern: {Protocols: "TLSv1.3"},
+}
+
+var nginxTLSProtocols = map[string]bool{"TLSv1": true, "TLSv1.1": true, "TLSv1.2": true, "TLSv1.3": true}
+var nginxLbMethods = map[string]string{NginxLbRoundRobin: "", NginxLbLeastConn: "least_conn", NginxLbIPHash: "ip_hash", NginxLbRandom: "random"}
+var nginxUnsafeChars = regexp.MustCompile(`[\s;{}"'\\#]`)
+var nginxCipherChars = regexp.MustCompile(`^[A-Za-z0-9!:+@_.-]+$`)
+var nginxNameChars = regexp.MustCompile(`[^A-Za-z0-9]`)
 
 type AgentNginxProxy struct {
 	resource.ResourceBase `json:",inline"`
-	Domain                string `json:"domain" db:"uk"`
-	Url                   string `json:"url"`
-	IsHttps               bool   `json:"isHttps" db:"uk"`
+	Domain                string   `json:"domain" db:"uk"`
+	Url                   string   `json:"url"`
+	IsHttps               bool     `json:"isHttps" db:"uk"`
+	Upstreams             []string `json:"upstreams"`
+	LbMethod              string   `json:"lbMethod"`
+	MaxFails              uint32   `json:"maxFails"`
+	FailTimeout           uint32   `json:"failTimeout"`
+	RedirectUrl           string   `json:"redirectUrl"`
+	RedirectCode          uint32   `json:"redirectCode"`
+	HttpToHttps           bool     `json:"httpToHttps"`
+	Hsts                  bool     `json:"hsts"`
+	HstsMaxAge            uint32   `json:"hstsMaxAge"`
+	TlsProfile            string   `json:"tlsProfile"`
+	TlsProtocols          []string `json:"tlsProtocols"`
+	TlsCiphers            string   `json:"tlsCiphers"`
+	AcmeManaged           bool     `json:"acmeManaged"`
+}
+
+type AgentNginxLocation struct {
+	resource.ResourceBase `json:",inline"`
+	Domain                string   `json:"domain" db:"uk"`
+	IsHttps               bool     `json:"isHttps" db:"uk"`
+	Path                  string   `json:"path" db:"uk"`
+	Url                   string   `json:"url"`
+	Upstreams             []string `json:"upstreams"`
+	LbMethod              string   `json:"lbMethod"`
+	MaxFails              uint32   `json:"maxFails"`
+	FailTimeout           uint32   `json:"failTimeout"`
+	RedirectUrl           string   `json:"redirectUrl"`
+	RedirectCode          uint32   `json:"redirectCode"`
+}
+
+type NginxProxyConf struct {
+	Domain       string
+	Url          string
+	IsHttps      bool
+	Hsts         bool
+	HstsMaxAge   uint32
+	TLSProtocols string
+	TLSCiphers   string
+	Upstreams    []NginxUpstream
+	Locations    []NginxLocation
+	AccessLog    *NginxAccessLog
+}
+
+type NginxAccessLog struct {
+	Path       string
+	FormatName string
+	Format     string
+}
+
+type NginxUpstream struct {
+	Name     string
+	LbMethod string
+	Servers  []string
+}
+
+type NginxLocation struct {
+	Path         string
+	ProxyPass    string
+	RedirectUrl  string
+	RedirectCode uint32
+}
+
+func (proxy *AgentNginxProxy) RootLocation() *AgentNginxLocation {
+	if proxy.Url == "" && len(proxy.Upstreams) == 0 && proxy.RedirectCode == 0 && proxy.RedirectUrl == "" {
+		return nil
+	}
+
+	return &AgentNginxLocation{
+		Domain:       proxy.Domain,
+		IsHttps:      proxy.IsHttps,
+		Path:         NginxRootPath,
+		Url:          proxy.Url,
+		Upstreams:    proxy.Upstreams,
+		LbMethod:     proxy.LbMethod,
+		MaxFails:     proxy.MaxFails,
+		FailTimeout:  proxy.FailTimeout,
+		RedirectUrl:  proxy.RedirectUrl,
+		RedirectCode: proxy.RedirectCode,
+	}
+}
+
+func (proxy *AgentNginxProxy) ToProxyConf(locations []*AgentNginxLocation) (*NginxProxyConf, error) {
+	if proxy.Domain == "" || nginxUnsafeChars.MatchString(proxy.Domain) {
+		return nil, fmt.Errorf("invalid nginx proxy domain %q", proxy.Domain)
+	}
+
+	conf := &NginxProxyConf{Domain: proxy.Domain, Url: proxy.Url, IsHttps: proxy.IsHttps}
+	if proxy.IsHttps {
+		if err := proxy.fillTLS(conf); err != nil {
+			return nil, err
+		}
+	} else if proxy.Hsts || proxy.HttpToHttps {
+		return nil, fmt.Errorf("hsts and http to https redirect require https proxy %s", proxy.Domain)
+	}
+
+	if root := proxy.RootLocation(); root != nil {
+		locations = append([]*AgentNginxLocation{root}, locations...)
+	}
+
+	if len(locations) == 0 {
+		return nil, fmt.Errorf("nginx proxy %s has no url, upstream, redirect or location", proxy.Domain)
+	}
+
+	sort.SliceStable(locations, func(i, j int) bool {
+		return locations[i].Path < locations[j].Path
+	})
+
+	paths := make(map[string]bool)
+	for i, location := range locations {
+		if paths[location.Path] {
+			return nil, fmt.Errorf("duplicate location %s of nginx proxy %s", location.Path, proxy.Domain)
+		}
+		paths[location.Path] = true
+
+		upstreamName := nginxUpstreamName(proxy.Domain, proxy.IsHttps, i)
+		nginxLocation, upstream, err := location.toNginxLocation(upstreamName)
+		if err != nil {
+			return nil, fmt.Errorf("invalid location %s of nginx proxy %s: %s", location.Path, proxy.Domain, err.Error())
+		}
+
+		conf.Locations = append(conf.Locations, nginxLocation)
+		if upstream != nil {
+			conf.Upstreams = append(conf.Upstreams, *upstream)
+		}
+	}
+
+	return conf, nil
+}
+
+func (proxy *AgentNginxProxy) fillTLS(conf *NginxProxyConf) error {
+	conf.Hsts = proxy.Hsts
+	conf.HstsMaxAge = proxy.HstsMaxAge
+	if conf.Hsts && conf.HstsMaxAge == 0 {
+		conf.HstsMaxAge = DefaultNginxHstsMaxAge
+	}
+
+	profileName := proxy.TlsProfile
+	if profileName == "" {
+		profileName = NginxTLSProfileLegacy
+	}
+
+	if profileName != NginxTLSProfileCustom {
+		profile, ok := nginxTLSProfiles[profileName]
+		if ok == false {
+			return fmt.Errorf("unknown tls profile %s", profileName)
+		}
+
+		if len(proxy.TlsProtocols) != 0 || proxy.TlsCiphers != "" {
+			return fmt.Errorf("tls protocols and ciphers are only allowed with %s tls profile", NginxTLSProfileCustom)
+		}
+
+		conf.TLSProtocols = profile.Protocols
+		conf.TLSCiphers = profile.Ciphers
+		return nil
+	}
+
+	if len(proxy.TlsProtocols) == 0 {
+		return fmt.Errorf("%s tls profile requires tls protocols", NginxTLSProfileCustom)
+	}
+
+	for _, protocol := range proxy.TlsProtocols {
+		if nginxTLSProtocols[protocol] == false {
+			return fmt.Errorf("unsupported tls protocol %s", protocol)
+		}
+	}
+
+	if proxy.TlsCiphers != "" && nginxCipherChars.MatchString(proxy.TlsCiphers) == false {
+		return fmt.Errorf("invalid tls ciphers %s", proxy.TlsCiphers)
+	}
+
+	conf.TLSProtocols = strings.Join(proxy.TlsProtocols, " ")
+	conf.TLSCiphers = proxy.TlsCiphers
+	return nil
+}
+
+func (location *AgentNginxLocation) toNginxLocation(upstreamName string) (NginxLocation, *NginxUpstream, error) {
+	if strings.HasPrefix(location.Path, "/") == false || nginxUnsafeChars.MatchString(location.Path) {
+		return NginxLocation{}, nil, fmt.Errorf("path must start with / and not contain spaces or special characters")
+	}
+
+	nginxLocation := NginxLocation{Path: location.Path}
+	var targets []string
+	if location.Url != "" {
+		targets = append(targets, location.Url)
+	}
+	targets = append(targets, location.Upstreams...)
+
+	if location.RedirectCode != 0 || location.RedirectUrl != "" {
+		if len(targets) != 0 {
+			return NginxLocation{}, nil, fmt.Errorf("redirect can not be used with url or upstreams")
+		}
+
+		if location.RedirectCode != 301 && location.RedirectCode != 302 {
+			return NginxLocation{}, nil, fmt.Errorf("redirect code must be 301 or 302")
+		}
+
+		if _, err := parseNginxURL(location.RedirectUrl); err != nil {
+			return NginxLocation{}, nil, fmt.Errorf("invalid redirect url: %s", err.Error())
+		}
+
+		nginxLocation.RedirectUrl = location.RedirectUrl
+		nginxLocation.RedirectCode = location.RedirectCode
+		return nginxLocation, nil, nil
+	}
+
+	if len(targets) == 0 {
+		return NginxLocation{}, nil, fmt.Errorf("url, upstreams or redirect is required")
+	}
+
+	lbMethod, ok := nginxLbMethods[location.LbMethod]
+	if location.LbMethod != "" && ok == false {
+		return NginxLocation{}, nil, fmt.Errorf("unknown load balancing method %s", location.LbMethod)
+	}
+
+	if len(targets) == 1 && location.LbMethod == "" && location.MaxFails == 0 && location.FailTimeout == 0 {
+		if _, err := parseNginxURL(targets[0]); err != nil {
+			return NginxLocation{}, nil, fmt.Errorf("invalid url: %s", err.Error())
+		}
+
+		nginxLocation.ProxyPass = targets[0]
+		return nginxLocation, nil, nil
+	}
+
+	upstream := &NginxUpstream{Name: upstreamName, LbMethod: lbMethod}
+	var scheme string
+	for _, target := range targets {
+		u, err := parseNginxURL(target)
+		if err != nil {
+			return NginxLocation{}, nil, fmt.Errorf("invalid upstream %s: %s", target, err.Error())
+		}
+
+		if u.Path != "" && u.Path != "/" {
+			return NginxLocation{}, nil, fmt.Errorf("upstream %s must not contain path", target)
+		}
+
+		if scheme != "" && u.Scheme != scheme {
+			return NginxLocation{}, nil, fmt.Errorf("upstreams must use the same scheme")
+		}
+		scheme = u.Scheme
+
+		server := u.Host
+		if location.MaxFails != 0 {
+			server += " max_fails=" + strconv.FormatUint(uint64(location.MaxFails), 10)
+		}
+		if location.FailTimeout != 0 {
+			server += " fail_timeout=" + strconv.FormatUint(uint64(location.FailTimeout), 10) + "s"
+		}
+		upstream.Servers = append(upstream.Servers, server)
+	}
+
+	nginxLocation.ProxyPass = scheme + "://" + upstreamName
+	return nginxLocation, upstream, nil
+}
+
+func parseNginxURL(rawURL string) (*url.URL, error) {
+	if nginxUnsafeChars.MatchString(rawURL) {
+		return nil, fmt.Errorf("%q contains spaces or special characters", rawURL)
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%q must be an absolute http or https url", rawURL)
+	}
+
+	return u, nil
+}
+
+func NginxConfName(domain string) string {
+	sum := sha256.Sum256([]byte(domain))
+	return nginxNameChars.ReplaceAllString(domain, "_") + "_" + hex.EncodeToString(sum[:nginxNameHashSize])
+}
+
+func nginxUpstreamName(domain string, isHttps bool, index int) string {
+	port := "80"
+	if isHttps {
+		port = "443"
+	}
+
+	return "ddi_" + NginxConfName(domain) + "_" + port + "_" + strconv.Itoa(index)
 }
@@ -0,0 +1,62 @@
+package resource
+
+import (
+	"testing"
+
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+func TestNginxProxyConf(t *testing.T) {
+	proxy := &AgentNginxProxy{Domain: "www.example.com", Url: "http://10.0.0.1:8080/app"}
+	conf, err := proxy.ToProxyConf(nil)
+	ut.Assert(t, err == nil, "single url proxy should succeed")
+	ut.Equal(t, conf.Locations, []NginxLocation{{Path: "/", ProxyPass: "http://10.0.0.1:8080/app"}})
+	ut.Equal(t, len(conf.Upstreams), 0)
+
+	proxy = &AgentNginxProxy{
+		Domain:       "www.example.com",
+		IsHttps:      true,
+		Hsts:         true,
+		RedirectUrl:  "https://other.example.com$request_uri",
+		RedirectCode: 302,
+		TlsProfile:   NginxTLSProfileModern,
+	}
+	conf, err = proxy.ToProxyConf([]*AgentNginxLocation{
+		{Path: "/api/", Upstreams: []string{"http://10.0.0.1:8080", "http://10.0.0.2:8080"},
+			LbMethod: NginxLbLeastConn, MaxFails: 3, FailTimeout: 10},
+	})
+	ut.Assert(t, err == nil, "https proxy with locations should succeed")
+	ut.Equal(t, conf.TLSProtocols, "TLSv1.3")
+	ut.Equal(t, conf.HstsMaxAge, uint32(DefaultNginxHstsMaxAge))
+	ut.Equal(t, conf.Locations, []NginxLocation{
+		{Path: "/", RedirectUrl: "https://other.example.com$request_uri", RedirectCode: 302},
+		{Path: "/api/", ProxyPass: "http://ddi_www_example_com_80fc0fb9_443_1"},
+	})
+	ut.Equal(t, conf.Upstreams, []NginxUpstream{{
+		Name:     "ddi_www_example_com_80fc0fb9_443_1",
+		LbMethod: "least_conn",
+		Servers:  []string{"10.0.0.1:8080 max_fails=3 fail_timeout=10s", "10.0.0.2:8080 max_fails=3 fail_timeout=10s"},
+	}})
+
+	for _, invalid := range []*AgentNginxProxy{
+		{Domain: "www.example.com"},
+		{Domain: "www.example.com;", Url: "http://10.0.0.1"},
+		{Domain: "www.example.com", Url: "10.0.0.1:8080"},
+		{Domain: "www.example.com", Url: "http://10.0.0.1", RedirectUrl: "http://a.com", RedirectCode: 301},
+		{Domain: "www.example.com", RedirectUrl: "http://a.com", RedirectCode: 307},
+		{Domain: "www.example.com", Upstreams: []string{"http://10.0.0.1", "https://10.0.0.2"}},
+		{Domain: "www.example.com", Url: "http://10.0.0.1", LbMethod: "hash"},
+		{Domain: "www.example.com", Url: "http://10.0.0.1", Hsts: true},
+		{Domain: "www.example.com", Url: "http://10.0.0.1", IsHttps: true, TlsProtocols: []string{"TLSv1.2"}},
+		{Domain: "www.example.com", Url: "http://10.0.0.1", IsHttps: true, TlsProfile: NginxTLSProfileCustom, TlsProtocols: []string{"SSLv3"}},
+	} {
+		_, err := invalid.ToProxyConf(nil)
+		ut.Assert(t, err != nil, "invalid proxy %v should fail", invalid)
+	}
+
+	ut.Assert(t, NginxConfName("a-b.example.com") != NginxConfName("a_b.example.com"), "nginx conf names should not collide")
+
+	_, err = (&AgentNginxProxy{Domain: "www.example.com", Url: "http://10.0.0.1"}).ToProxyConf(
+		[]*AgentNginxLocation{{Path: "/", Url: "http://10.0.0.2"}})
+	ut.Assert(t, err != nil, "duplicate location should fail")
+}
@@ -1,6 +1,9 @@
 package resource
 
 import (
+	"fmt"
+	"net"
+
 	restdb "github.com/zdnscloud/gorest/db"
 	"github.com/zdnscloud/gorest/resource"
 )
@@ -15,4 +18,50 @@ type AgentView struct {
 	Dns64                 string   `json:"dns64" rest:"min=1,max=100"`
 	Key                   string   `json:"-" db:"uk"`
 	Recursion             bool     `json:"recursion"`
+	EncryptedAddresses    []string `json:"encryptedAddresses"`
+}
+
+func (v *AgentView) ValidateEncryptedAddresses() error {
+	seen := make(map[string]bool)
+	for _, address := range v.EncryptedAddresses {
+		ip := net.ParseIP(address)
+		if ip == nil || ip.IsUnspecified() {
+			return fmt.Errorf("invalid encrypted dns address %q of view %s", address, v.Name)
+		}
+
+		if seen[ip.String()] {
+			return fmt.Errorf("duplicate encrypted dns address %s of view %s", address, v.Name)
+		}
+		seen[ip.String()] = true
+	}
+
+	return nil
+}
+
+func MatchDestinations(views []*AgentView) map[string][]string {
+	owned := make(map[string][]string)
+	var excluded []string
+	for _, view := range views {
+		for _, address := range view.EncryptedAddresses {
+			if ip := net.ParseIP(address); ip != nil {
+				owned[view.Name] = append(owned[view.Name], ip.String())
+				excluded = append(excluded, "!"+ip.String())
+			}
+		}
+	}
+
+	destinations := make(map[string][]string)
+	if len(excluded) == 0 {
+		return destinations
+	}
+
+	for _, view := range views {
+		if ips, ok := owned[view.Name]; ok {
+			destinations[view.Name] = ips
+		} else {
+			destinations[view.Name] = append(append([]string{}, excluded...), "any")
+		}
+	}
+
+	return destinations
 }
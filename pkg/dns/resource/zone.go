@@ -38,6 +38,7 @@ type ZoneData struct {
 	ForwardStyle string
 	IPs          []string
 	ViewName     string
+	TLS          string
 }
 
 type AuthZoneFileData struct {
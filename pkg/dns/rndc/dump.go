@@ -0,0 +1,91 @@
+package rndc
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var cacheViewRegexp = regexp.MustCompile(`^; Cache dump of view '([^']*)'`)
+
+var recordClasses = map[string]bool{"IN": true, "CH": true, "HS": true}
+
+type CacheEntry struct {
+	View  string
+	Name  string
+	TTL   uint32
+	Type  string
+	Rdata string
+}
+
+func FilterCacheDump(r io.Reader, name string) ([]*CacheEntry, error) {
+	name = normalizeName(name)
+	var entries []*CacheEntry
+	var view, owner string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, ";") {
+			if matches := cacheViewRegexp.FindStringSubmatch(line); len(matches) == 2 {
+				view = matches[1]
+				owner = ""
+			}
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "$") {
+			continue
+		}
+
+		if line[0] != ' ' && line[0] != '\t' {
+			owner = fields[0]
+			fields = fields[1:]
+		}
+
+		if owner == "" || normalizeName(owner) != name {
+			continue
+		}
+
+		if entry, ok := parseCacheRecord(fields); ok {
+			entry.View = view
+			entry.Name = owner
+			entries = append(entries, entry)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func parseCacheRecord(fields []string) (*CacheEntry, bool) {
+	if len(fields) < 2 {
+		return nil, false
+	}
+
+	ttl, err := strconv.ParseUint(fields[0], 10, 32)
+	if err != nil {
+		return nil, false
+	}
+
+	fields = fields[1:]
+	if recordClasses[strings.ToUpper(fields[0])] {
+		fields = fields[1:]
+	}
+
+	if len(fields) == 0 {
+		return nil, false
+	}
+
+	return &CacheEntry{TTL: uint32(ttl), Type: fields[0], Rdata: strings.Join(fields[1:], " ")}, true
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
@@ -0,0 +1,150 @@
+package rndc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultPath     = "/usr/local/sbin/rndc"
+	CommandTimeout  = 30 * time.Second
+	DumpTimeout     = 60 * time.Second
+	DumpFileName    = "named_dump.db"
+	DumpCompleteTag = "; Dump complete"
+
+	dumpPollInterval = 100 * time.Millisecond
+	dumpTailSize     = 1024
+)
+
+var dumpLock sync.Mutex
+
+type Rndc struct {
+	path     string
+	confPath string
+}
+
+func New(path, confPath string) *Rndc {
+	if path == "" {
+		path = DefaultPath
+	}
+
+	return &Rndc{path: path, confPath: confPath}
+}
+
+func (r *Rndc) Run(args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), CommandTimeout)
+	defer cancel()
+
+	if r.confPath != "" {
+		args = append([]string{"-c", r.confPath}, args...)
+	}
+
+	output, err := exec.CommandContext(ctx, r.path, args...).CombinedOutput()
+	if err != nil {
+		if msg := strings.TrimSpace(string(output)); msg != "" {
+			return "", fmt.Errorf("%s: %s", filepath.Base(r.path), msg)
+		}
+		return "", fmt.Errorf("%s failed: %s", filepath.Base(r.path), err.Error())
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+func withView(args []string, view string) []string {
+	if view != "" {
+		args = append(args, view)
+	}
+
+	return args
+}
+
+func (r *Rndc) Flush(view string) error {
+	_, err := r.Run(withView([]string{"flush"}, view)...)
+	return err
+}
+
+func (r *Rndc) FlushName(name, view string) error {
+	_, err := r.Run(withView([]string{"flushname", name}, view)...)
+	return err
+}
+
+func (r *Rndc) FlushTree(name, view string) error {
+	_, err := r.Run(withView([]string{"flushtree", name}, view)...)
+	return err
+}
+
+func (r *Rndc) DumpCache(view, dumpPath, name string) ([]*CacheEntry, error) {
+	dumpLock.Lock()
+	defer dumpLock.Unlock()
+
+	if err := os.Remove(dumpPath); err != nil && os.IsNotExist(err) == false {
+		return nil, fmt.Errorf("remove old cache dump %s failed: %s", dumpPath, err.Error())
+	}
+
+	if _, err := r.Run(withView([]string{"dumpdb", "-cache"}, view)...); err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(DumpTimeout)
+	for {
+		completed, err := isDumpComplete(dumpPath)
+		if err != nil {
+			return nil, fmt.Errorf("read cache dump %s failed: %s", dumpPath, err.Error())
+		} else if completed {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("wait for cache dump %s timeout", dumpPath)
+		}
+		time.Sleep(dumpPollInterval)
+	}
+
+	f, err := os.Open(dumpPath)
+	if err != nil {
+		return nil, fmt.Errorf("open cache dump %s failed: %s", dumpPath, err.Error())
+	}
+	defer f.Close()
+
+	entries, err := FilterCacheDump(f, name)
+	if err != nil {
+		return nil, fmt.Errorf("parse cache dump %s failed: %s", dumpPath, err.Error())
+	}
+
+	return entries, nil
+}
+
+func isDumpComplete(dumpPath string) (bool, error) {
+	f, err := os.Open(dumpPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return false, err
+	}
+
+	offset := info.Size() - dumpTailSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	tail := make([]byte, info.Size()-offset)
+	if _, err := f.ReadAt(tail, offset); err != nil && err != io.EOF {
+		return false, err
+	}
+
+	return strings.Contains(string(tail), DumpCompleteTag), nil
+}
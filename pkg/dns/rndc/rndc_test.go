@@ -0,0 +1,91 @@
+package rndc
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+var cacheDump = `;
+; Start view default
+;
+;
+; Cache dump of view 'default' (cache default)
+;
+$DATE 20201019101010
+; authanswer
+www.example.com.	3590	A	1.1.1.1
+			3590	A	1.1.1.2
+WWW.Example.com.	10	\-AAAA	;-$NXRRSET
+; glue
+example.com.		172790	IN NS	ns1.example.com.
+;
+; Cache dump of view 'internal' (cache internal)
+;
+www.example.com.	60	A	10.0.0.1
+;
+; Address database dump
+; www.example.com. [v4 TTL 10] [v6 TTL 10] [v4 success] [v6 nxrrset]
+;
+; Dump complete
+`
+
+func TestFilterCacheDump(t *testing.T) {
+	entries, err := FilterCacheDump(strings.NewReader(cacheDump), "www.example.com")
+	ut.Assert(t, err == nil, "filter cache dump should succeed")
+	ut.Equal(t, len(entries), 4)
+	ut.Equal(t, *entries[0], CacheEntry{View: "default", Name: "www.example.com.", TTL: 3590, Type: "A", Rdata: "1.1.1.1"})
+	ut.Equal(t, *entries[1], CacheEntry{View: "default", Name: "www.example.com.", TTL: 3590, Type: "A", Rdata: "1.1.1.2"})
+	ut.Equal(t, entries[2].Type, `\-AAAA`)
+	ut.Equal(t, *entries[3], CacheEntry{View: "internal", Name: "www.example.com.", TTL: 60, Type: "A", Rdata: "10.0.0.1"})
+
+	entries, _ = FilterCacheDump(strings.NewReader(cacheDump), "example.com.")
+	ut.Equal(t, len(entries), 1)
+	ut.Equal(t, *entries[0], CacheEntry{View: "default", Name: "example.com.", TTL: 172790, Type: "NS", Rdata: "ns1.example.com."})
+}
+
+func TestRndcCommands(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rndc")
+	ut.Assert(t, err == nil, "create temp dir should succeed")
+	defer os.RemoveAll(dir)
+
+	argsPath := filepath.Join(dir, "args")
+	dumpPath := filepath.Join(dir, DumpFileName)
+	script := "#!/bin/sh\necho \"$@\" > " + argsPath + "\n" +
+		"if [ \"$3\" = dumpdb ]; then printf 'www.example.com.\\t60\\tA\\t10.0.0.1\\n" + DumpCompleteTag + "\\n' > " + dumpPath + "; fi\n"
+	rndcPath := filepath.Join(dir, "rndc")
+	ut.Assert(t, ioutil.WriteFile(rndcPath, []byte(script), 0755) == nil, "write fake rndc should succeed")
+
+	r := New(rndcPath, "/etc/rndc.conf")
+	for _, c := range []struct {
+		run  func() error
+		args string
+	}{
+		{func() error { return r.Flush("") }, "-c /etc/rndc.conf flush\n"},
+		{func() error { return r.Flush("default") }, "-c /etc/rndc.conf flush default\n"},
+		{func() error { return r.FlushName("www.example.com", "") }, "-c /etc/rndc.conf flushname www.example.com\n"},
+		{func() error { return r.FlushTree("example.com", "v1") }, "-c /etc/rndc.conf flushtree example.com v1\n"},
+	} {
+		ut.Assert(t, c.run() == nil, "rndc command should succeed")
+		args, _ := ioutil.ReadFile(argsPath)
+		ut.Equal(t, string(args), c.args)
+	}
+
+	entries, err := r.DumpCache("v1", dumpPath, "www.example.com")
+	ut.Assert(t, err == nil, "dump cache should succeed")
+	ut.Equal(t, len(entries), 1)
+	ut.Equal(t, entries[0].Rdata, "10.0.0.1")
+	args, _ := ioutil.ReadFile(argsPath)
+	ut.Equal(t, string(args), "-c /etc/rndc.conf dumpdb -cache v1\n")
+
+	failPath := filepath.Join(dir, "rndc-fail")
+	ut.Assert(t, ioutil.WriteFile(failPath, []byte("#!/bin/sh\necho 'rndc: view not found' >&2\nexit 1\n"), 0755) == nil,
+		"write failing rndc should succeed")
+	err = New(failPath, "").Flush("missing")
+	ut.Assert(t, err != nil, "failed rndc should return error")
+	ut.Equal(t, err.Error(), "rndc-fail: rndc: view not found")
+}
@@ -0,0 +1,214 @@
+package snapshot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	StatusAdded    = "added"
+	StatusRemoved  = "removed"
+	StatusModified = "modified"
+	DiffContext    = 3
+	MaxDiffCells   = 4000000
+)
+
+type FileDiff struct {
+	Path   string
+	Status string
+	Diff   string
+}
+
+func (s *Store) Diff(fromID, toID string) ([]FileDiff, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	from, err := s.get(fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	toFiles, toDir := map[string]*File(nil), s.confDir
+	if toID == "" {
+		if toFiles, err = s.scanLive(); err != nil {
+			return nil, err
+		}
+	} else {
+		to, err := s.get(toID)
+		if err != nil {
+			return nil, err
+		}
+		toFiles, toDir = to.Files, filepath.Join(s.dir, toID)
+	}
+
+	fromDir := filepath.Join(s.dir, fromID)
+	names := sortedNames(from.Files)
+	for name := range toFiles {
+		if _, ok := from.Files[name]; ok == false {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var diffs []FileDiff
+	for _, name := range names {
+		if isExcluded(filepath.Base(name)) {
+			continue
+		}
+
+		fromFile, inFrom := from.Files[name]
+		toFile, inTo := toFiles[name]
+		switch {
+		case inFrom == false:
+			diffs = append(diffs, FileDiff{Path: name, Status: StatusAdded})
+		case inTo == false:
+			diffs = append(diffs, FileDiff{Path: name, Status: StatusRemoved})
+		case fromFile.Hash != toFile.Hash:
+			diff, err := diffFiles(filepath.Join(fromDir, name), filepath.Join(toDir, name))
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, FileDiff{Path: name, Status: StatusModified, Diff: diff})
+		}
+	}
+
+	return diffs, nil
+}
+
+func diffFiles(fromPath, toPath string) (string, error) {
+	from, err := ioutil.ReadFile(fromPath)
+	if err != nil {
+		return "", fmt.Errorf("read file %s failed: %s", fromPath, err.Error())
+	}
+
+	to, err := ioutil.ReadFile(toPath)
+	if err != nil {
+		return "", fmt.Errorf("read file %s failed: %s", toPath, err.Error())
+	}
+
+	return UnifiedDiff(strings.Split(string(from), "\n"), strings.Split(string(to), "\n")), nil
+}
+
+type diffOp struct {
+	kind byte
+	line string
+	a    int
+	b    int
+}
+
+func UnifiedDiff(a, b []string) string {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	midA, midB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if len(midA)*len(midB) > MaxDiffCells {
+		return fmt.Sprintf("files differ in lines %d-%d, too large to diff", prefix+1, len(a)-suffix)
+	}
+
+	var ops []diffOp
+	for i := 0; i < prefix; i++ {
+		ops = append(ops, diffOp{kind: ' ', line: a[i], a: i, b: i})
+	}
+
+	ops = append(ops, lcsOps(midA, midB, prefix)...)
+	for i := 0; i < suffix; i++ {
+		ai, bi := len(a)-suffix+i, len(b)-suffix+i
+		ops = append(ops, diffOp{kind: ' ', line: a[ai], a: ai, b: bi})
+	}
+
+	return formatHunks(ops)
+}
+
+func lcsOps(a, b []string, offset int) []diffOp {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			ops = append(ops, diffOp{kind: ' ', line: a[i], a: offset + i, b: offset + j})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lengths[i+1][j] >= lengths[i][j+1]):
+			ops = append(ops, diffOp{kind: '-', line: a[i], a: offset + i, b: offset + j})
+			i++
+		default:
+			ops = append(ops, diffOp{kind: '+', line: b[j], a: offset + i, b: offset + j})
+			j++
+		}
+	}
+
+	return ops
+}
+
+func formatHunks(ops []diffOp) string {
+	var out strings.Builder
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+
+		start := i - DiffContext
+		if start < 0 {
+			start = 0
+		}
+
+		end, lastChange := i, i
+		for end < len(ops) && end-lastChange <= 2*DiffContext {
+			if ops[end].kind != ' ' {
+				lastChange = end
+			}
+			end++
+		}
+		if end > lastChange+DiffContext+1 {
+			end = lastChange + DiffContext + 1
+		}
+
+		var countA, countB int
+		for _, op := range ops[start:end] {
+			if op.kind != '+' {
+				countA++
+			}
+			if op.kind != '-' {
+				countB++
+			}
+		}
+
+		fmt.Fprintf(&out, "@@ -%d,%d +%d,%d @@\n", ops[start].a+1, countA, ops[start].b+1, countB)
+		for _, op := range ops[start:end] {
+			out.WriteByte(op.kind)
+			out.WriteString(op.line)
+			out.WriteByte('\n')
+		}
+		i = end
+	}
+
+	return out.String()
+}
@@ -0,0 +1,390 @@
+package snapshot
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkingthing/ddi-agent/pkg/fileutil"
+	"github.com/linkingthing/ddi-agent/pkg/journal"
+)
+
+const (
+	ManifestName       = "manifest.json"
+	DriftName          = "drift.json"
+	DefaultMaxVersions = 10
+	DefaultDirName     = ".snapshots"
+	IDFormat           = "20060102-150405.000000"
+	TimeFormat         = "2006-01-02 15:04:05"
+	dirPermissions     = 0755
+)
+
+var excludedNames = []string{"query.log", "named.pid", "named_dump.db", "named.stats", "managed-keys.bind"}
+var excludedSuffixes = []string{".jnl", ".nzd", ".nzd-lock", ".mkeys", ".pid", ".log", ".key"}
+
+type File struct {
+	Hash string      `json:"hash"`
+	Mode os.FileMode `json:"mode"`
+	Size int64       `json:"size"`
+}
+
+type Snapshot struct {
+	ID         string           `json:"id"`
+	CreateTime time.Time        `json:"create_time"`
+	Files      map[string]*File `json:"files"`
+}
+
+type Drift struct {
+	SnapshotID string    `json:"snapshot_id"`
+	Time       time.Time `json:"time"`
+}
+
+type Store struct {
+	confDir     string
+	dir         string
+	maxVersions int
+	lock        sync.Mutex
+}
+
+func New(confDir, dir string, maxVersions int) (*Store, error) {
+	if dir == "" {
+		dir = filepath.Join(confDir, DefaultDirName)
+	}
+
+	if maxVersions <= 0 {
+		maxVersions = DefaultMaxVersions
+	}
+
+	if err := os.MkdirAll(dir, dirPermissions); err != nil {
+		return nil, fmt.Errorf("create snapshot dir %s failed: %s", dir, err.Error())
+	}
+
+	return &Store{confDir: confDir, dir: dir, maxVersions: maxVersions}, nil
+}
+
+func (s *Snapshot) Size() int64 {
+	var size int64
+	for _, file := range s.Files {
+		size += file.Size
+	}
+
+	return size
+}
+
+func (s *Store) Take() (*Snapshot, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	files, err := s.scanLive()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := s.list()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(snapshots) > 0 && sameFiles(snapshots[0].Files, files) {
+		return snapshots[0], nil
+	}
+
+	now := time.Now()
+	snapshot := &Snapshot{ID: now.Format(IDFormat), CreateTime: now, Files: files}
+	tmpDir := filepath.Join(s.dir, "."+snapshot.ID)
+	if err := os.MkdirAll(tmpDir, dirPermissions); err != nil {
+		return nil, fmt.Errorf("create snapshot dir %s failed: %s", tmpDir, err.Error())
+	}
+
+	if err := s.copyFiles(tmpDir, snapshot); err != nil {
+		os.RemoveAll(tmpDir)
+		return nil, err
+	}
+
+	if err := os.Rename(tmpDir, filepath.Join(s.dir, snapshot.ID)); err != nil {
+		os.RemoveAll(tmpDir)
+		return nil, fmt.Errorf("save snapshot %s failed: %s", snapshot.ID, err.Error())
+	}
+
+	s.prune(append([]*Snapshot{snapshot}, snapshots...))
+	return snapshot, nil
+}
+
+func (s *Store) List() ([]*Snapshot, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.list()
+}
+
+func (s *Store) Get(id string) (*Snapshot, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.get(id)
+}
+
+func (s *Store) Restore(id string, j *journal.Journal) ([]string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	snapshot, err := s.get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := s.scanLive()
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	for _, name := range sortedNames(snapshot.Files) {
+		file := snapshot.Files[name]
+		if isExcluded(filepath.Base(name)) {
+			continue
+		}
+
+		if current, ok := live[name]; ok && current.Hash == file.Hash && current.Mode == file.Mode {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(s.dir, id, name))
+		if err != nil {
+			return changed, fmt.Errorf("read snapshot file %s failed: %s", name, err.Error())
+		}
+
+		path := filepath.Join(s.confDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), dirPermissions); err != nil {
+			return changed, fmt.Errorf("create dir for %s failed: %s", path, err.Error())
+		}
+
+		if err := j.Snapshot(path); err != nil {
+			return changed, err
+		}
+
+		if err := fileutil.WriteFileAtomic(path, data, file.Mode); err != nil {
+			return changed, err
+		}
+		changed = append(changed, name)
+	}
+
+	for _, name := range sortedNames(live) {
+		if _, ok := snapshot.Files[name]; ok == false {
+			path := filepath.Join(s.confDir, name)
+			if err := j.Snapshot(path); err != nil {
+				return changed, err
+			}
+
+			if err := os.Remove(path); err != nil && os.IsNotExist(err) == false {
+				return changed, fmt.Errorf("remove file %s failed: %s", name, err.Error())
+			}
+			changed = append(changed, name)
+		}
+	}
+
+	return changed, nil
+}
+
+func (s *Store) MarkDrift(id string) error {
+	data, err := json.Marshal(&Drift{SnapshotID: id, Time: time.Now()})
+	if err != nil {
+		return fmt.Errorf("marshal drift failed: %s", err.Error())
+	}
+
+	return fileutil.WriteFileAtomic(filepath.Join(s.dir, DriftName), data, 0644)
+}
+
+func (s *Store) ClearDrift() error {
+	if err := os.Remove(filepath.Join(s.dir, DriftName)); err != nil && os.IsNotExist(err) == false {
+		return fmt.Errorf("remove drift file failed: %s", err.Error())
+	}
+
+	return nil
+}
+
+func (s *Store) Drift() (*Drift, error) {
+	data, err := ioutil.ReadFile(filepath.Join(s.dir, DriftName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read drift file failed: %s", err.Error())
+	}
+
+	var drift Drift
+	if err := json.Unmarshal(data, &drift); err != nil {
+		return nil, fmt.Errorf("unmarshal drift file failed: %s", err.Error())
+	}
+
+	return &drift, nil
+}
+
+func (s *Store) list() ([]*Snapshot, error) {
+	entries, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot dir %s failed: %s", s.dir, err.Error())
+	}
+
+	var snapshots []*Snapshot
+	for _, entry := range entries {
+		if entry.IsDir() == false || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		snapshot, err := s.get(entry.Name())
+		if err != nil {
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].ID > snapshots[j].ID
+	})
+	return snapshots, nil
+}
+
+func (s *Store) get(id string) (*Snapshot, error) {
+	if id == "" || strings.ContainsAny(id, "/\\") || strings.HasPrefix(id, ".") {
+		return nil, fmt.Errorf("invalid snapshot id %s", id)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(s.dir, id, ManifestName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("snapshot %s not found", id)
+		}
+		return nil, fmt.Errorf("read snapshot %s failed: %s", id, err.Error())
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("unmarshal snapshot %s failed: %s", id, err.Error())
+	}
+
+	return &snapshot, nil
+}
+
+func (s *Store) prune(snapshots []*Snapshot) {
+	for i := s.maxVersions; i < len(snapshots); i++ {
+		os.RemoveAll(filepath.Join(s.dir, snapshots[i].ID))
+	}
+}
+
+func (s *Store) copyFiles(dst string, snapshot *Snapshot) error {
+	for name, file := range snapshot.Files {
+		data, err := ioutil.ReadFile(filepath.Join(s.confDir, name))
+		if err != nil {
+			return fmt.Errorf("read file %s failed: %s", name, err.Error())
+		}
+
+		sum := sha256.Sum256(data)
+		file.Hash = hex.EncodeToString(sum[:])
+		file.Size = int64(len(data))
+		path := filepath.Join(dst, name)
+		if err := os.MkdirAll(filepath.Dir(path), dirPermissions); err != nil {
+			return fmt.Errorf("create dir for %s failed: %s", path, err.Error())
+		}
+
+		if err := ioutil.WriteFile(path, data, file.Mode); err != nil {
+			return fmt.Errorf("copy file %s failed: %s", name, err.Error())
+		}
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("marshal manifest failed: %s", err.Error())
+	}
+
+	return ioutil.WriteFile(filepath.Join(dst, ManifestName), data, 0644)
+}
+
+func (s *Store) scanLive() (map[string]*File, error) {
+	files := make(map[string]*File)
+	err := filepath.Walk(s.confDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == s.confDir {
+			return nil
+		}
+
+		if path == s.dir || strings.HasPrefix(info.Name(), ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if info.Mode().IsRegular() == false || isExcluded(info.Name()) {
+			return nil
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		name, err := filepath.Rel(s.confDir, path)
+		if err != nil {
+			return err
+		}
+
+		sum := sha256.Sum256(data)
+		files[name] = &File{Hash: hex.EncodeToString(sum[:]), Mode: info.Mode().Perm(), Size: info.Size()}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan dir %s failed: %s", s.confDir, err.Error())
+	}
+
+	return files, nil
+}
+
+func isExcluded(name string) bool {
+	for _, excluded := range excludedNames {
+		if strings.HasPrefix(name, excluded) {
+			return true
+		}
+	}
+
+	for _, suffix := range excludedSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sameFiles(a, b map[string]*File) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for name, file := range a {
+		if other, ok := b[name]; ok == false || other.Hash != file.Hash || other.Mode != file.Mode {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedNames(files map[string]*File) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
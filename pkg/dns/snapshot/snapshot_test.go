@@ -0,0 +1,81 @@
+package snapshot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+func TestTakeDiffRestore(t *testing.T) {
+	confDir, err := ioutil.TempDir("", "snapshot-test")
+	ut.Assert(t, err == nil, "create temp dir should succeed")
+	defer os.RemoveAll(confDir)
+
+	ut.Assert(t, os.Mkdir(filepath.Join(confDir, "redirection"), 0755) == nil, "mkdir should succeed")
+	ut.Assert(t, ioutil.WriteFile(filepath.Join(confDir, "named.conf"), []byte("a\nb\nc\n"), 0644) == nil, "")
+	ut.Assert(t, ioutil.WriteFile(filepath.Join(confDir, "redirection", "rpz_default"), []byte("rpz\n"), 0644) == nil, "")
+	ut.Assert(t, ioutil.WriteFile(filepath.Join(confDir, "query.log"), []byte("log\n"), 0644) == nil, "")
+	ut.Assert(t, os.Mkdir(filepath.Join(confDir, "tls"), 0755) == nil, "mkdir should succeed")
+	ut.Assert(t, ioutil.WriteFile(filepath.Join(confDir, "tls", "ddi_global.key"), []byte("key\n"), 0600) == nil, "")
+
+	store, err := New(confDir, "", 2)
+	ut.Assert(t, err == nil, "new store should succeed")
+
+	first, err := store.Take()
+	ut.Assert(t, err == nil, "take snapshot should succeed")
+	ut.Equal(t, len(first.Files), 2)
+
+	same, err := store.Take()
+	ut.Assert(t, err == nil, "take snapshot should succeed")
+	ut.Equal(t, same.ID, first.ID)
+
+	ut.Assert(t, ioutil.WriteFile(filepath.Join(confDir, "named.conf"), []byte("a\nB\nc\n"), 0644) == nil, "")
+	ut.Assert(t, ioutil.WriteFile(filepath.Join(confDir, "default.nzf"), []byte("zone\n"), 0644) == nil, "")
+	second, err := store.Take()
+	ut.Assert(t, err == nil, "take snapshot should succeed")
+	ut.Assert(t, second.ID != first.ID, "changed files should create new snapshot")
+
+	diffs, err := store.Diff(first.ID, second.ID)
+	ut.Assert(t, err == nil, "diff should succeed")
+	ut.Equal(t, len(diffs), 2)
+	ut.Equal(t, diffs[0], FileDiff{Path: "default.nzf", Status: StatusAdded})
+	ut.Equal(t, diffs[1].Status, StatusModified)
+	ut.Equal(t, diffs[1].Diff, "@@ -1,4 +1,4 @@\n a\n-b\n+B\n c\n \n")
+
+	changed, err := store.Restore(first.ID, nil)
+	ut.Assert(t, err == nil, "restore should succeed")
+	ut.Equal(t, changed, []string{"named.conf", "default.nzf"})
+	data, _ := ioutil.ReadFile(filepath.Join(confDir, "named.conf"))
+	ut.Equal(t, string(data), "a\nb\nc\n")
+	_, err = os.Stat(filepath.Join(confDir, "default.nzf"))
+	ut.Assert(t, os.IsNotExist(err), "file absent from snapshot should be removed")
+	_, err = os.Stat(filepath.Join(confDir, "tls", "ddi_global.key"))
+	ut.Assert(t, err == nil, "private key should be left untouched by restore")
+
+	diffs, err = store.Diff(first.ID, "")
+	ut.Assert(t, err == nil, "diff with live files should succeed")
+	ut.Equal(t, len(diffs), 0)
+
+	ut.Assert(t, ioutil.WriteFile(filepath.Join(confDir, "named.conf"), []byte("x\n"), 0644) == nil, "")
+	_, err = store.Take()
+	ut.Assert(t, err == nil, "take snapshot should succeed")
+	snapshots, err := store.List()
+	ut.Assert(t, err == nil, "list should succeed")
+	ut.Equal(t, len(snapshots), 2)
+	ut.Assert(t, snapshots[1].ID == second.ID, "oldest snapshot should be pruned")
+
+	ut.Assert(t, store.MarkDrift(first.ID) == nil, "mark drift should succeed")
+	drift, err := store.Drift()
+	ut.Assert(t, err == nil && drift.SnapshotID == first.ID, "drift should be recorded")
+	ut.Assert(t, store.ClearDrift() == nil, "clear drift should succeed")
+	drift, err = store.Drift()
+	ut.Assert(t, err == nil && drift == nil, "drift should be cleared")
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	ut.Equal(t, UnifiedDiff([]string{"a", "b"}, []string{"a", "b"}), "")
+	ut.Equal(t, UnifiedDiff([]string{"a"}, []string{"a", "b"}), "@@ -1,1 +1,2 @@\n a\n+b\n")
+}
@@ -0,0 +1,167 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const CheckTimeout = 30 * time.Second
+
+type Checker struct {
+	checkConfPath string
+	checkZonePath string
+}
+
+func NewChecker(checkConfPath, checkZonePath string) *Checker {
+	return &Checker{
+		checkConfPath: checkConfPath,
+		checkZonePath: checkZonePath,
+	}
+}
+
+func (c *Checker) CheckConf(mainConfPath, confPath string, content []byte) error {
+	if err := ValidateConf(content); err != nil {
+		return err
+	}
+
+	if isExecutable(c.checkConfPath) == false {
+		return nil
+	}
+
+	candidate, err := writeTempFile(confPath, content)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(candidate)
+
+	target := candidate
+	if confPath != mainConfPath {
+		if mainConf, err := ioutil.ReadFile(mainConfPath); err == nil {
+			include := []byte("\"" + confPath + "\"")
+			if bytes.Contains(mainConf, include) {
+				mainConf = bytes.Replace(mainConf, include, []byte("\""+candidate+"\""), -1)
+				if target, err = writeTempFile(mainConfPath, mainConf); err != nil {
+					return err
+				}
+				defer os.Remove(target)
+			}
+		}
+	}
+
+	return runChecker(c.checkConfPath, target)
+}
+
+func (c *Checker) CheckConfDir(confDir, mainConfName string, files map[string][]byte, removed []string) error {
+	if isExecutable(c.checkConfPath) == false {
+		return nil
+	}
+
+	tmpDir, err := ioutil.TempDir("", "named-check")
+	if err != nil {
+		return fmt.Errorf("create temp dir for %s failed: %s", confDir, err.Error())
+	}
+	defer os.RemoveAll(tmpDir)
+
+	infos, err := ioutil.ReadDir(confDir)
+	if err != nil && os.IsNotExist(err) == false {
+		return fmt.Errorf("read conf dir %s failed: %s", confDir, err.Error())
+	}
+
+	contents := make(map[string][]byte)
+	for _, info := range infos {
+		path := filepath.Join(confDir, info.Name())
+		if info.Mode().IsRegular() && isConfFile(info.Name()) {
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("read conf %s failed: %s", path, err.Error())
+			}
+			contents[path] = data
+		}
+	}
+
+	for path, data := range files {
+		contents[path] = data
+	}
+
+	for _, path := range removed {
+		delete(contents, path)
+	}
+
+	oldPrefix, newPrefix := []byte("include \""+confDir), []byte("include \""+tmpDir)
+	for path, data := range contents {
+		if err := ioutil.WriteFile(filepath.Join(tmpDir, filepath.Base(path)),
+			bytes.Replace(data, oldPrefix, newPrefix, -1), 0644); err != nil {
+			return fmt.Errorf("write conf %s to temp dir failed: %s", path, err.Error())
+		}
+	}
+
+	return runChecker(c.checkConfPath, filepath.Join(tmpDir, mainConfName))
+}
+
+func isConfFile(name string) bool {
+	return strings.HasSuffix(name, ".conf") || strings.HasSuffix(name, ".acl")
+}
+
+func (c *Checker) CheckZone(origin, zonePath string, content []byte) error {
+	if err := ValidateZone(origin, content); err != nil {
+		return err
+	}
+
+	if isExecutable(c.checkZonePath) == false {
+		return nil
+	}
+
+	candidate, err := writeTempFile(zonePath, content)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(candidate)
+
+	return runChecker(c.checkZonePath, origin, candidate)
+}
+
+func isExecutable(path string) bool {
+	if path == "" {
+		return false
+	}
+
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular() && info.Mode().Perm()&0111 != 0
+}
+
+func writeTempFile(path string, content []byte) (string, error) {
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".check-")
+	if err != nil {
+		return "", fmt.Errorf("create temp file for %s failed: %s", path, err.Error())
+	}
+	defer f.Close()
+
+	if _, err := f.Write(content); err != nil {
+		os.Remove(f.Name())
+		return "", fmt.Errorf("write temp file for %s failed: %s", path, err.Error())
+	}
+
+	return f.Name(), nil
+}
+
+func runChecker(checker string, args ...string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), CheckTimeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, checker, args...).CombinedOutput()
+	if err != nil {
+		if msg := strings.TrimSpace(string(output)); msg != "" {
+			return fmt.Errorf("%s: %s", filepath.Base(checker), msg)
+		}
+		return fmt.Errorf("%s failed: %s", filepath.Base(checker), err.Error())
+	}
+
+	return nil
+}
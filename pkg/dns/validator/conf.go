@@ -0,0 +1,389 @@
+package validator
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+type tokenType int
+
+const (
+	tokenWord tokenType = iota
+	tokenString
+	tokenOpen
+	tokenClose
+	tokenSemicolon
+)
+
+type token struct {
+	typ   tokenType
+	value string
+	line  int
+}
+
+type statement struct {
+	words  []token
+	blocks []*block
+	line   int
+}
+
+type block struct {
+	keyword    string
+	line       int
+	statements []*statement
+}
+
+var addressMatchListKeywords = map[string]bool{
+	"acl":                true,
+	"allow":              true,
+	"allow-notify":       true,
+	"allow-query":        true,
+	"allow-query-cache":  true,
+	"allow-query-on":     true,
+	"allow-recursion":    true,
+	"allow-recursion-on": true,
+	"allow-transfer":     true,
+	"allow-update":       true,
+	"blackhole":          true,
+	"clients":            true,
+	"exclude":            true,
+	"listen-on":          true,
+	"listen-on-v6":       true,
+	"mapped":             true,
+	"match-clients":      true,
+	"match-destinations": true,
+}
+
+var addressListKeywords = map[string]bool{
+	"also-notify": true,
+	"forwarders":  true,
+	"masters":     true,
+}
+
+var builtinACLs = map[string]bool{
+	"any":       true,
+	"none":      true,
+	"localhost": true,
+	"localnets": true,
+}
+
+func ValidateConf(content []byte) error {
+	tokens, err := tokenize(string(content))
+	if err != nil {
+		return err
+	}
+
+	statements, next, err := parseStatements(tokens, 0, false)
+	if err != nil {
+		return err
+	}
+
+	if next != len(tokens) {
+		return fmt.Errorf("line %d: unexpected '}'", tokens[next].line)
+	}
+
+	for _, stmt := range statements {
+		if err := validateStatement(stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func tokenize(content string) ([]token, error) {
+	var tokens []token
+	line := 1
+	for i := 0; i < len(content); {
+		c := content[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == '#' || (c == '/' && i+1 < len(content) && content[i+1] == '/'):
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(content) && content[i+1] == '*':
+			start := line
+			end := strings.Index(content[i+2:], "*/")
+			if end == -1 {
+				return nil, fmt.Errorf("line %d: unterminated comment", start)
+			}
+			line += strings.Count(content[i:i+2+end], "\n")
+			i += end + 4
+		case c == '"':
+			start := line
+			end := strings.IndexByte(content[i+1:], '"')
+			if end == -1 {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", start)
+			}
+			value := content[i+1 : i+1+end]
+			line += strings.Count(value, "\n")
+			tokens = append(tokens, token{typ: tokenString, value: value, line: start})
+			i += end + 2
+		case c == '{':
+			tokens = append(tokens, token{typ: tokenOpen, value: "{", line: line})
+			i++
+		case c == '}':
+			tokens = append(tokens, token{typ: tokenClose, value: "}", line: line})
+			i++
+		case c == ';':
+			tokens = append(tokens, token{typ: tokenSemicolon, value: ";", line: line})
+			i++
+		default:
+			start := i
+			for i < len(content) && strings.IndexByte(" \t\r\n{};\"#", content[i]) == -1 &&
+				!(content[i] == '/' && i+1 < len(content) && (content[i+1] == '/' || content[i+1] == '*')) {
+				i++
+			}
+			tokens = append(tokens, token{typ: tokenWord, value: content[start:i], line: line})
+		}
+	}
+
+	return tokens, nil
+}
+
+func parseStatements(tokens []token, pos int, nested bool) ([]*statement, int, error) {
+	var statements []*statement
+	for pos < len(tokens) {
+		if tokens[pos].typ == tokenClose {
+			if nested {
+				return statements, pos, nil
+			}
+			return nil, pos, fmt.Errorf("line %d: unexpected '}'", tokens[pos].line)
+		}
+
+		stmt, next, err := parseStatement(tokens, pos)
+		if err != nil {
+			return nil, next, err
+		}
+
+		statements = append(statements, stmt)
+		pos = next
+	}
+
+	if nested {
+		return nil, pos, fmt.Errorf("line %d: missing '}'", tokens[len(tokens)-1].line)
+	}
+
+	return statements, pos, nil
+}
+
+func parseStatement(tokens []token, pos int) (*statement, int, error) {
+	stmt := &statement{line: tokens[pos].line}
+	for pos < len(tokens) {
+		tok := tokens[pos]
+		switch tok.typ {
+		case tokenSemicolon:
+			if len(stmt.words) == 0 && len(stmt.blocks) == 0 {
+				return nil, pos, fmt.Errorf("line %d: unexpected ';'", tok.line)
+			}
+			return stmt, pos + 1, nil
+		case tokenClose:
+			return nil, pos, fmt.Errorf("line %d: missing ';' before '}'", tok.line)
+		case tokenOpen:
+			b := &block{line: tok.line}
+			if len(stmt.words) > 0 {
+				b.keyword = stmt.words[len(stmt.words)-1].value
+			}
+			statements, next, err := parseStatements(tokens, pos+1, true)
+			if err != nil {
+				return nil, next, err
+			}
+			b.statements = statements
+			stmt.blocks = append(stmt.blocks, b)
+			pos = next + 1
+		default:
+			if len(stmt.blocks) > 0 && tok.line > tokens[pos-1].line && isKnownClause(tok.value) {
+				return nil, pos, fmt.Errorf("line %d: missing ';' before '%s'", tokens[pos-1].line, tok.value)
+			}
+			stmt.words = append(stmt.words, tok)
+			pos++
+		}
+	}
+
+	return nil, pos, fmt.Errorf("line %d: missing ';'", tokens[len(tokens)-1].line)
+}
+
+func isKnownClause(word string) bool {
+	switch word {
+	case "acl", "controls", "include", "key", "logging", "options", "statistics-channels", "view", "zone":
+		return true
+	default:
+		return false
+	}
+}
+
+func validateStatement(stmt *statement) error {
+	if len(stmt.words) == 0 {
+		return fmt.Errorf("line %d: statement without keyword", stmt.line)
+	}
+
+	switch stmt.words[0].value {
+	case "acl":
+		if len(stmt.words) != 2 || len(stmt.blocks) != 1 {
+			return fmt.Errorf("line %d: acl must be written as acl <name> { ... }", stmt.line)
+		}
+		if builtinACLs[stmt.words[1].value] {
+			return fmt.Errorf("line %d: acl name %s is reserved", stmt.line, stmt.words[1].value)
+		}
+	case "include":
+		if len(stmt.words) != 2 || stmt.words[1].typ != tokenString || len(stmt.blocks) != 0 {
+			return fmt.Errorf("line %d: include requires a quoted file name", stmt.line)
+		}
+	case "zone":
+		if len(stmt.words) < 2 || len(stmt.blocks) > 1 {
+			return fmt.Errorf("line %d: zone must be written as zone <name> [class] { ... }", stmt.line)
+		}
+	}
+
+	for _, b := range stmt.blocks {
+		keyword := b.keyword
+		if addressMatchListKeywords[keyword] == false && addressListKeywords[keyword] == false {
+			keyword = stmt.words[0].value
+		}
+
+		var err error
+		switch {
+		case addressMatchListKeywords[keyword]:
+			err = validateAddressMatchList(b.statements)
+		case addressListKeywords[keyword]:
+			err = validateAddressList(keyword, b.statements)
+		default:
+			for _, child := range b.statements {
+				if err = validateStatement(child); err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateAddressMatchList(elements []*statement) error {
+	for _, element := range elements {
+		if len(element.blocks) > 0 {
+			if len(element.words) > 1 || (len(element.words) == 1 && element.words[0].value != "!") {
+				return fmt.Errorf("line %d: invalid nested address match list", element.line)
+			}
+			if err := validateAddressMatchList(element.blocks[0].statements); err != nil {
+				return err
+			}
+			continue
+		}
+
+		words := element.words
+		value := words[0].value
+		if value == "!" {
+			words = words[1:]
+			if len(words) == 0 {
+				return fmt.Errorf("line %d: missing address after '!'", element.line)
+			}
+			value = words[0].value
+		} else if strings.HasPrefix(value, "!") {
+			value = strings.TrimPrefix(value, "!")
+		}
+
+		if value == "key" {
+			if len(words) != 2 {
+				return fmt.Errorf("line %d: key element requires exactly one key name", element.line)
+			}
+			continue
+		}
+
+		if len(words) != 1 {
+			return fmt.Errorf("line %d: unexpected '%s' in address match list", element.line, words[1].value)
+		}
+
+		if err := validateAddressMatchElement(value); err != nil {
+			return fmt.Errorf("line %d: %s", element.line, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func validateAddressMatchElement(value string) error {
+	if value == "" {
+		return fmt.Errorf("empty address match element")
+	}
+
+	if builtinACLs[value] || looksLikeAddress(value) == false {
+		return nil
+	}
+
+	if strings.Contains(value, "/") {
+		if _, _, err := net.ParseCIDR(value); err != nil {
+			return fmt.Errorf("invalid prefix %s", value)
+		}
+		return nil
+	}
+
+	if net.ParseIP(value) == nil {
+		return fmt.Errorf("invalid address %s", value)
+	}
+
+	return nil
+}
+
+func validateAddressList(keyword string, elements []*statement) error {
+	for _, element := range elements {
+		if len(element.blocks) > 0 {
+			return fmt.Errorf("line %d: unexpected '{' in %s", element.line, keyword)
+		}
+
+		words := element.words
+		address := words[0].value
+		if net.ParseIP(address) == nil {
+			if keyword != "masters" || looksLikeAddress(address) {
+				return fmt.Errorf("line %d: invalid address %s in %s", element.line, address, keyword)
+			}
+		}
+
+		for i := 1; i < len(words); i += 2 {
+			if i+1 >= len(words) {
+				return fmt.Errorf("line %d: missing value for '%s' in %s", element.line, words[i].value, keyword)
+			}
+
+			switch words[i].value {
+			case "port", "dscp":
+				if _, err := strconv.ParseUint(words[i+1].value, 10, 16); err != nil {
+					return fmt.Errorf("line %d: invalid %s %s in %s", element.line, words[i].value, words[i+1].value, keyword)
+				}
+			case "key", "tls":
+			default:
+				return fmt.Errorf("line %d: unexpected '%s' in %s", element.line, words[i].value, keyword)
+			}
+		}
+	}
+
+	return nil
+}
+
+func looksLikeAddress(value string) bool {
+	if strings.Contains(value, ":") {
+		return true
+	}
+
+	hasDigit := false
+	for _, c := range value {
+		switch {
+		case c >= '0' && c <= '9':
+			hasDigit = true
+		case c == '.' || c == '/':
+		default:
+			return false
+		}
+	}
+
+	return hasDigit
+}
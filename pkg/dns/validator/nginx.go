@@ -0,0 +1,424 @@
+package validator
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const nginxConfSuffix = ".conf"
+
+type nginxDirective struct {
+	name     string
+	args     []string
+	line     int
+	hasBlock bool
+	children []*nginxDirective
+}
+
+var nginxBlockDirectives = map[string]bool{
+	"events":       true,
+	"http":         true,
+	"if":           true,
+	"limit_except": true,
+	"location":     true,
+	"map":          true,
+	"server":       true,
+	"types":        true,
+	"upstream":     true,
+}
+
+var nginxSSLProtocols = map[string]bool{
+	"SSLv2":   true,
+	"SSLv3":   true,
+	"TLSv1":   true,
+	"TLSv1.1": true,
+	"TLSv1.2": true,
+	"TLSv1.3": true,
+}
+
+type NginxChecker struct {
+	nginxPath string
+	prefix    string
+}
+
+func NewNginxChecker(nginxPath, prefix string) *NginxChecker {
+	return &NginxChecker{
+		nginxPath: nginxPath,
+		prefix:    prefix,
+	}
+}
+
+func (c *NginxChecker) Check(confPath string, content []byte) error {
+	files, err := loadNginxConfSet(confPath, content)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateNginxConfSet(files); err != nil {
+		return err
+	}
+
+	if isExecutable(c.nginxPath) == false || c.prefix == "" {
+		return nil
+	}
+
+	candidate, err := writeTempFile(confPath, content)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(candidate)
+
+	var includes strings.Builder
+	for _, name := range sortedNginxConfNames(files) {
+		if name == confPath {
+			name = candidate
+		}
+		includes.WriteString(fmt.Sprintf("    include %s;\n", name))
+	}
+
+	mainConf := fmt.Sprintf("error_log stderr;\npid %s;\nevents {}\nhttp {\n%s}\n",
+		filepath.Join(os.TempDir(), "nginx-check.pid"), includes.String())
+	main, err := writeTempFile(filepath.Join(c.prefix, "nginx.conf"), []byte(mainConf))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(main)
+
+	return runChecker(c.nginxPath, "-t", "-q", "-p", c.prefix, "-c", main)
+}
+
+func loadNginxConfSet(confPath string, content []byte) (map[string][]byte, error) {
+	files := map[string][]byte{confPath: content}
+	dir := filepath.Dir(confPath)
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return files, nil
+		}
+		return nil, fmt.Errorf("read nginx conf dir %s failed: %s", dir, err.Error())
+	}
+
+	for _, info := range infos {
+		name := filepath.Join(dir, info.Name())
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".") ||
+			strings.HasSuffix(info.Name(), nginxConfSuffix) == false || name == confPath {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, fmt.Errorf("read nginx conf %s failed: %s", name, err.Error())
+		}
+		files[name] = data
+	}
+
+	return files, nil
+}
+
+func sortedNginxConfNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func ValidateNginxConfSet(files map[string][]byte) error {
+	upstreams := make(map[string]string)
+	for _, name := range sortedNginxConfNames(files) {
+		if err := validateNginxConf(files[name], name, upstreams); err != nil {
+			return fmt.Errorf("%s: %s", filepath.Base(name), err.Error())
+		}
+	}
+
+	return nil
+}
+
+func ValidateNginxConf(content []byte) error {
+	return validateNginxConf(content, "", make(map[string]string))
+}
+
+func validateNginxConf(content []byte, name string, upstreams map[string]string) error {
+	tokens, err := tokenizeNginx(string(content))
+	if err != nil {
+		return err
+	}
+
+	directives, next, err := parseNginxDirectives(tokens, 0, false)
+	if err != nil {
+		return err
+	}
+
+	if next != len(tokens) {
+		return fmt.Errorf("line %d: unexpected '}'", tokens[next].line)
+	}
+
+	if err := validateNginxDirectives(directives, "", 0); err != nil {
+		return err
+	}
+
+	for _, directive := range directives {
+		if directive.name == "upstream" {
+			if other, ok := upstreams[directive.args[0]]; ok {
+				if other != name {
+					return fmt.Errorf("line %d: duplicate upstream %s in %s", directive.line, directive.args[0], filepath.Base(other))
+				}
+				return fmt.Errorf("line %d: duplicate upstream %s", directive.line, directive.args[0])
+			}
+			upstreams[directive.args[0]] = name
+		}
+	}
+
+	return nil
+}
+
+func tokenizeNginx(content string) ([]token, error) {
+	var tokens []token
+	line := 1
+	for i := 0; i < len(content); {
+		c := content[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == '#':
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+		case c == '"' || c == '\'':
+			start := line
+			var value strings.Builder
+			i++
+			for ; i < len(content) && content[i] != c; i++ {
+				if content[i] == '\\' && i+1 < len(content) {
+					i++
+				}
+				if content[i] == '\n' {
+					line++
+				}
+				value.WriteByte(content[i])
+			}
+			if i == len(content) {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", start)
+			}
+			tokens = append(tokens, token{typ: tokenString, value: value.String(), line: start})
+			i++
+		case c == '{':
+			tokens = append(tokens, token{typ: tokenOpen, value: "{", line: line})
+			i++
+		case c == '}':
+			tokens = append(tokens, token{typ: tokenClose, value: "}", line: line})
+			i++
+		case c == ';':
+			tokens = append(tokens, token{typ: tokenSemicolon, value: ";", line: line})
+			i++
+		default:
+			start := i
+			for i < len(content) && strings.IndexByte(" \t\r\n{};\"'", content[i]) == -1 {
+				i++
+			}
+			tokens = append(tokens, token{typ: tokenWord, value: content[start:i], line: line})
+		}
+	}
+
+	return tokens, nil
+}
+
+func parseNginxDirectives(tokens []token, pos int, nested bool) ([]*nginxDirective, int, error) {
+	var directives []*nginxDirective
+	for pos < len(tokens) {
+		tok := tokens[pos]
+		switch tok.typ {
+		case tokenClose:
+			if nested {
+				return directives, pos, nil
+			}
+			return nil, pos, fmt.Errorf("line %d: unexpected '}'", tok.line)
+		case tokenSemicolon, tokenOpen:
+			return nil, pos, fmt.Errorf("line %d: unexpected '%s'", tok.line, tok.value)
+		}
+
+		directive := &nginxDirective{name: tok.value, line: tok.line}
+		pos++
+		for pos < len(tokens) && (tokens[pos].typ == tokenWord || tokens[pos].typ == tokenString) {
+			directive.args = append(directive.args, tokens[pos].value)
+			pos++
+		}
+
+		if pos == len(tokens) {
+			return nil, pos, fmt.Errorf("line %d: missing ';' after %s", tok.line, directive.name)
+		}
+
+		switch tokens[pos].typ {
+		case tokenSemicolon:
+			pos++
+		case tokenOpen:
+			children, next, err := parseNginxDirectives(tokens, pos+1, true)
+			if err != nil {
+				return nil, next, err
+			}
+			if next == len(tokens) {
+				return nil, next, fmt.Errorf("line %d: missing '}' for %s", tok.line, directive.name)
+			}
+			directive.hasBlock = true
+			directive.children = children
+			pos = next + 1
+		default:
+			return nil, pos, fmt.Errorf("line %d: missing ';' after %s", tok.line, directive.name)
+		}
+
+		directives = append(directives, directive)
+	}
+
+	return directives, pos, nil
+}
+
+func validateNginxDirectives(directives []*nginxDirective, parent string, line int) error {
+	locations := make(map[string]bool)
+	var hasListen, hasCert, hasCertKey, hasServer bool
+	for _, directive := range directives {
+		needBlock := nginxBlockDirectives[directive.name] && (directive.name != "server" || parent != "upstream")
+		if needBlock != directive.hasBlock {
+			if directive.hasBlock {
+				return fmt.Errorf("line %d: directive %s has no block", directive.line, directive.name)
+			}
+			return fmt.Errorf("line %d: directive %s requires a block", directive.line, directive.name)
+		}
+
+		if err := validateNginxDirective(directive, parent); err != nil {
+			return fmt.Errorf("line %d: %s", directive.line, err.Error())
+		}
+
+		switch directive.name {
+		case "location":
+			key := strings.Join(directive.args, " ")
+			if locations[key] {
+				return fmt.Errorf("line %d: duplicate location %s", directive.line, key)
+			}
+			locations[key] = true
+		case "listen":
+			hasListen = true
+		case "ssl_certificate":
+			hasCert = true
+		case "ssl_certificate_key":
+			hasCertKey = true
+		case "server":
+			hasServer = true
+		}
+
+		if directive.hasBlock {
+			if err := validateNginxDirectives(directive.children, directive.name, directive.line); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch parent {
+	case "server":
+		if hasListen == false {
+			return fmt.Errorf("line %d: server without listen directive", line)
+		}
+		if hasCert != hasCertKey {
+			return fmt.Errorf("line %d: ssl_certificate and ssl_certificate_key must be set together", line)
+		}
+	case "upstream":
+		if hasServer == false {
+			return fmt.Errorf("line %d: upstream without server directive", line)
+		}
+	}
+
+	return nil
+}
+
+func validateNginxDirective(directive *nginxDirective, parent string) error {
+	args := directive.args
+	switch directive.name {
+	case "server":
+		if parent == "upstream" {
+			if len(args) == 0 {
+				return fmt.Errorf("upstream server requires an address")
+			}
+			return validateUpstreamServerParams(args[1:])
+		}
+		if len(args) != 0 {
+			return fmt.Errorf("server block takes no arguments")
+		}
+	case "upstream":
+		if len(args) != 1 {
+			return fmt.Errorf("upstream requires one name")
+		}
+	case "location":
+		if len(args) != 1 && len(args) != 2 {
+			return fmt.Errorf("location requires a path")
+		}
+	case "listen", "server_name", "root":
+		if len(args) == 0 {
+			return fmt.Errorf("%s requires arguments", directive.name)
+		}
+	case "proxy_pass":
+		if len(args) != 1 {
+			return fmt.Errorf("proxy_pass requires one url")
+		}
+		if strings.Contains(args[0], "$") == false {
+			u, err := url.Parse(args[0])
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("invalid proxy_pass url %s", args[0])
+			}
+		}
+	case "return":
+		if len(args) == 0 || len(args) > 2 {
+			return fmt.Errorf("return requires a code and optional url or text")
+		}
+		if code, err := strconv.Atoi(args[0]); err != nil || code < 100 || code > 999 {
+			if len(args) != 1 {
+				return fmt.Errorf("invalid return code %s", args[0])
+			}
+		}
+	case "ssl_protocols":
+		if len(args) == 0 {
+			return fmt.Errorf("ssl_protocols requires protocols")
+		}
+		for _, protocol := range args {
+			if nginxSSLProtocols[protocol] == false {
+				return fmt.Errorf("unknown ssl protocol %s", protocol)
+			}
+		}
+	case "ssl_certificate", "ssl_certificate_key", "ssl_ciphers":
+		if len(args) != 1 {
+			return fmt.Errorf("%s requires one argument", directive.name)
+		}
+	}
+
+	return nil
+}
+
+func validateUpstreamServerParams(params []string) error {
+	for _, param := range params {
+		switch {
+		case param == "backup" || param == "down" || param == "resolve":
+		case strings.HasPrefix(param, "weight="), strings.HasPrefix(param, "max_fails="),
+			strings.HasPrefix(param, "max_conns="):
+			if _, err := strconv.ParseUint(param[strings.IndexByte(param, '=')+1:], 10, 32); err != nil {
+				return fmt.Errorf("invalid upstream server parameter %s", param)
+			}
+		case strings.HasPrefix(param, "fail_timeout="), strings.HasPrefix(param, "slow_start="):
+			value := strings.TrimRight(param[strings.IndexByte(param, '=')+1:], "smhd")
+			if _, err := strconv.ParseUint(value, 10, 32); err != nil {
+				return fmt.Errorf("invalid upstream server parameter %s", param)
+			}
+		default:
+			return fmt.Errorf("unknown upstream server parameter %s", param)
+		}
+	}
+
+	return nil
+}
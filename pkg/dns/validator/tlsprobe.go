@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"time"
+)
+
+const TLSProbeTimeout = 5 * time.Second
+
+func ParseCABundle(bundle []byte) (*x509.CertPool, error) {
+	if len(bundle) == 0 {
+		return nil, nil
+	}
+
+	pool := x509.NewCertPool()
+	if pool.AppendCertsFromPEM(bundle) == false {
+		return nil, fmt.Errorf("no certificate found in ca bundle")
+	}
+
+	return pool, nil
+}
+
+func ProbeTLS(address, serverName string, caBundle []byte, timeout time.Duration) error {
+	pool, err := ParseCABundle(caBundle)
+	if err != nil {
+		return err
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", address, &tls.Config{
+		ServerName: serverName,
+		RootCAs:    pool,
+		MinVersion: tls.VersionTLS12,
+	})
+	if err != nil {
+		return fmt.Errorf("tls handshake with %s failed: %s", address, err.Error())
+	}
+
+	return conn.Close()
+}
@@ -0,0 +1,288 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	ut "github.com/zdnscloud/cement/unittest"
+
+	"github.com/linkingthing/ddi-agent/pkg/dns/resource"
+)
+
+const namedConf = `key "rndc-key" {
+	algorithm hmac-sha256;
+	secret "c3Ryb25n";
+};
+
+controls {
+        inet 127.0.0.1 port 953
+        allow { 127.0.0.1; } keys { "rndc-key"; };
+};
+
+acl "office"{
+10.0.0.0/8;
+!192.168.1.1;
+2001:db8::/32;
+};
+
+view "default" {
+    recursion yes;
+    match-clients {
+	key keydefault;!10.1.1.1;office;
+	};
+	zone "example.com" { type forward; forward first; forwarders { 8.8.8.8; 1.1.1.1 port 5353; }; };
+        dns64 64:ff9b::/96 {
+        clients { any; };
+        mapped { any; };
+        suffix ::;
+        };
+	response-policy { zone "rpz" policy given; } max-policy-ttl 86400 qname-wait-recurse no ;
+        zone "rpz" {type master; file "redirection/rpz_default"; allow-query {any;}; };
+};
+# New zone file for view: default
+zone "a.com" in default { type master; file "a.com.zone"; allow-transfer {key keydefault;}; also-notify {  }; masters {  };};
+`
+
+func TestValidateConf(t *testing.T) {
+	ut.Assert(t, ValidateConf([]byte(namedConf)) == nil, "generated config should be valid")
+
+	for content, msg := range map[string]string{
+		"acl \"a\" { 10.0.0.300; };":                  "line 1: invalid address 10.0.0.300",
+		"acl \"a\" {\n10.0.0.0/33;\n};":               "line 2: invalid prefix 10.0.0.0/33",
+		"acl \"a\" { 10.0.0.1 };":                     "line 1: missing ';' before '}'",
+		"options {\n recursion yes;\n":                "line 2: missing '}'",
+		"options { recursion yes; }\nview \"v\" { };": "line 1: missing ';' before 'view'",
+		"options { forwarders { abc; }; };":           "line 1: invalid address abc in forwarders",
+		"options { listen-on { ;}; };":                "line 1: unexpected ';'",
+		"key \"k\" { secret \"abc; };":                "line 1: unterminated quoted string",
+		"acl any { 10.0.0.1; };":                      "line 1: acl name any is reserved",
+	} {
+		err := ValidateConf([]byte(content))
+		ut.Assert(t, err != nil, "%s should be invalid", content)
+		ut.Assert(t, strings.Contains(err.Error(), msg), "unexpected error %s", err.Error())
+	}
+}
+
+const forwardZoneTpl = `tls {{.TLS}} {
+	remote-hostname "dns.example.com";
+};
+view "v1" {
+	zone "{{.Name}}" { type forward; forward {{.ForwardStyle}}; forwarders { {{range $ii,$ip := .IPs}}{{$ip}}{{if $.TLS}} tls {{$.TLS}}{{end}}; {{end}}}; };
+};
+`
+
+func TestValidateDoTForwardZone(t *testing.T) {
+	zone := resource.AgentForwardZone{Name: "corp.example", ForwardStyle: "only", AgentView: "v1",
+		Addresses: []string{"1.1.1.1", "2001:db8::1"}, TlsEnable: true, TlsServerName: "dns.example.com"}
+	zoneData, err := zone.ToZoneData()
+	ut.Assert(t, err == nil, "tls forward zone should succeed")
+
+	buffer := new(bytes.Buffer)
+	ut.Assert(t, template.Must(template.New("forward").Parse(forwardZoneTpl)).Execute(buffer, zoneData) == nil,
+		"render forward zone should succeed")
+	ut.Assert(t, strings.Contains(buffer.String(), "1.1.1.1 port 853 tls fwd_v1_corp_example;"), "unexpected forwarders %s", buffer.String())
+	ut.Assert(t, ValidateConf(buffer.Bytes()) == nil, "dot forward zone should be valid")
+
+	err = ValidateConf([]byte("options { forwarders { 1.1.1.1 tls; }; };"))
+	ut.Assert(t, err != nil && strings.Contains(err.Error(), "missing value for 'tls' in forwarders"), "tls without name should fail")
+}
+
+func TestValidateEncryptedViews(t *testing.T) {
+	views := []*resource.AgentView{
+		{Name: "mobile", Key: "a2V5", EncryptedAddresses: []string{"10.0.0.1"}},
+		{Name: "default", Key: "a2V5"},
+	}
+	destinations := resource.MatchDestinations(views)
+	var data []map[string]interface{}
+	for _, view := range views {
+		data = append(data, map[string]interface{}{"Name": view.Name, "Key": view.Key, "MatchDestinations": destinations[view.Name]})
+	}
+
+	tpl, err := template.ParseFiles("../grpcservice/templates/named_view.tpl")
+	ut.Assert(t, err == nil, "parse view template should succeed: %v", err)
+	buffer := new(bytes.Buffer)
+	ut.Assert(t, tpl.Execute(buffer, map[string]interface{}{"Views": data}) == nil, "render views should succeed")
+	ut.Assert(t, ValidateConf(buffer.Bytes()) == nil, "encrypted views should be valid")
+
+	content := strings.Join(strings.Fields(buffer.String()), " ")
+	ut.Assert(t, strings.Contains(content, `view "mobile" { recursion no; match-clients { key keymobile; }; match-destinations { 10.0.0.1; };`),
+		"owning view should match its encrypted address: %s", content)
+	ut.Assert(t, strings.Contains(content, `view "default" { recursion no; match-clients { key keydefault; }; match-destinations { !10.0.0.1;any; };`),
+		"other views should exclude encrypted address: %s", content)
+}
+
+func TestValidateZone(t *testing.T) {
+	zone := `; zone file fragment for example.com.
+
+$TTL 3600
+
+$ORIGIN example.com.
+@ 3600 SOA ns1.example.com. root.example.com. 1 3600 900 604800 300
+@ 3600 NS ns1.example.com.
+ns1 3600 A 10.0.0.1
+www 600 IN AAAA 2001:db8::1
+txt 600 TXT "hello world; not a comment"
+`
+	ut.Assert(t, ValidateZone("example.com.", []byte(zone)) == nil, "generated zone should be valid")
+
+	rpz := `$TTL 7200	; 2 hours
+@			IN SOA	nons.blocked.com. noemail.blocked.com. (
+				2018031408 ; serial
+				43200      ; refresh (12 hours)
+				900        ; retry (15 minutes)
+				1814400    ; expire (3 weeks)
+				7200       ; minimum (2 hours)
+				)
+@       IN              NS      nons.blocked.com.
+
+www.bad.com 300 CNAME .
+`
+	ut.Assert(t, ValidateZone("rpz", []byte(rpz)) == nil, "rpz zone should be valid")
+
+	for content, msg := range map[string]string{
+		"@ 3600 A 10.0.0.256":       "line 1: invalid A rdata",
+		"$TTL 3600\n\nwww 3600 A":   "line 3: missing rdata for A record",
+		"www 3600 BOGUS 1":          "line 1: invalid record type BOGUS",
+		"@ SOA a. b. ( 1 2 3 4 5":   "line 1: missing ')'",
+		"$TTL abc":                  "line 1: invalid ttl abc",
+		"$GENERATE 1-2 a A 1.1.1.1": "line 1: unknown directive $GENERATE",
+	} {
+		err := ValidateZone("example.com.", []byte(content))
+		ut.Assert(t, err != nil, "%s should be invalid", content)
+		ut.Assert(t, strings.Contains(err.Error(), msg), "unexpected error %s", err.Error())
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	ttl, err := parseTTL("1h30m")
+	ut.Assert(t, err == nil, "1h30m should be valid")
+	ut.Equal(t, ttl, uint32(5400))
+
+	_, err = parseTTL("1x")
+	ut.Assert(t, err != nil, "1x should be invalid")
+}
+
+const nginxConf = `upstream ddi_a_com_443_1 {
+    least_conn;
+    server 10.0.0.1:80 max_fails=2 fail_timeout=10s;
+    server 10.0.0.2:80;
+}
+
+server {
+    listen              443 ssl;
+    server_name         a.com;
+    ssl_certificate     conf.d/key/a.com.crt;
+    ssl_certificate_key conf.d/key/a.com.key;
+    ssl_protocols       TLSv1.2 TLSv1.3;
+    add_header          Strict-Transport-Security "max-age=31536000" always;
+
+    location ^~ /.well-known/acme-challenge/ {
+        root /usr/share/nginx/acme;
+    }
+
+    location / {
+        proxy_pass http://ddi_a_com_443_1;
+        proxy_set_header Connection "upgrade";
+        proxy_set_header Host $http_host;
+    }
+
+    location /old/ {
+        return 301 https://b.com$request_uri;
+    }
+}
+`
+
+func TestValidateNginxConf(t *testing.T) {
+	ut.Assert(t, ValidateNginxConf([]byte(nginxConf)) == nil, "generated nginx config should be valid")
+
+	for content, msg := range map[string]string{
+		"server {\n listen 80;\n":                                          "line 1: missing '}' for server",
+		"server { listen 80 }":                                             "line 1: missing ';' after listen",
+		"server { server_name a.com; }":                                    "line 1: server without listen directive",
+		"server { listen 80; location / { proxy_pass 10.0.0.1; } }":        "line 1: invalid proxy_pass url 10.0.0.1",
+		"server { listen 80; location / { } location / { } }":              "line 1: duplicate location /",
+		"server { listen 443 ssl; ssl_protocols TLSv1.4; }":                "line 1: unknown ssl protocol TLSv1.4",
+		"server { listen 443 ssl; ssl_certificate a.crt; }":                "line 1: ssl_certificate and ssl_certificate_key must be set together",
+		"upstream u { server 10.0.0.1 max_fails=x; }":                      "line 1: invalid upstream server parameter max_fails=x",
+		"upstream u { least_conn; }":                                       "line 1: upstream without server directive",
+		"upstream u { server 10.0.0.1; }\nupstream u { server 10.0.0.2; }": "line 2: duplicate upstream u",
+		"server { listen 80; location / { return 301 a b c; } }":           "line 1: return requires a code",
+		"server { listen 80; location /a; }":                               "line 1: directive location requires a block",
+	} {
+		err := ValidateNginxConf([]byte(content))
+		ut.Assert(t, err != nil, "%s should be invalid", content)
+		ut.Assert(t, strings.Contains(err.Error(), msg), "unexpected error %s", err.Error())
+	}
+}
+
+func TestNginxCheckerConfSet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nginx")
+	ut.Assert(t, err == nil, "create temp dir should succeed")
+	defer os.RemoveAll(dir)
+
+	ut.Assert(t, ioutil.WriteFile(filepath.Join(dir, "a.com.conf"), []byte(nginxConf), 0644) == nil, "write a.com.conf should succeed")
+	checker := NewNginxChecker("", "")
+	ut.Assert(t, checker.Check(filepath.Join(dir, "a.com.conf"), []byte(nginxConf)) == nil, "rewrite the same conf should succeed")
+
+	err = checker.Check(filepath.Join(dir, "b.com.conf"), []byte(nginxConf))
+	ut.Assert(t, err != nil, "duplicate upstream across conf files should fail")
+	ut.Assert(t, strings.Contains(err.Error(), "duplicate upstream ddi_a_com_443_1 in a.com.conf"), "unexpected error %s", err.Error())
+
+	ut.Assert(t, checker.Check(filepath.Join(dir, "b.com.conf"), []byte("server { listen 80; }")) == nil,
+		"conf without conflicts should succeed")
+}
+
+func TestCheckConfDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "named")
+	ut.Assert(t, err == nil, "create temp dir should succeed")
+	defer os.RemoveAll(dir)
+
+	confDir := filepath.Join(dir, "etc")
+	ut.Assert(t, os.Mkdir(confDir, 0755) == nil, "create conf dir should succeed")
+	mainConf := filepath.Join(confDir, "named.conf")
+	ut.Assert(t, ioutil.WriteFile(mainConf, []byte("include \""+confDir+"/named_view.conf\";\n"), 0644) == nil,
+		"write named.conf should succeed")
+	ut.Assert(t, ioutil.WriteFile(filepath.Join(confDir, "named_view.conf"), []byte("old"), 0644) == nil,
+		"write named_view.conf should succeed")
+	ut.Assert(t, ioutil.WriteFile(filepath.Join(confDir, "named_acl.conf"), []byte("acl"), 0644) == nil,
+		"write named_acl.conf should succeed")
+
+	output := filepath.Join(dir, "output")
+	checkConf := filepath.Join(dir, "named-checkconf")
+	script := "#!/bin/sh\ncat $(sed -n 's/^include \"\\(.*\\)\";/\\1/p' \"$1\") > " + output + "\n" +
+		"ls $(dirname \"$1\") >> " + output + "\n"
+	ut.Assert(t, ioutil.WriteFile(checkConf, []byte(script), 0755) == nil, "write fake checker should succeed")
+
+	err = NewChecker(checkConf, "").CheckConfDir(confDir, "named.conf",
+		map[string][]byte{filepath.Join(confDir, "named_view.conf"): []byte("new\n")},
+		[]string{filepath.Join(confDir, "named_acl.conf")})
+	ut.Assert(t, err == nil, "check conf dir should succeed: %v", err)
+	content, _ := ioutil.ReadFile(output)
+	ut.Equal(t, string(content), "new\nnamed.conf\nnamed_view.conf\n")
+	data, _ := ioutil.ReadFile(filepath.Join(confDir, "named_view.conf"))
+	ut.Equal(t, string(data), "old")
+}
+
+func TestProbeTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	address := server.Listener.Addr().String()
+	ut.Assert(t, ProbeTLS(address, "example.com", caBundle, TLSProbeTimeout) == nil, "probe with trusted ca should succeed")
+
+	err := ProbeTLS(address, "dns.example.org", caBundle, TLSProbeTimeout)
+	ut.Assert(t, err != nil, "probe with mismatched server name should fail")
+	err = ProbeTLS(address, "example.com", nil, TLSProbeTimeout)
+	ut.Assert(t, err != nil, "probe with untrusted certificate should fail")
+	_, err = ParseCABundle([]byte("not a certificate"))
+	ut.Assert(t, err != nil, "invalid ca bundle should fail")
+}
@@ -0,0 +1,272 @@
+package validator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zdnscloud/g53"
+)
+
+type zoneLine struct {
+	line   int
+	text   string
+	fields []zoneField
+}
+
+type zoneField struct {
+	value string
+	start int
+	end   int
+}
+
+func ValidateZone(origin string, content []byte) error {
+	if _, err := g53.NameFromString(origin); err != nil {
+		return fmt.Errorf("invalid zone origin %s: %s", origin, err.Error())
+	}
+
+	lines, err := splitZoneLines(string(content))
+	if err != nil {
+		return err
+	}
+
+	hasOwner := false
+	for _, l := range lines {
+		if len(l.fields) == 0 {
+			continue
+		}
+
+		if strings.HasPrefix(l.fields[0].value, "$") {
+			if err := validateZoneDirective(l); err != nil {
+				return err
+			}
+			continue
+		}
+
+		fields := l.fields
+		if l.text[0] == ' ' || l.text[0] == '\t' {
+			if hasOwner == false {
+				return fmt.Errorf("line %d: record without owner name", l.line)
+			}
+		} else {
+			if owner := fields[0].value; owner != "@" {
+				if _, err := g53.NameFromString(owner); err != nil {
+					return fmt.Errorf("line %d: invalid owner name %s: %s", l.line, owner, err.Error())
+				}
+			}
+			hasOwner = true
+			fields = fields[1:]
+		}
+
+		if err := validateRR(l, fields); err != nil {
+			return fmt.Errorf("line %d: %s", l.line, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func splitZoneLines(content string) ([]zoneLine, error) {
+	var lines []zoneLine
+	var current strings.Builder
+	start, depth, quoted := 0, 0, false
+	line := 1
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case quoted:
+			if c == '\\' && i+1 < len(content) {
+				current.WriteByte(c)
+				i++
+				c = content[i]
+			} else if c == '"' {
+				quoted = false
+			}
+			current.WriteByte(c)
+			continue
+		case c == '"':
+			quoted = true
+		case c == ';':
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+			continue
+		case c == '(':
+			depth++
+			c = ' '
+		case c == ')':
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unexpected ')'", line)
+			}
+			depth--
+			c = ' '
+		}
+
+		if c == '\n' {
+			line++
+			if depth > 0 {
+				current.WriteByte(' ')
+				continue
+			}
+
+			lines = append(lines, newZoneLine(start, current.String()))
+			current.Reset()
+			start = line
+			continue
+		}
+
+		if current.Len() == 0 {
+			start = line
+		}
+		current.WriteByte(c)
+	}
+
+	if quoted {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", start)
+	}
+
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: missing ')'", start)
+	}
+
+	return append(lines, newZoneLine(start, current.String())), nil
+}
+
+func newZoneLine(line int, text string) zoneLine {
+	l := zoneLine{line: line, text: text}
+	for i := 0; i < len(text); {
+		if text[i] == ' ' || text[i] == '\t' || text[i] == '\r' {
+			i++
+			continue
+		}
+
+		start := i
+		quoted := false
+		for ; i < len(text); i++ {
+			if text[i] == '"' {
+				quoted = !quoted
+			} else if text[i] == '\\' && quoted {
+				i++
+			} else if quoted == false && (text[i] == ' ' || text[i] == '\t' || text[i] == '\r') {
+				break
+			}
+		}
+		if i > len(text) {
+			i = len(text)
+		}
+		l.fields = append(l.fields, zoneField{value: text[start:i], start: start, end: i})
+	}
+
+	return l
+}
+
+func validateZoneDirective(l zoneLine) error {
+	directive := strings.ToUpper(l.fields[0].value)
+	switch directive {
+	case "$TTL":
+		if len(l.fields) != 2 {
+			return fmt.Errorf("line %d: $TTL requires exactly one value", l.line)
+		}
+		if _, err := parseTTL(l.fields[1].value); err != nil {
+			return fmt.Errorf("line %d: %s", l.line, err.Error())
+		}
+	case "$ORIGIN":
+		if len(l.fields) != 2 {
+			return fmt.Errorf("line %d: $ORIGIN requires exactly one name", l.line)
+		}
+		if _, err := g53.NameFromString(l.fields[1].value); err != nil {
+			return fmt.Errorf("line %d: invalid origin %s: %s", l.line, l.fields[1].value, err.Error())
+		}
+	case "$INCLUDE":
+		if len(l.fields) < 2 {
+			return fmt.Errorf("line %d: $INCLUDE requires a file name", l.line)
+		}
+	default:
+		return fmt.Errorf("line %d: unknown directive %s", l.line, l.fields[0].value)
+	}
+
+	return nil
+}
+
+func validateRR(l zoneLine, fields []zoneField) error {
+	for len(fields) > 0 {
+		if _, err := parseTTL(fields[0].value); err == nil {
+			fields = fields[1:]
+		} else if _, err := g53.ClassFromString(fields[0].value); err == nil && isRRType(fields[0].value) == false {
+			fields = fields[1:]
+		} else {
+			break
+		}
+	}
+
+	if len(fields) == 0 {
+		return fmt.Errorf("missing record type")
+	}
+
+	rrType, err := g53.TypeFromString(fields[0].value)
+	if err != nil {
+		return fmt.Errorf("invalid record type %s", fields[0].value)
+	}
+
+	if len(fields) == 1 {
+		return fmt.Errorf("missing rdata for %s record", fields[0].value)
+	}
+
+	rdata := strings.TrimSpace(l.text[fields[1].start:])
+	if _, err := g53.RdataFromString(rrType, rdata); err != nil {
+		return fmt.Errorf("invalid %s rdata %s: %s", fields[0].value, rdata, err.Error())
+	}
+
+	return nil
+}
+
+func isRRType(s string) bool {
+	_, err := g53.TypeFromString(s)
+	return err == nil
+}
+
+func parseTTL(s string) (uint32, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty ttl")
+	}
+
+	if ttl, err := strconv.ParseUint(s, 10, 32); err == nil {
+		return uint32(ttl), nil
+	}
+
+	var total, value uint64
+	hasValue := false
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			value = value*10 + uint64(c-'0')
+			hasValue = true
+			continue
+		}
+
+		if hasValue == false {
+			return 0, fmt.Errorf("invalid ttl %s", s)
+		}
+
+		switch c {
+		case 'w':
+			total += value * 604800
+		case 'd':
+			total += value * 86400
+		case 'h':
+			total += value * 3600
+		case 'm':
+			total += value * 60
+		case 's':
+			total += value
+		default:
+			return 0, fmt.Errorf("invalid ttl %s", s)
+		}
+		value, hasValue = 0, false
+	}
+
+	if hasValue || total > 0xffffffff {
+		return 0, fmt.Errorf("invalid ttl %s", s)
+	}
+
+	return uint32(total), nil
+}
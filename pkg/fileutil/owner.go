@@ -0,0 +1,31 @@
+package fileutil
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+)
+
+func ChownToUser(path, username string) error {
+	u, err := user.Lookup(username)
+	if err != nil {
+		return fmt.Errorf("lookup user %s failed: %s", username, err.Error())
+	}
+
+	uid, err := strconv.Atoi(u.Uid)
+	if err != nil {
+		return fmt.Errorf("invalid uid %s of user %s", u.Uid, username)
+	}
+
+	gid, err := strconv.Atoi(u.Gid)
+	if err != nil {
+		return fmt.Errorf("invalid gid %s of user %s", u.Gid, username)
+	}
+
+	if err := os.Chown(path, uid, gid); err != nil {
+		return fmt.Errorf("chown %s to %s failed: %s", path, username, err.Error())
+	}
+
+	return nil
+}
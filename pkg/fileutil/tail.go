@@ -0,0 +1,97 @@
+package fileutil
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/zdnscloud/cement/log"
+)
+
+const tailCheckPeriod = time.Second
+
+func Tail(path string, handle func(line string), quit <-chan struct{}) {
+	var file *os.File
+	var reader *bufio.Reader
+	var offset int64
+	defer func() {
+		if file != nil {
+			file.Close()
+		}
+	}()
+
+	for {
+		if file == nil {
+			f, err := os.Open(path)
+			if err != nil {
+				if sleepOrQuit(quit) {
+					return
+				}
+				continue
+			}
+
+			if reader == nil {
+				if offset, err = f.Seek(0, io.SeekEnd); err != nil {
+					f.Close()
+					if sleepOrQuit(quit) {
+						return
+					}
+					continue
+				}
+			} else {
+				offset = 0
+			}
+			file = f
+			reader = bufio.NewReader(file)
+		}
+
+		line, err := reader.ReadString('\n')
+		if err == nil {
+			offset += int64(len(line))
+			handle(strings.TrimSpace(line))
+			continue
+		} else if err != io.EOF {
+			log.Warnf("read file %s failed: %s", path, err.Error())
+		}
+
+		if len(line) != 0 {
+			if _, err := file.Seek(offset, io.SeekStart); err == nil {
+				reader.Reset(file)
+			}
+		}
+
+		if sleepOrQuit(quit) {
+			return
+		}
+
+		if isReplaced(path, file, offset) {
+			file.Close()
+			file = nil
+		}
+	}
+}
+
+func sleepOrQuit(quit <-chan struct{}) bool {
+	select {
+	case <-quit:
+		return true
+	case <-time.After(tailCheckPeriod):
+		return false
+	}
+}
+
+func isReplaced(path string, file *os.File, offset int64) bool {
+	current, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	opened, err := file.Stat()
+	if err != nil {
+		return true
+	}
+
+	return os.SameFile(current, opened) == false || current.Size() < offset
+}
@@ -1,8 +1,11 @@
 package grpcclient
 
 import (
+	"context"
+
 	"google.golang.org/grpc"
 
+	"github.com/linkingthing/ddi-agent/pkg/health"
 	monitorpb "github.com/linkingthing/ddi-monitor/pkg/proto"
 )
 
@@ -14,8 +17,14 @@ var grpcClient *GrpcClient
 
 func New(conn *grpc.ClientConn) {
 	grpcClient = &GrpcClient{monitorClient: monitorpb.NewDDIMonitorClient(conn)}
+	health.Register(health.ComponentMonitor, checkMonitor)
 }
 
 func GetDDIMonitorGrpcClient() monitorpb.DDIMonitorClient {
 	return grpcClient.monitorClient
 }
+
+func checkMonitor(ctx context.Context) error {
+	_, err := grpcClient.monitorClient.GetDNSState(ctx, &monitorpb.GetDNSStateRequest{})
+	return err
+}
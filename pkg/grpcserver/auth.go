@@ -0,0 +1,396 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zdnscloud/cement/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/agentmetric"
+	"github.com/linkingthing/ddi-agent/pkg/audit"
+)
+
+const (
+	AuthorizationHeader = "authorization"
+	BearerPrefix        = "Bearer "
+	TokenAlgorithm      = "HS256"
+	TokenType           = "JWT"
+	DefaultRoleClaim    = "roles"
+	DefaultLocalRole    = "admin"
+	LocalSubject        = audit.LocalCaller
+	LocalTokenTTL       = 5 * time.Minute
+	TokenLeeway         = 30 * time.Second
+	MethodWildcard      = "*"
+	HealthMethodPrefix  = "/grpc.health.v1.Health/"
+
+	DenyReasonMissingToken = "missing_token"
+	DenyReasonInvalidToken = "invalid_token"
+	DenyReasonForbidden    = "forbidden"
+)
+
+type tokenHeader struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ,omitempty"`
+}
+
+type tokenClaims struct {
+	Subject   string
+	Issuer    string
+	Audiences []string
+	ExpiresAt int64
+	NotBefore int64
+	Roles     []string
+}
+
+type Authenticator struct {
+	key       []byte
+	issuer    string
+	audience  string
+	roleClaim string
+	policies  map[string][]string
+}
+
+func NewAuthenticator(conf config.AuthConf) (*Authenticator, error) {
+	key, err := loadAuthKey(conf.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	roleClaim := conf.RoleClaim
+	if roleClaim == "" {
+		roleClaim = DefaultRoleClaim
+	}
+
+	policies := make(map[string][]string)
+	for _, policy := range conf.Policies {
+		if policy.Role == "" {
+			return nil, fmt.Errorf("auth policy role should not be empty")
+		}
+
+		for _, method := range policy.Methods {
+			if method != MethodWildcard {
+				if _, err := path.Match(method, ""); err != nil {
+					return nil, fmt.Errorf("invalid auth policy method %s for role %s: %s", method, policy.Role, err.Error())
+				}
+			}
+		}
+		policies[policy.Role] = append(policies[policy.Role], policy.Methods...)
+	}
+
+	if len(policies) == 0 {
+		policies[DefaultLocalRole] = []string{MethodWildcard}
+	}
+
+	return &Authenticator{
+		key:       key,
+		issuer:    conf.Issuer,
+		audience:  conf.Audience,
+		roleClaim: roleClaim,
+		policies:  policies,
+	}, nil
+}
+
+func loadAuthKey(keyFile string) ([]byte, error) {
+	if keyFile == "" {
+		return nil, fmt.Errorf("auth key_file should not be empty")
+	}
+
+	data, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("read auth key file %s failed: %s", keyFile, err.Error())
+	}
+
+	key := []byte(strings.TrimSpace(string(data)))
+	if len(key) < sha256.Size {
+		return nil, fmt.Errorf("auth key in %s should be at least %d bytes", keyFile, sha256.Size)
+	}
+
+	return key, nil
+}
+
+func (a *Authenticator) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if strings.HasPrefix(info.FullMethod, HealthMethodPrefix) {
+		return handler(ctx, req)
+	}
+
+	token, err := getBearerToken(ctx)
+	if err != nil {
+		return nil, a.deny(ctx, req, info.FullMethod, "", DenyReasonMissingToken, codes.Unauthenticated, err)
+	}
+
+	claims, err := a.verifyToken(token, time.Now())
+	if err != nil {
+		return nil, a.deny(ctx, req, info.FullMethod, "", DenyReasonInvalidToken, codes.Unauthenticated, err)
+	}
+
+	if a.isAllowed(claims.Roles, info.FullMethod) == false {
+		return nil, a.deny(ctx, req, info.FullMethod, claims.Subject, DenyReasonForbidden, codes.PermissionDenied,
+			fmt.Errorf("roles %v are not allowed to call %s", claims.Roles, info.FullMethod))
+	}
+
+	return handler(audit.WithCaller(ctx, claims.Subject), req)
+}
+
+func (a *Authenticator) deny(ctx context.Context, req interface{}, method, subject, reason string, code codes.Code, err error) error {
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+
+	agentmetric.GRPCAuthDenied.WithLabelValues(method, reason).Inc()
+	log.Warnf("grpc call %s from %s subject %s denied: %s", method, addr, subject, err.Error())
+	audit.RecordDenied(ctx, method, req, subject, err)
+	return status.Error(code, err.Error())
+}
+
+func getBearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok == false {
+		return "", fmt.Errorf("no metadata in request")
+	}
+
+	values := md.Get(AuthorizationHeader)
+	if len(values) == 0 {
+		return "", fmt.Errorf("no authorization header in request")
+	}
+
+	if strings.HasPrefix(values[0], BearerPrefix) == false {
+		return "", fmt.Errorf("authorization header is not a bearer token")
+	}
+
+	return strings.TrimSpace(strings.TrimPrefix(values[0], BearerPrefix)), nil
+}
+
+func (a *Authenticator) isAllowed(roles []string, method string) bool {
+	for _, role := range roles {
+		for _, pattern := range a.policies[role] {
+			if pattern == MethodWildcard {
+				return true
+			}
+
+			if matched, _ := path.Match(pattern, method); matched {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (a *Authenticator) verifyToken(token string, now time.Time) (*tokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("token should have 3 parts but get %d", len(parts))
+	}
+
+	var header tokenHeader
+	if err := decodeTokenPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("decode token header failed: %s", err.Error())
+	}
+
+	if header.Algorithm != TokenAlgorithm {
+		return nil, fmt.Errorf("unsupported token algorithm %s", header.Algorithm)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("decode token signature failed: %s", err.Error())
+	}
+
+	if hmac.Equal(signature, a.sign(parts[0]+"."+parts[1])) == false {
+		return nil, fmt.Errorf("token signature mismatch")
+	}
+
+	var rawClaims map[string]json.RawMessage
+	if err := decodeTokenPart(parts[1], &rawClaims); err != nil {
+		return nil, fmt.Errorf("decode token claims failed: %s", err.Error())
+	}
+
+	claims, err := a.parseClaims(rawClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.ExpiresAt == 0 {
+		return nil, fmt.Errorf("token has no expiration")
+	}
+
+	if now.Add(-TokenLeeway).Unix() >= claims.ExpiresAt {
+		return nil, fmt.Errorf("token expired at %s", time.Unix(claims.ExpiresAt, 0).Format(time.RFC3339))
+	}
+
+	if claims.NotBefore != 0 && now.Add(TokenLeeway).Unix() < claims.NotBefore {
+		return nil, fmt.Errorf("token not valid before %s", time.Unix(claims.NotBefore, 0).Format(time.RFC3339))
+	}
+
+	if a.issuer != "" && claims.Issuer != a.issuer {
+		return nil, fmt.Errorf("token issuer %s mismatch", claims.Issuer)
+	}
+
+	if a.audience != "" && containsString(claims.Audiences, a.audience) == false {
+		return nil, fmt.Errorf("token audience %v mismatch", claims.Audiences)
+	}
+
+	return claims, nil
+}
+
+func (a *Authenticator) parseClaims(rawClaims map[string]json.RawMessage) (*tokenClaims, error) {
+	claims := &tokenClaims{}
+	for name, target := range map[string]interface{}{
+		"sub": &claims.Subject,
+		"iss": &claims.Issuer,
+		"exp": &claims.ExpiresAt,
+		"nbf": &claims.NotBefore,
+	} {
+		if raw, ok := rawClaims[name]; ok {
+			if err := json.Unmarshal(raw, target); err != nil {
+				return nil, fmt.Errorf("parse token claim %s failed: %s", name, err.Error())
+			}
+		}
+	}
+
+	audiences, err := parseStringOrList(rawClaims["aud"])
+	if err != nil {
+		return nil, fmt.Errorf("parse token claim aud failed: %s", err.Error())
+	}
+
+	roles, err := parseStringOrList(rawClaims[a.roleClaim])
+	if err != nil {
+		return nil, fmt.Errorf("parse token claim %s failed: %s", a.roleClaim, err.Error())
+	}
+
+	claims.Audiences = audiences
+	claims.Roles = roles
+	return claims, nil
+}
+
+func parseStringOrList(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return []string{value}, nil
+	}
+
+	var values []string
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+func decodeTokenPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+func (a *Authenticator) sign(signingInput string) []byte {
+	mac := hmac.New(sha256.New, a.key)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
+
+func (a *Authenticator) SignToken(subject string, roles []string, ttl time.Duration) (string, error) {
+	header, err := json.Marshal(tokenHeader{Algorithm: TokenAlgorithm, Type: TokenType})
+	if err != nil {
+		return "", fmt.Errorf("marshal token header failed: %s", err.Error())
+	}
+
+	now := time.Now()
+	claims := map[string]interface{}{
+		"sub":       subject,
+		"iat":       now.Unix(),
+		"exp":       now.Add(ttl).Unix(),
+		a.roleClaim: roles,
+	}
+	if a.issuer != "" {
+		claims["iss"] = a.issuer
+	}
+	if a.audience != "" {
+		claims["aud"] = a.audience
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("marshal token claims failed: %s", err.Error())
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(a.sign(signingInput)), nil
+}
+
+type localTokenCredentials struct {
+	authenticator *Authenticator
+	role          string
+	lock          sync.Mutex
+	token         string
+	expiresAt     time.Time
+}
+
+func NewLocalTokenCredentials(conf config.AuthConf) (credentials.PerRPCCredentials, error) {
+	authenticator, err := NewAuthenticator(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	role := conf.LocalRole
+	if role == "" {
+		role = DefaultLocalRole
+	}
+
+	return &localTokenCredentials{authenticator: authenticator, role: role}, nil
+}
+
+func (c *localTokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if time.Until(c.expiresAt) < LocalTokenTTL/5 {
+		token, err := c.authenticator.SignToken(LocalSubject, []string{c.role}, LocalTokenTTL)
+		if err != nil {
+			return nil, err
+		}
+
+		c.token = token
+		c.expiresAt = time.Now().Add(LocalTokenTTL)
+	}
+
+	return map[string]string{AuthorizationHeader: BearerPrefix + c.token}, nil
+}
+
+func (c *localTokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
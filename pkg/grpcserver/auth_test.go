@@ -0,0 +1,73 @@
+package grpcserver
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zdnscloud/cement/log"
+	ut "github.com/zdnscloud/cement/unittest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/linkingthing/ddi-agent/config"
+)
+
+func newTestAuthenticator(t *testing.T) *Authenticator {
+	keyFile, err := ioutil.TempFile("", "auth.key")
+	ut.Assert(t, err == nil, "create key file failed")
+	defer os.Remove(keyFile.Name())
+	keyFile.WriteString(strings.Repeat("k", 32) + "\n")
+	keyFile.Close()
+
+	authenticator, err := NewAuthenticator(config.AuthConf{
+		KeyFile:  keyFile.Name(),
+		Issuer:   "ddi-controller",
+		Audience: "ddi-agent",
+		Policies: []config.AuthPolicy{
+			{Role: "admin", Methods: []string{"*"}},
+			{Role: "viewer", Methods: []string{"/proto.DHCPManager/Get*"}},
+		},
+	})
+	ut.Assert(t, err == nil, "new authenticator failed")
+	return authenticator
+}
+
+func callWithToken(a *Authenticator, method, token string) error {
+	ctx := context.Background()
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(AuthorizationHeader, BearerPrefix+token))
+	}
+
+	_, err := a.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+		func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+	return err
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	log.InitLogger(log.Error)
+	defer log.CloseLogger()
+	a := newTestAuthenticator(t)
+	viewerToken, err := a.SignToken("ops", []string{"viewer"}, time.Minute)
+	ut.Assert(t, err == nil, "sign token failed")
+
+	ut.Assert(t, callWithToken(a, "/proto.DHCPManager/GetSubnet4Leases", viewerToken) == nil, "viewer should read leases")
+	ut.Equal(t, status.Code(callWithToken(a, "/proto.DHCPManager/CreateSubnet4", viewerToken)), codes.PermissionDenied)
+	ut.Equal(t, status.Code(callWithToken(a, "/proto.DHCPManager/CreateSubnet4", "")), codes.Unauthenticated)
+	ut.Assert(t, callWithToken(a, HealthMethodPrefix+"Check", "") == nil, "health check should skip auth")
+
+	adminToken, _ := a.SignToken("ops", []string{"admin"}, time.Minute)
+	ut.Assert(t, callWithToken(a, "/proto.AgentManager/CreateView", adminToken) == nil, "admin should call all methods")
+	ut.Equal(t, status.Code(callWithToken(a, "/proto.AgentManager/CreateView", adminToken+"x")), codes.Unauthenticated)
+
+	expiredToken, _ := a.SignToken("ops", []string{"admin"}, -time.Hour)
+	ut.Equal(t, status.Code(callWithToken(a, "/proto.AgentManager/CreateView", expiredToken)), codes.Unauthenticated)
+
+	a.issuer = "other-controller"
+	ut.Equal(t, status.Code(callWithToken(a, "/proto.AgentManager/CreateView", adminToken)), codes.Unauthenticated)
+}
@@ -1,32 +1,70 @@
 package grpcserver
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/agentmetric"
+	"github.com/linkingthing/ddi-agent/pkg/audit"
 	dhcpsrv "github.com/linkingthing/ddi-agent/pkg/dhcp/grpcservice"
 	dnssrv "github.com/linkingthing/ddi-agent/pkg/dns/grpcservice"
+	"github.com/linkingthing/ddi-agent/pkg/health"
 	"github.com/linkingthing/ddi-agent/pkg/proto"
+	"github.com/linkingthing/ddi-agent/pkg/tlsconfig"
+)
+
+const (
+	HealthCheckInterval     = 10 * time.Second
+	AgentManagerServiceName = "proto.AgentManager"
+	DHCPManagerServiceName  = "proto.DHCPManager"
+	AuditManagerServiceName = "proto.AuditManager"
 )
 
 type GRPCServer struct {
-	server   *grpc.Server
-	listener net.Listener
+	server       *grpc.Server
+	listener     net.Listener
+	healthServer *grpchealth.Server
+	dnsService   *dnssrv.DNSService
+	dhcpService  *dhcpsrv.DHCPService
+	services     []string
+	quit         chan struct{}
 }
 
 func New(conf *config.AgentConfig) (*GRPCServer, error) {
+	tlsOpts, err := tlsconfig.ServerOptions(conf.Server.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("create grpc server tls config failed: %s", err.Error())
+	}
+
+	interceptors := []grpc.UnaryServerInterceptor{agentmetric.GRPCServerInterceptor}
+	if conf.Server.Auth.Enabled {
+		authenticator, err := NewAuthenticator(conf.Server.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("create grpc server authenticator failed: %s", err.Error())
+		}
+		interceptors = append(interceptors, authenticator.UnaryInterceptor)
+	}
+	interceptors = append(interceptors, audit.UnaryServerInterceptor)
+
 	listener, err := net.Listen("tcp", conf.Server.GrpcAddr)
 	if err != nil {
 		return nil, fmt.Errorf("create listener with addr %s failed: %s", conf.Server.GrpcAddr, err.Error())
 	}
 
 	grpcServer := &GRPCServer{
-		server:   grpc.NewServer(),
-		listener: listener,
+		server:       grpc.NewServer(append(tlsOpts, grpc.ChainUnaryInterceptor(interceptors...))...),
+		listener:     listener,
+		healthServer: grpchealth.NewServer(),
+		quit:         make(chan struct{}),
 	}
+	healthpb.RegisterHealthServer(grpcServer.server, grpcServer.healthServer)
 
 	if conf.DNS.Enabled {
 		dnsService, err := dnssrv.New(conf)
@@ -34,6 +72,8 @@ func New(conf *config.AgentConfig) (*GRPCServer, error) {
 			return nil, fmt.Errorf("create dns grpc service failed: %s", err.Error())
 		}
 		proto.RegisterAgentManagerServer(grpcServer.server, dnsService)
+		grpcServer.dnsService = dnsService
+		grpcServer.services = append(grpcServer.services, AgentManagerServiceName)
 	}
 
 	if conf.DHCP.Enabled {
@@ -42,16 +82,83 @@ func New(conf *config.AgentConfig) (*GRPCServer, error) {
 			return nil, fmt.Errorf("create dhcp grpc service failed: %s", err.Error())
 		}
 		proto.RegisterDHCPManagerServer(grpcServer.server, dhcpService)
+		grpcServer.dhcpService = dhcpService
+		grpcServer.services = append(grpcServer.services, DHCPManagerServiceName)
+	}
+
+	if audit.IsEnabled() {
+		proto.RegisterAuditManagerServer(grpcServer.server, audit.NewService())
+		grpcServer.services = append(grpcServer.services, AuditManagerServiceName)
 	}
 
 	return grpcServer, nil
 }
 
+func (s *GRPCServer) Reload(conf *config.AgentConfig) error {
+	if s.dhcpService != nil {
+		return s.dhcpService.Reload(conf)
+	}
+
+	return nil
+}
+
 func (s *GRPCServer) Run() error {
+	go s.watchHealth()
 	return s.server.Serve(s.listener)
 }
 
-func (s *GRPCServer) Stop() error {
-	s.server.GracefulStop()
-	return nil
+func (s *GRPCServer) watchHealth() {
+	ticker := time.NewTicker(HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		s.updateHealth()
+		select {
+		case <-ticker.C:
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+func (s *GRPCServer) updateHealth() {
+	servingStatus := healthpb.HealthCheckResponse_SERVING
+	if health.Check(context.Background()).IsUp() == false {
+		servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	s.healthServer.SetServingStatus("", servingStatus)
+	for _, service := range s.services {
+		s.healthServer.SetServingStatus(service, servingStatus)
+	}
+}
+
+func (s *GRPCServer) Stop(ctx context.Context) error {
+	close(s.quit)
+	s.healthServer.Shutdown()
+
+	var err error
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.server.Stop()
+		err = fmt.Errorf("graceful stop grpc server timeout, force stopped")
+	}
+
+	if s.dnsService != nil {
+		if closeErr := s.dnsService.Close(ctx); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+
+	if s.dhcpService != nil {
+		s.dhcpService.Close()
+	}
+
+	return err
 }
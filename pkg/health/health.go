@@ -0,0 +1,107 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+
+	ComponentPostgresql     = "postgresql"
+	ComponentDHCPPostgresql = "dhcp_postgresql"
+	ComponentMonitor        = "ddi_monitor"
+	ComponentKafka          = "kafka"
+	ComponentNamed          = "named"
+	ComponentKea            = "kea_ctrl_agent"
+
+	CheckTimeout = 5 * time.Second
+)
+
+type CheckFunc func(context.Context) error
+
+type ComponentStatus struct {
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+	Latency string `json:"latency"`
+}
+
+type Report struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentStatus `json:"components"`
+}
+
+type registry struct {
+	lock   sync.RWMutex
+	checks map[string]CheckFunc
+}
+
+var globalRegistry = &registry{checks: make(map[string]CheckFunc)}
+
+func Register(name string, check CheckFunc) {
+	globalRegistry.lock.Lock()
+	globalRegistry.checks[name] = check
+	globalRegistry.lock.Unlock()
+}
+
+func Check(ctx context.Context) *Report {
+	globalRegistry.lock.RLock()
+	checks := make(map[string]CheckFunc, len(globalRegistry.checks))
+	for name, check := range globalRegistry.checks {
+		checks[name] = check
+	}
+	globalRegistry.lock.RUnlock()
+
+	report := &Report{Status: StatusUp, Components: make(map[string]ComponentStatus, len(checks))}
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check CheckFunc) {
+			defer wg.Done()
+			status := runCheck(ctx, check)
+			lock.Lock()
+			report.Components[name] = status
+			if status.Status != StatusUp {
+				report.Status = StatusDown
+			}
+			lock.Unlock()
+		}(name, check)
+	}
+	wg.Wait()
+
+	return report
+}
+
+func runCheck(ctx context.Context, check CheckFunc) ComponentStatus {
+	ctx, cancel := context.WithTimeout(ctx, CheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	result := make(chan error, 1)
+	go func() {
+		result <- check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-result:
+	case <-ctx.Done():
+		err = fmt.Errorf("check timeout after %s", CheckTimeout)
+	}
+
+	status := ComponentStatus{Status: StatusUp, Latency: time.Since(start).String()}
+	if err != nil {
+		status.Status = StatusDown
+		status.Error = err.Error()
+	}
+
+	return status
+}
+
+func (r *Report) IsUp() bool {
+	return r.Status == StatusUp
+}
@@ -0,0 +1,23 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+func TestCheck(t *testing.T) {
+	Register("good", func(context.Context) error { return nil })
+	report := Check(context.Background())
+	ut.Assert(t, report.IsUp(), "report should be up")
+	ut.Equal(t, report.Components["good"].Status, StatusUp)
+
+	Register("bad", func(context.Context) error { return fmt.Errorf("connection refused") })
+	report = Check(context.Background())
+	ut.Equal(t, report.IsUp(), false)
+	ut.Equal(t, report.Components["good"].Status, StatusUp)
+	ut.Equal(t, report.Components["bad"].Status, StatusDown)
+	ut.Equal(t, report.Components["bad"].Error, "connection refused")
+}
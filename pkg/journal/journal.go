@@ -0,0 +1,136 @@
+package journal
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/linkingthing/ddi-agent/pkg/fileutil"
+)
+
+type Action struct {
+	Name string
+	Do   func() error
+}
+
+type fileSnapshot struct {
+	path    string
+	existed bool
+	mode    os.FileMode
+	data    []byte
+}
+
+type Journal struct {
+	files   []*fileSnapshot
+	undos   []Action
+	reloads []Action
+}
+
+func New() *Journal {
+	return &Journal{}
+}
+
+func (j *Journal) Snapshot(path string) error {
+	if j == nil {
+		return nil
+	}
+
+	for _, file := range j.files {
+		if file.path == path {
+			return nil
+		}
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			j.files = append(j.files, &fileSnapshot{path: path})
+			return nil
+		}
+		return fmt.Errorf("stat file %s failed: %s", path, err.Error())
+	}
+
+	if info.IsDir() {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read file %s failed: %s", path, err.Error())
+	}
+
+	j.files = append(j.files, &fileSnapshot{path: path, existed: true, mode: info.Mode(), data: data})
+	return nil
+}
+
+func (j *Journal) Undo(name string, fn func() error) {
+	if j != nil {
+		j.undos = append(j.undos, Action{Name: name, Do: fn})
+	}
+}
+
+func (j *Journal) Reload(name string, fn func() error) {
+	if j == nil {
+		return
+	}
+
+	for _, reload := range j.reloads {
+		if reload.Name == name {
+			return
+		}
+	}
+
+	j.reloads = append(j.reloads, Action{Name: name, Do: fn})
+}
+
+func (j *Journal) Files() []string {
+	var files []string
+	for _, file := range j.files {
+		files = append(files, file.path)
+	}
+
+	return files
+}
+
+func (j *Journal) Rollback() error {
+	var errs []string
+	for i := len(j.undos) - 1; i >= 0; i-- {
+		if err := j.undos[i].Do(); err != nil {
+			errs = append(errs, fmt.Sprintf("undo %s failed: %s", j.undos[i].Name, err.Error()))
+		}
+	}
+
+	for i := len(j.files) - 1; i >= 0; i-- {
+		if err := j.files[i].restore(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	for _, reload := range j.reloads {
+		if err := reload.Do(); err != nil {
+			errs = append(errs, fmt.Sprintf("reload %s failed: %s", reload.Name, err.Error()))
+		}
+	}
+
+	if len(errs) != 0 {
+		return fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+func (s *fileSnapshot) restore() error {
+	if s.existed == false {
+		if err := os.Remove(s.path); err != nil && os.IsNotExist(err) == false {
+			return fmt.Errorf("remove file %s failed: %s", s.path, err.Error())
+		}
+		return nil
+	}
+
+	if err := fileutil.WriteFileAtomic(s.path, s.data, s.mode); err != nil {
+		return fmt.Errorf("restore file %s failed: %s", s.path, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package journal
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+func TestRollback(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journal")
+	ut.Assert(t, err == nil, "create temp dir failed")
+	defer os.RemoveAll(dir)
+
+	existed := filepath.Join(dir, "named_view.conf")
+	created := filepath.Join(dir, "example.com.zone")
+	ioutil.WriteFile(existed, []byte("old"), 0644)
+
+	j := New()
+	ut.Assert(t, j.Snapshot(existed) == nil, "snapshot existed file failed")
+	ut.Assert(t, j.Snapshot(created) == nil, "snapshot missing file failed")
+	ioutil.WriteFile(existed, []byte("new"), 0644)
+	ioutil.WriteFile(created, []byte("zone"), 0644)
+	ut.Assert(t, j.Snapshot(existed) == nil, "snapshot twice failed")
+
+	var actions []string
+	j.Undo("add zone", func() error { actions = append(actions, "delete zone"); return nil })
+	j.Undo("add rrset", func() error { actions = append(actions, "delete rrset"); return nil })
+	j.Reload("named", func() error { actions = append(actions, "reload named"); return nil })
+	j.Reload("named", func() error { actions = append(actions, "reload named again"); return nil })
+
+	ut.Assert(t, j.Rollback() == nil, "rollback failed")
+	data, _ := ioutil.ReadFile(existed)
+	ut.Equal(t, string(data), "old")
+	_, err = os.Stat(created)
+	ut.Assert(t, os.IsNotExist(err), "created file should be removed")
+	ut.Equal(t, actions, []string{"delete rrset", "delete zone", "reload named"})
+
+	var nilJournal *Journal
+	ut.Assert(t, nilJournal.Snapshot(existed) == nil, "nil journal should ignore snapshot")
+	nilJournal.Undo("noop", nil)
+	nilJournal.Reload("noop", nil)
+}
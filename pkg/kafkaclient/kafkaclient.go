@@ -0,0 +1,105 @@
+package kafkaclient
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	kg "github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/tlsconfig"
+)
+
+const (
+	DefaultClientID         = "ddi-agent"
+	DefaultDNSTopic         = "dns"
+	DefaultDHCPTopic        = "dhcp"
+	DefaultAgentEventTopic  = "AgentEventTopic"
+	DefaultUploadLogTopic   = "UploadLogTopic"
+	DefaultDNSSecurityTopic = "DNSSecurityTopic"
+
+	MechanismPlain       = "plain"
+	MechanismScramSHA256 = "scram-sha-256"
+	MechanismScramSHA512 = "scram-sha-512"
+
+	DialTimeout = 10 * time.Second
+)
+
+func NewDialer(conf *config.KafkaConf) (*kg.Dialer, error) {
+	dialer := &kg.Dialer{
+		ClientID:  getClientID(conf),
+		Timeout:   DialTimeout,
+		DualStack: true,
+	}
+
+	if conf.TLS.Enabled {
+		tlsConfig, err := tlsconfig.NewClientTLSConfig(conf.TLS, tlsconfig.DialHosts(conf.Addr...)...)
+		if err != nil {
+			return nil, fmt.Errorf("create kafka tls config failed: %s", err.Error())
+		}
+		dialer.TLS = tlsConfig
+	}
+
+	if conf.SASL.Enabled {
+		mechanism, err := newSASLMechanism(&conf.SASL)
+		if err != nil {
+			return nil, fmt.Errorf("create kafka sasl mechanism failed: %s", err.Error())
+		}
+		dialer.SASLMechanism = mechanism
+	}
+
+	return dialer, nil
+}
+
+func newSASLMechanism(conf *config.KafkaSASLConf) (sasl.Mechanism, error) {
+	if conf.Username == "" {
+		return nil, fmt.Errorf("sasl username should not be empty")
+	}
+
+	switch strings.ToLower(conf.Mechanism) {
+	case MechanismPlain:
+		return plain.Mechanism{Username: conf.Username, Password: conf.Password}, nil
+	case MechanismScramSHA256:
+		return scram.Mechanism(scram.SHA256, conf.Username, conf.Password)
+	case MechanismScramSHA512:
+		return scram.Mechanism(scram.SHA512, conf.Username, conf.Password)
+	default:
+		return nil, fmt.Errorf("unsupported sasl mechanism %s", conf.Mechanism)
+	}
+}
+
+func getClientID(conf *config.KafkaConf) string {
+	return getOrDefault(conf.ClientID, DefaultClientID)
+}
+
+func DNSTopic(conf *config.KafkaConf) string {
+	return getOrDefault(conf.Topics.DNS, DefaultDNSTopic)
+}
+
+func DHCPTopic(conf *config.KafkaConf) string {
+	return getOrDefault(conf.Topics.DHCP, DefaultDHCPTopic)
+}
+
+func AgentEventTopic(conf *config.KafkaConf) string {
+	return getOrDefault(conf.Topics.AgentEvent, DefaultAgentEventTopic)
+}
+
+func UploadLogTopic(conf *config.KafkaConf) string {
+	return getOrDefault(conf.Topics.UploadLog, DefaultUploadLogTopic)
+}
+
+func DNSSecurityTopic(conf *config.KafkaConf) string {
+	return getOrDefault(conf.Topics.DNSSecurity, DefaultDNSSecurityTopic)
+}
+
+func getOrDefault(value, defaultValue string) string {
+	if value != "" {
+		return value
+	}
+
+	return defaultValue
+}
@@ -5,21 +5,26 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	kg "github.com/segmentio/kafka-go"
+	"github.com/zdnscloud/cement/log"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/health"
+	"github.com/linkingthing/ddi-agent/pkg/kafkaclient"
 	pb "github.com/linkingthing/ddi-agent/pkg/proto"
 )
 
 const (
-	AgentEventTopic = "AgentEventTopic"
-	UploadLogTopic  = "UploadLogTopic"
-	AgentEvent      = "AgentEvent"
-	UploadLogEvent  = "UploadLogEvent"
+	AgentEvent         = "AgentEvent"
+	UploadLogEvent     = "UploadLogEvent"
+	DNSSecurityEvent   = "DNSSecurityEvent"
+	CertificateEvent   = "CertificateEvent"
+	DNSZoneHealthEvent = "DNSZoneHealthEvent"
 )
 
 const (
@@ -27,29 +32,125 @@ const (
 )
 
 type KafkaProducer struct {
-	agentWriter  *kg.Writer
-	uploadWriter *kg.Writer
+	lock           sync.RWMutex
+	closed         bool
+	brokers        []string
+	dialer         *kg.Dialer
+	agentWriter    *kg.Writer
+	uploadWriter   *kg.Writer
+	securityWriter *kg.Writer
 }
 
 var globalKafkaProducer *KafkaProducer
+var globalLock sync.RWMutex
 
 func GetKafkaProducer() *KafkaProducer {
+	globalLock.RLock()
+	defer globalLock.RUnlock()
 	return globalKafkaProducer
 }
 
-func Init(conf *config.AgentConfig) {
-	globalKafkaProducer = &KafkaProducer{
-		agentWriter: kg.NewWriter(kg.WriterConfig{
-			Brokers:   conf.Kafka.Addr,
-			Topic:     AgentEventTopic,
-			BatchSize: 1,
-		}),
-		uploadWriter: kg.NewWriter(kg.WriterConfig{
-			Brokers:   conf.Kafka.Addr,
-			Topic:     UploadLogTopic,
-			BatchSize: 1,
-		}),
+func Init(conf *config.AgentConfig) error {
+	producer, err := newKafkaProducer(conf)
+	if err != nil {
+		return err
+	}
+
+	globalLock.Lock()
+	globalKafkaProducer = producer
+	globalLock.Unlock()
+
+	health.Register(health.ComponentKafka, func(ctx context.Context) error {
+		return GetKafkaProducer().checkBrokers(ctx)
+	})
+	return nil
+}
+
+func Reload(conf *config.AgentConfig) error {
+	producer, err := newKafkaProducer(conf)
+	if err != nil {
+		return err
+	}
+
+	globalLock.Lock()
+	oldProducer := globalKafkaProducer
+	globalKafkaProducer = producer
+	globalLock.Unlock()
+
+	if oldProducer != nil {
+		oldProducer.close()
+	}
+	return nil
+}
+
+func Close() {
+	if producer := GetKafkaProducer(); producer != nil {
+		producer.close()
+	}
+}
+
+func newKafkaProducer(conf *config.AgentConfig) (*KafkaProducer, error) {
+	dialer, err := kafkaclient.NewDialer(&conf.Kafka)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KafkaProducer{
+		brokers:        conf.Kafka.Addr,
+		dialer:         dialer,
+		agentWriter:    newWriter(conf, dialer, kafkaclient.AgentEventTopic(&conf.Kafka)),
+		uploadWriter:   newWriter(conf, dialer, kafkaclient.UploadLogTopic(&conf.Kafka)),
+		securityWriter: newWriter(conf, dialer, kafkaclient.DNSSecurityTopic(&conf.Kafka)),
+	}, nil
+}
+
+func newWriter(conf *config.AgentConfig, dialer *kg.Dialer, topic string) *kg.Writer {
+	return kg.NewWriter(kg.WriterConfig{
+		Brokers:   conf.Kafka.Addr,
+		Topic:     topic,
+		Dialer:    dialer,
+		BatchSize: 1,
+	})
+}
+
+func (producer *KafkaProducer) close() {
+	producer.lock.Lock()
+	producer.closed = true
+	producer.lock.Unlock()
+
+	for _, writer := range []*kg.Writer{producer.agentWriter, producer.uploadWriter, producer.securityWriter} {
+		if err := writer.Close(); err != nil {
+			log.Warnf("close kafka writer for topic %s failed: %s", writer.Stats().Topic, err.Error())
+		}
+	}
+}
+
+func (producer *KafkaProducer) writeMessage(writer *kg.Writer, key string, data []byte) error {
+	producer.lock.RLock()
+	defer producer.lock.RUnlock()
+	if producer.closed {
+		return fmt.Errorf("kafka producer is closed")
+	}
+
+	return writer.WriteMessages(context.Background(), kg.Message{Key: []byte(key), Value: data})
+}
+
+func (producer *KafkaProducer) checkBrokers(ctx context.Context) error {
+	if len(producer.brokers) == 0 {
+		return fmt.Errorf("no kafka broker configured")
+	}
+
+	var errs []string
+	for _, broker := range producer.brokers {
+		conn, err := producer.dialer.DialContext(ctx, "tcp", broker)
+		if err == nil {
+			conn.Close()
+			return nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %s", broker, err.Error()))
 	}
+
+	return fmt.Errorf("all kafka brokers unreachable: %s", strings.Join(errs, ", "))
 }
 
 func formatCmd(cmd []byte) (string, string) {
@@ -88,7 +189,7 @@ func (producer *KafkaProducer) SendAgentEventMessage(
 		return fmt.Errorf("kafka SendAgentEventMessage Marshal failed: %s", err.Error())
 	}
 
-	return producer.agentWriter.WriteMessages(context.Background(), kg.Message{Key: []byte(AgentEvent), Value: data})
+	return producer.writeMessage(producer.agentWriter, AgentEvent, data)
 }
 
 func (producer *KafkaProducer) SendUploadMessage(m proto.Message) error {
@@ -97,5 +198,32 @@ func (producer *KafkaProducer) SendUploadMessage(m proto.Message) error {
 		return fmt.Errorf("kafka SendUploadMessage Marshal failed: %s", err.Error())
 	}
 
-	return producer.uploadWriter.WriteMessages(context.Background(), kg.Message{Key: []byte(UploadLogEvent), Value: data})
+	return producer.writeMessage(producer.uploadWriter, UploadLogEvent, data)
+}
+
+func (producer *KafkaProducer) SendDNSSecurityMessage(m proto.Message) error {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("kafka SendDNSSecurityMessage Marshal failed: %s", err.Error())
+	}
+
+	return producer.writeMessage(producer.securityWriter, DNSSecurityEvent, data)
+}
+
+func (producer *KafkaProducer) SendCertificateMessage(m proto.Message) error {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("kafka SendCertificateMessage Marshal failed: %s", err.Error())
+	}
+
+	return producer.writeMessage(producer.agentWriter, CertificateEvent, data)
+}
+
+func (producer *KafkaProducer) SendDNSZoneHealthMessage(m proto.Message) error {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("kafka SendDNSZoneHealthMessage Marshal failed: %s", err.Error())
+	}
+
+	return producer.writeMessage(producer.agentWriter, DNSZoneHealthEvent, data)
 }
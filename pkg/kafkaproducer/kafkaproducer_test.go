@@ -0,0 +1,36 @@
+package kafkaproducer
+
+import (
+	"testing"
+	"time"
+
+	ut "github.com/zdnscloud/cement/unittest"
+
+	"github.com/linkingthing/ddi-agent/config"
+	pb "github.com/linkingthing/ddi-agent/pkg/proto"
+)
+
+func TestCloseDrainsSenders(t *testing.T) {
+	conf := &config.AgentConfig{}
+	conf.Kafka.Addr = []string{"127.0.0.1:1"}
+	producer, err := newKafkaProducer(conf)
+	ut.Assert(t, err == nil, "new kafka producer should succeed")
+
+	producer.lock.RLock()
+	closed := make(chan struct{})
+	go func() {
+		producer.close()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+		t.Fatal("close should wait for in-flight senders")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	producer.lock.RUnlock()
+	<-closed
+	err = producer.SendUploadMessage(&pb.DDIResponse{})
+	ut.Assert(t, err != nil && err.Error() == "kafka producer is closed", "send after close should fail: %v", err)
+}
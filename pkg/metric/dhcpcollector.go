@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -54,6 +55,7 @@ type SubnetAddressStats struct {
 type DHCPCollector struct {
 	enabled                bool
 	nodeIP                 string
+	urlLock                sync.RWMutex
 	url                    string
 	httpClient             *http.Client
 	lastAssignedAddrsCount float64
@@ -81,6 +83,22 @@ func newDHCPCollector(conf *config.AgentConfig, cli *http.Client) (*DHCPCollecto
 	return c, nil
 }
 
+func (dhcp *DHCPCollector) reload(conf *config.AgentConfig) error {
+	if dhcp.enabled == false {
+		return nil
+	}
+
+	cmdUrl, err := url.Parse(HttpScheme + conf.DHCP.CmdAddr)
+	if err != nil {
+		return err
+	}
+
+	dhcp.urlLock.Lock()
+	dhcp.url = cmdUrl.String()
+	dhcp.urlLock.Unlock()
+	return nil
+}
+
 func (dhcp *DHCPCollector) Run() {
 	ticker := time.NewTicker(5 * time.Second)
 	defer ticker.Stop()
@@ -313,7 +331,10 @@ func getStatsValue(statsInterface interface{}) (float64, bool) {
 }
 
 func (dhcp *DHCPCollector) getStats(service string) ([]dhcpsrv.DHCPCmdResponse, error) {
-	resps, err := dhcpsrv.SendHttpRequestToDHCP(dhcp.httpClient, dhcp.url, &dhcpsrv.DHCPCmdRequest{
+	dhcp.urlLock.RLock()
+	cmdUrl := dhcp.url
+	dhcp.urlLock.RUnlock()
+	resps, err := dhcpsrv.SendHttpRequestToDHCP(dhcp.httpClient, cmdUrl, &dhcpsrv.DHCPCmdRequest{
 		Command:  GetStatisticAll,
 		Services: []string{service},
 	})
@@ -0,0 +1,88 @@
+package metric
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	CacheStatsTreeMemInUse = "TreeMemInUse"
+	CacheStatsHeapMemInUse = "HeapMemInUse"
+)
+
+type ViewCacheStats struct {
+	View        string
+	Size        uint64
+	Entries     uint64
+	QueryHits   uint64
+	QueryMisses uint64
+	HitRatio    float64
+	Counters    map[string]uint64
+	RRsets      map[string]uint64
+}
+
+var globalDNSCollector *DNSCollector
+
+func GetDNSCollector() *DNSCollector {
+	return globalDNSCollector
+}
+
+func (dns *DNSCollector) CacheStats() ([]*ViewCacheStats, error) {
+	if dns.enabled == false {
+		return nil, fmt.Errorf("dns is disabled")
+	}
+
+	statistics, err := dns.getStats()
+	if err != nil {
+		return nil, fmt.Errorf("get dns statistics failed: %s", err.Error())
+	}
+
+	var views []*ViewCacheStats
+	for _, v := range statistics.Views {
+		if v.Name != ViewNameBind {
+			views = append(views, newViewCacheStats(v))
+		}
+	}
+
+	sort.Slice(views, func(i, j int) bool { return views[i].View < views[j].View })
+	return views, nil
+}
+
+func newViewCacheStats(v View) *ViewCacheStats {
+	stats := &ViewCacheStats{
+		View:     v.Name,
+		Counters: make(map[string]uint64),
+		RRsets:   make(map[string]uint64),
+	}
+
+	for _, cs := range v.Counters {
+		if cs.Type != ViewCounterTypeCacheStats {
+			continue
+		}
+
+		for _, c := range cs.Counters {
+			stats.Counters[c.Name] = c.Counter
+			switch c.Name {
+			case CacheStatsQueryHits:
+				stats.QueryHits = c.Counter
+			case CacheStatsQueryMisses:
+				stats.QueryMisses = c.Counter
+			case CacheStatsTreeMemInUse, CacheStatsHeapMemInUse:
+				stats.Size += c.Counter
+			}
+		}
+	}
+
+	if total := stats.QueryHits + stats.QueryMisses; total != 0 {
+		stats.HitRatio = float64(stats.QueryHits) / float64(total)
+	}
+
+	for _, g := range v.Cache {
+		if g.Gauge > 0 {
+			stats.RRsets[g.Name] = uint64(g.Gauge)
+			stats.Entries += uint64(g.Gauge)
+		}
+	}
+
+	return stats
+}
@@ -1,29 +1,49 @@
 package metric
 
 import (
+	"context"
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/zdnscloud/cement/log"
-	"github.com/zdnscloud/cement/slice"
 
 	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/health"
 )
 
 const (
 	HttpScheme                = "http://"
 	StatsServerPath           = "/xml/v3/server"
+	StatsZonesPath            = "/xml/v3/zones"
+	JSONStatsServerPath       = "/json/v1/server"
+	JSONStatsZonesPath        = "/json/v1/zones"
+	StatsFormatAuto           = "auto"
+	StatsFormatXML            = "xml"
+	StatsFormatJSON           = "json"
 	ServerCounterTypeOpCode   = "opcode"
 	ServerCounterTypeNSStat   = "nsstat"
 	ServerCounterTypeQType    = "qtype"
+	ServerCounterTypeSockStat = "sockstat"
 	ViewCounterTypeCacheStats = "cachestats"
+	ViewCounterTypeResStats   = "resstats"
+	ZoneCounterTypeRcode      = "rcode"
+	ViewNameBind              = "_bind"
+	ResStatsQueryv4           = "Queryv4"
+	ResStatsQueryv6           = "Queryv6"
+	ResStatsQueryTimeout      = "QueryTimeout"
+	ResStatsLame              = "Lame"
+	ResStatsEDNS0Fail         = "EDNS0Fail"
+	ResStatsQryRTTPrefix      = "QryRTT"
 	CacheStatsQueryHits       = "QueryHits"
 	CacheStatsQueryMisses     = "QueryMisses"
 	OpcodeQUERY               = "QUERY"
@@ -45,16 +65,22 @@ const (
 	RcodeDuplicate            = "Duplicate"
 	RcodeDropped              = "Dropped"
 	RcodeFailure              = "Failure"
+	SockStatPrefixTLS         = "TLS"
+	SockStatPrefixHTTP        = "HTTP"
+	EncryptedProtocolDot      = "dot"
+	EncryptedProtocolDoh      = "doh"
 
 	Uint32Max = 4294967295
 )
 
-var SupportTypes = []string{"A", "AAAA", "CNAME", "HINFO", "MX", "NS", "NAPTR", "PTR", "SRV", "SOA", "TXT"}
-
 type DNSCollector struct {
 	enabled        bool
 	nodeIP         string
-	url            string
+	confLock       sync.RWMutex
+	baseUrl        string
+	statsFormat    string
+	formatLock     sync.Mutex
+	format         string
 	httpClient     *http.Client
 	lastQueryCount uint64
 	lastGetTime    time.Time
@@ -66,21 +92,66 @@ func newDNSCollector(conf *config.AgentConfig, cli *http.Client) (*DNSCollector,
 		return &DNSCollector{enabled: conf.DNS.Enabled}, nil
 	}
 
-	u, err := url.Parse(HttpScheme + conf.DNS.StatsAddr + StatsServerPath)
+	baseUrl, statsFormat, err := parseStatsConf(conf)
 	if err != nil {
 		return nil, err
 	}
 
 	c := &DNSCollector{
-		enabled:    conf.DNS.Enabled,
-		nodeIP:     conf.Server.IP,
-		url:        u.String(),
-		httpClient: cli,
+		enabled:     conf.DNS.Enabled,
+		nodeIP:      conf.Server.IP,
+		baseUrl:     baseUrl,
+		statsFormat: statsFormat,
+		httpClient:  cli,
 	}
+	health.Register(health.ComponentNamed, c.checkNamed)
+	globalDNSCollector = c
 	go c.Run()
 	return c, nil
 }
 
+func parseStatsConf(conf *config.AgentConfig) (string, string, error) {
+	u, err := url.Parse(HttpScheme + conf.DNS.StatsAddr)
+	if err != nil {
+		return "", "", err
+	}
+
+	statsFormat := strings.ToLower(conf.DNS.StatsFormat)
+	switch statsFormat {
+	case "":
+		statsFormat = StatsFormatAuto
+	case StatsFormatAuto, StatsFormatXML, StatsFormatJSON:
+	default:
+		return "", "", fmt.Errorf("unsupported dns stats format %s", conf.DNS.StatsFormat)
+	}
+
+	return u.String(), statsFormat, nil
+}
+
+func (dns *DNSCollector) reload(conf *config.AgentConfig) error {
+	if dns.enabled == false {
+		return nil
+	}
+
+	baseUrl, statsFormat, err := parseStatsConf(conf)
+	if err != nil {
+		return err
+	}
+
+	dns.confLock.Lock()
+	dns.baseUrl = baseUrl
+	dns.statsFormat = statsFormat
+	dns.confLock.Unlock()
+	dns.resetFormat()
+	return nil
+}
+
+func (dns *DNSCollector) getConf() (string, string) {
+	dns.confLock.RLock()
+	defer dns.confLock.RUnlock()
+	return dns.baseUrl, dns.statsFormat
+}
+
 func (dns *DNSCollector) Run() {
 	ticker := time.NewTicker(5 * time.Second)
 	defer ticker.Stop()
@@ -138,6 +209,13 @@ func (dns *DNSCollector) Collect(ch chan<- prometheus.Metric) {
 		return
 	}
 
+	dns.collectResolverStats(ch, statistics.Views)
+	if zoneStatistics, err := dns.getZoneStats(); err != nil {
+		log.Warnf("get dns zone statistics with node %s failed: %s", dns.nodeIP, err.Error())
+	} else {
+		dns.collectZoneStats(ch, zoneStatistics.Views)
+	}
+
 	totalQueries, ok := dns.getQueryTotal(statistics.Server.Counters)
 	if ok == false || totalQueries == 0 {
 		return
@@ -160,6 +238,84 @@ func (dns *DNSCollector) Collect(ch chan<- prometheus.Metric) {
 			dns.collectNSStat(ch, totalQueries, cs.Counters)
 		case ServerCounterTypeQType:
 			dns.collectQTypeRatio(ch, cs.Counters)
+		case ServerCounterTypeSockStat:
+			dns.collectEncryptedStats(ch, cs.Counters)
+		}
+	}
+}
+
+func (dns *DNSCollector) collectResolverStats(ch chan<- prometheus.Metric, views []View) {
+	for _, v := range views {
+		if v.Name == ViewNameBind {
+			continue
+		}
+
+		for _, cs := range v.Counters {
+			if cs.Type != ViewCounterTypeResStats {
+				continue
+			}
+
+			var queriesSent uint64
+			for _, c := range cs.Counters {
+				switch c.Name {
+				case ResStatsQueryv4, ResStatsQueryv6:
+					queriesSent += c.Counter
+				case ResStatsQueryTimeout:
+					ch <- prometheus.MustNewConstMetric(DNSResolverTimeouts,
+						prometheus.CounterValue, float64(c.Counter), dns.nodeIP, v.Name)
+				case ResStatsLame:
+					ch <- prometheus.MustNewConstMetric(DNSResolverLame,
+						prometheus.CounterValue, float64(c.Counter), dns.nodeIP, v.Name)
+				case ResStatsEDNS0Fail:
+					ch <- prometheus.MustNewConstMetric(DNSResolverEDNSFailures,
+						prometheus.CounterValue, float64(c.Counter), dns.nodeIP, v.Name)
+				default:
+					if strings.HasPrefix(c.Name, ResStatsQryRTTPrefix) {
+						ch <- prometheus.MustNewConstMetric(DNSResolverRTT, prometheus.CounterValue,
+							float64(c.Counter), dns.nodeIP, v.Name, strings.TrimPrefix(c.Name, ResStatsQryRTTPrefix))
+					}
+				}
+			}
+
+			ch <- prometheus.MustNewConstMetric(DNSResolverQueriesSent,
+				prometheus.CounterValue, float64(queriesSent), dns.nodeIP, v.Name)
+			break
+		}
+	}
+}
+
+func (dns *DNSCollector) collectZoneStats(ch chan<- prometheus.Metric, views []ZoneView) {
+	for _, v := range views {
+		if v.Name == ViewNameBind {
+			continue
+		}
+
+		for _, zone := range v.Zones {
+			if serial, err := strconv.ParseUint(zone.Serial, 10, 32); err == nil {
+				ch <- prometheus.MustNewConstMetric(DNSZoneSerial,
+					prometheus.GaugeValue, float64(serial), dns.nodeIP, v.Name, zone.Name)
+			}
+
+			if refresh, err := time.Parse(time.RFC3339, zone.Refresh); err == nil {
+				ch <- prometheus.MustNewConstMetric(DNSZoneRefreshTime,
+					prometheus.GaugeValue, float64(refresh.Unix()), dns.nodeIP, v.Name, zone.Name)
+			}
+
+			if expires, err := time.Parse(time.RFC3339, zone.Expires); err == nil {
+				ch <- prometheus.MustNewConstMetric(DNSZoneExpireTime,
+					prometheus.GaugeValue, float64(expires.Unix()), dns.nodeIP, v.Name, zone.Name)
+			}
+
+			for _, cs := range zone.Counters {
+				if cs.Type != ZoneCounterTypeRcode {
+					continue
+				}
+
+				for _, c := range cs.Counters {
+					ch <- prometheus.MustNewConstMetric(DNSZoneQueries, prometheus.CounterValue,
+						float64(c.Counter), dns.nodeIP, v.Name, zone.Name, strings.TrimPrefix(c.Name, "Qry"))
+				}
+			}
 		}
 	}
 }
@@ -224,34 +380,157 @@ func (dns *DNSCollector) collectNSStat(ch chan<- prometheus.Metric, totalQueries
 }
 
 func (dns *DNSCollector) collectQTypeRatio(ch chan<- prometheus.Metric, counters []Counter) {
-	var newTotalQueries float64
-	var newCounters []Counter
+	var totalQueries float64
 	for _, c := range counters {
-		if isTypeSupport(c.Name) {
-			newCounters = append(newCounters, c)
-			newTotalQueries += float64(c.Counter)
-		}
+		totalQueries += float64(c.Counter)
 	}
 
-	if newTotalQueries != 0 {
-		for _, c := range newCounters {
+	if totalQueries != 0 {
+		for _, c := range counters {
 			ch <- prometheus.MustNewConstMetric(DNSQueryTypeRatios,
-				prometheus.CounterValue, float64(c.Counter)/newTotalQueries, dns.nodeIP, c.Name)
+				prometheus.CounterValue, float64(c.Counter)/totalQueries, dns.nodeIP, c.Name)
 		}
 	}
 }
 
+func (dns *DNSCollector) collectEncryptedStats(ch chan<- prometheus.Metric, counters []Counter) {
+	stats := make(map[string]map[string]uint64)
+	for _, c := range counters {
+		protocol, typ, ok := parseEncryptedSockStat(c.Name)
+		if ok == false {
+			continue
+		}
+
+		if _, ok := stats[protocol]; ok == false {
+			stats[protocol] = make(map[string]uint64)
+		}
+		stats[protocol][typ] += c.Counter
+	}
+
+	for protocol, types := range stats {
+		for typ, count := range types {
+			ch <- prometheus.MustNewConstMetric(DNSEncryptedStats, prometheus.CounterValue,
+				float64(count), dns.nodeIP, protocol, typ)
+		}
+	}
+}
+
+func parseEncryptedSockStat(name string) (string, string, bool) {
+	var protocol, rest string
+	switch {
+	case strings.HasPrefix(name, SockStatPrefixTLS):
+		protocol, rest = EncryptedProtocolDot, strings.TrimPrefix(name, SockStatPrefixTLS)
+	case strings.HasPrefix(name, SockStatPrefixHTTP):
+		protocol, rest = EncryptedProtocolDoh, strings.TrimPrefix(name, SockStatPrefixHTTP)
+	default:
+		return "", "", false
+	}
+
+	if len(rest) < 2 || (rest[0] != '4' && rest[0] != '6') {
+		return "", "", false
+	}
+
+	return protocol, rest[1:], true
+}
+
 func (dns *DNSCollector) getStats() (*DNSStatistics, error) {
+	format, err := dns.getFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	if format == StatsFormatJSON {
+		var stats JSONDNSStatistics
+		if err := dns.getJSON(JSONStatsServerPath, &stats); err != nil {
+			dns.resetFormat()
+			return nil, err
+		}
+		return stats.toDNSStatistics(), nil
+	}
+
 	var stats DNSStatistics
-	if err := dns.get(&stats); err != nil {
+	if err := dns.getXML(StatsServerPath, &stats); err != nil {
+		dns.resetFormat()
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+func (dns *DNSCollector) getZoneStats() (*DNSZoneStatistics, error) {
+	format, err := dns.getFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	if format == StatsFormatJSON {
+		var stats JSONDNSStatistics
+		if err := dns.getJSON(JSONStatsZonesPath, &stats); err != nil {
+			dns.resetFormat()
+			return nil, err
+		}
+		return stats.toDNSZoneStatistics(), nil
+	}
+
+	var stats DNSZoneStatistics
+	if err := dns.getXML(StatsZonesPath, &stats); err != nil {
+		dns.resetFormat()
 		return nil, err
 	}
 
 	return &stats, nil
 }
 
-func (dns *DNSCollector) get(resp interface{}) error {
-	httpResp, err := dns.httpClient.Get(dns.url)
+func (dns *DNSCollector) checkNamed(context.Context) error {
+	_, err := dns.getStats()
+	return err
+}
+
+func (dns *DNSCollector) getFormat() (string, error) {
+	if _, statsFormat := dns.getConf(); statsFormat != StatsFormatAuto {
+		return statsFormat, nil
+	}
+
+	dns.formatLock.Lock()
+	defer dns.formatLock.Unlock()
+	if dns.format != "" {
+		return dns.format, nil
+	}
+
+	var jsonStats JSONDNSStatistics
+	jsonErr := dns.getJSON(JSONStatsServerPath, &jsonStats)
+	if jsonErr == nil {
+		dns.format = StatsFormatJSON
+		return dns.format, nil
+	}
+
+	var xmlStats DNSStatistics
+	xmlErr := dns.getXML(StatsServerPath, &xmlStats)
+	if xmlErr == nil {
+		dns.format = StatsFormatXML
+		return dns.format, nil
+	}
+
+	return "", fmt.Errorf("detect dns stats format failed, json: %s, xml: %s", jsonErr.Error(), xmlErr.Error())
+}
+
+func (dns *DNSCollector) resetFormat() {
+	dns.formatLock.Lock()
+	dns.format = ""
+	dns.formatLock.Unlock()
+}
+
+func (dns *DNSCollector) getJSON(path string, resp interface{}) error {
+	return dns.get(path, resp, json.Unmarshal, StatsFormatJSON)
+}
+
+func (dns *DNSCollector) getXML(path string, resp interface{}) error {
+	return dns.get(path, resp, xml.Unmarshal, StatsFormatXML)
+}
+
+func (dns *DNSCollector) get(path string, resp interface{}, unmarshal func([]byte, interface{}) error, format string) error {
+	baseUrl, _ := dns.getConf()
+	httpResp, err := dns.httpClient.Get(baseUrl + path)
 	if err != nil {
 		return fmt.Errorf("query dns stats failed: %s", err.Error())
 	}
@@ -266,13 +545,9 @@ func (dns *DNSCollector) get(resp interface{}) error {
 		return fmt.Errorf("read dns stats response failed: %s", err.Error())
 	}
 
-	if err := xml.Unmarshal(body, resp); err != nil {
-		return fmt.Errorf("unmarshal dns stats with XML failed: %s", err.Error())
+	if err := unmarshal(body, resp); err != nil {
+		return fmt.Errorf("unmarshal dns stats with %s failed: %s", strings.ToUpper(format), err.Error())
 	}
 
 	return nil
 }
-
-func isTypeSupport(queryType string) bool {
-	return slice.SliceIndex(SupportTypes, queryType) != -1
-}
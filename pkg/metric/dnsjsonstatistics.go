@@ -0,0 +1,150 @@
+package metric
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+)
+
+type JSONCounters map[string]uint64
+
+func (c *JSONCounters) UnmarshalJSON(data []byte) error {
+	var values map[string]interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	counters := make(JSONCounters, len(values))
+	for name, value := range values {
+		if number, ok := value.(float64); ok && number >= 0 {
+			counters[name] = uint64(number)
+		}
+	}
+
+	*c = counters
+	return nil
+}
+
+func (c JSONCounters) toCounters(typ string) Counters {
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	counters := Counters{Type: typ}
+	for _, name := range names {
+		counters.Counters = append(counters.Counters, Counter{Name: name, Counter: c[name]})
+	}
+
+	return counters
+}
+
+func (c JSONCounters) toGauges() []Gauge {
+	var gauges []Gauge
+	for _, counter := range c.toCounters("").Counters {
+		gauges = append(gauges, Gauge{Name: counter.Name, Gauge: int64(counter.Counter)})
+	}
+
+	return gauges
+}
+
+type JSONDNSStatistics struct {
+	BootTime    string              `json:"boot-time"`
+	ConfigTime  string              `json:"config-time"`
+	CurrentTime string              `json:"current-time"`
+	Opcodes     JSONCounters        `json:"opcodes"`
+	QTypes      JSONCounters        `json:"qtypes"`
+	NSStats     JSONCounters        `json:"nsstats"`
+	SockStats   JSONCounters        `json:"sockstats"`
+	Views       map[string]JSONView `json:"views"`
+}
+
+type JSONView struct {
+	Resolver JSONResolver `json:"resolver"`
+	Zones    []JSONZone   `json:"zones"`
+}
+
+type JSONResolver struct {
+	Stats      JSONCounters `json:"stats"`
+	CacheStats JSONCounters `json:"cachestats"`
+	Cache      JSONCounters `json:"cache"`
+}
+
+type JSONZone struct {
+	Name    string          `json:"name"`
+	Class   string          `json:"class"`
+	Type    string          `json:"type"`
+	Serial  json.RawMessage `json:"serial"`
+	Loaded  string          `json:"loaded"`
+	Refresh string          `json:"refresh"`
+	Expires string          `json:"expires"`
+	Rcodes  JSONCounters    `json:"rcodes"`
+}
+
+func (s *JSONDNSStatistics) toDNSStatistics() *DNSStatistics {
+	stats := &DNSStatistics{
+		Server: Server{
+			BootTime:    parseStatsTime(s.BootTime),
+			ConfigTime:  parseStatsTime(s.ConfigTime),
+			CurrentTime: parseStatsTime(s.CurrentTime),
+			Counters: []Counters{
+				s.Opcodes.toCounters(ServerCounterTypeOpCode),
+				s.NSStats.toCounters(ServerCounterTypeNSStat),
+				s.QTypes.toCounters(ServerCounterTypeQType),
+				s.SockStats.toCounters(ServerCounterTypeSockStat),
+			},
+		},
+	}
+
+	for _, name := range s.viewNames() {
+		view := s.Views[name]
+		stats.Views = append(stats.Views, View{
+			Name:  name,
+			Cache: view.Resolver.Cache.toGauges(),
+			Counters: []Counters{
+				view.Resolver.CacheStats.toCounters(ViewCounterTypeCacheStats),
+				view.Resolver.Stats.toCounters(ViewCounterTypeResStats),
+			},
+		})
+	}
+
+	return stats
+}
+
+func (s *JSONDNSStatistics) toDNSZoneStatistics() *DNSZoneStatistics {
+	stats := &DNSZoneStatistics{}
+	for _, name := range s.viewNames() {
+		zoneView := ZoneView{Name: name}
+		for _, zone := range s.Views[name].Zones {
+			zoneView.Zones = append(zoneView.Zones, Zone{
+				Name:     zone.Name,
+				Class:    zone.Class,
+				Type:     zone.Type,
+				Serial:   strings.Trim(string(zone.Serial), "\""),
+				Loaded:   zone.Loaded,
+				Refresh:  zone.Refresh,
+				Expires:  zone.Expires,
+				Counters: []Counters{zone.Rcodes.toCounters(ZoneCounterTypeRcode)},
+			})
+		}
+		stats.Views = append(stats.Views, zoneView)
+	}
+
+	return stats
+}
+
+func (s *JSONDNSStatistics) viewNames() []string {
+	names := make([]string, 0, len(s.Views))
+	for name := range s.Views {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func parseStatsTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, s)
+	return t
+}
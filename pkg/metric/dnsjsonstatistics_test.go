@@ -0,0 +1,212 @@
+package metric
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+var jsonServerStats = `{
+  "json-stats-version":"1.2",
+  "boot-time":"2020-09-01T08:00:00.123Z",
+  "opcodes":{"QUERY":100,"IQUERY":0},
+  "qtypes":{"A":60,"AAAA":30,"HTTPS":10},
+  "nsstats":{"QrySuccess":80,"QryNXDOMAIN":20,"Unknown":"n/a"},
+  "sockstats":{"TCP4Accept":5,"TLS4Accept":3,"TLS6Accept":2,"HTTP4Active":1},
+  "views":{
+    "default":{"resolver":{"stats":{"Queryv4":10,"QueryTimeout":2},"cachestats":{"QueryHits":40,"QueryMisses":60,"TreeMemInUse":1000,"HeapMemInUse":24},"cache":{"A":12,"!AAAA":3,"NS":0}}}
+  }
+}`
+
+var jsonZoneStats = `{
+  "views":{
+    "default":{"zones":[
+      {"name":"example.com","class":"IN","type":"master","serial":2020090101,"rcodes":{"QrySuccess":5}},
+      {"name":"slave.com","class":"IN","type":"slave","serial":"-"}
+    ]}
+  }
+}`
+
+func TestJSONServerStatistics(t *testing.T) {
+	var jsonStats JSONDNSStatistics
+	ut.Assert(t, json.Unmarshal([]byte(jsonServerStats), &jsonStats) == nil, "unmarshal should succeed")
+
+	stats := jsonStats.toDNSStatistics()
+	ut.Equal(t, stats.Server.BootTime.IsZero(), false)
+	ut.Equal(t, len(stats.Server.Counters), 4)
+	ut.Equal(t, stats.Server.Counters[1].Type, ServerCounterTypeNSStat)
+	ut.Equal(t, len(stats.Server.Counters[1].Counters), 2)
+	ut.Equal(t, stats.Server.Counters[2].Counters[2], Counter{Name: "HTTPS", Counter: 10})
+	ut.Equal(t, stats.Server.Counters[3].Type, ServerCounterTypeSockStat)
+	ut.Equal(t, len(stats.Server.Counters[3].Counters), 4)
+	ut.Equal(t, len(stats.Views), 1)
+	ut.Equal(t, stats.Views[0].Name, "default")
+	ut.Equal(t, stats.Views[0].Counters[0].Counters[1], Counter{Name: CacheStatsQueryHits, Counter: 40})
+
+	cacheStats := newViewCacheStats(stats.Views[0])
+	ut.Equal(t, cacheStats.View, "default")
+	ut.Equal(t, cacheStats.Size, uint64(1024))
+	ut.Equal(t, cacheStats.Entries, uint64(15))
+	ut.Equal(t, cacheStats.RRsets, map[string]uint64{"A": 12, "!AAAA": 3})
+	ut.Equal(t, cacheStats.HitRatio, 0.4)
+}
+
+func TestJSONZoneStatistics(t *testing.T) {
+	var jsonStats JSONDNSStatistics
+	ut.Assert(t, json.Unmarshal([]byte(jsonZoneStats), &jsonStats) == nil, "unmarshal should succeed")
+
+	stats := jsonStats.toDNSZoneStatistics()
+	ut.Equal(t, len(stats.Views), 1)
+	ut.Equal(t, len(stats.Views[0].Zones), 2)
+	ut.Equal(t, stats.Views[0].Zones[0].Serial, "2020090101")
+	ut.Equal(t, stats.Views[0].Zones[1].Serial, "-")
+	ut.Equal(t, stats.Views[0].Zones[0].Counters[0].Counters[0], Counter{Name: QrySuccess, Counter: 5})
+}
+
+func TestParseEncryptedSockStat(t *testing.T) {
+	for name, expected := range map[string][]string{
+		"TLS4Accept":  {EncryptedProtocolDot, "Accept"},
+		"TLS6RecvErr": {EncryptedProtocolDot, "RecvErr"},
+		"HTTP4Active": {EncryptedProtocolDoh, "Active"},
+		"TCP4Accept":  nil,
+		"TLSAccept":   nil,
+		"HTTP6":       nil,
+	} {
+		protocol, typ, ok := parseEncryptedSockStat(name)
+		ut.Equal(t, ok, expected != nil)
+		if ok {
+			ut.Equal(t, []string{protocol, typ}, expected)
+		}
+	}
+}
+
+type collectorFunc func(ch chan<- prometheus.Metric)
+
+func (f collectorFunc) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(f, ch)
+}
+
+func (f collectorFunc) Collect(ch chan<- prometheus.Metric) {
+	f(ch)
+}
+
+func TestCollectResolverStats(t *testing.T) {
+	for _, c := range []struct {
+		stats    string
+		expected string
+	}{
+		{
+			`{"views":{
+			  "default":{"resolver":{"stats":{"Queryv4":10,"Queryv6":5,"QueryTimeout":2,"Lame":1,"EDNS0Fail":3,"QryRTT10":7,"QryRTT1600+":1,"NXDOMAIN":4}}},
+			  "_bind":{"resolver":{"stats":{"Queryv4":100}}}
+			}}`,
+			`
+# HELP lx_dns_resolver_edns_failures dns resolver edns failures per node,view
+# TYPE lx_dns_resolver_edns_failures counter
+lx_dns_resolver_edns_failures{node="10.0.0.1",view="default"} 3
+# HELP lx_dns_resolver_lame dns resolver lame delegations per node,view
+# TYPE lx_dns_resolver_lame counter
+lx_dns_resolver_lame{node="10.0.0.1",view="default"} 1
+# HELP lx_dns_resolver_queries_sent dns resolver queries sent per node,view
+# TYPE lx_dns_resolver_queries_sent counter
+lx_dns_resolver_queries_sent{node="10.0.0.1",view="default"} 15
+# HELP lx_dns_resolver_rtt dns resolver query rtt per node,view,bucket
+# TYPE lx_dns_resolver_rtt counter
+lx_dns_resolver_rtt{bucket="10",node="10.0.0.1",view="default"} 7
+lx_dns_resolver_rtt{bucket="1600+",node="10.0.0.1",view="default"} 1
+# HELP lx_dns_resolver_timeouts dns resolver query timeouts per node,view
+# TYPE lx_dns_resolver_timeouts counter
+lx_dns_resolver_timeouts{node="10.0.0.1",view="default"} 2
+`,
+		},
+		{
+			`{"views":{"v1":{"resolver":{"stats":{}}}}}`,
+			`
+# HELP lx_dns_resolver_queries_sent dns resolver queries sent per node,view
+# TYPE lx_dns_resolver_queries_sent counter
+lx_dns_resolver_queries_sent{node="10.0.0.1",view="v1"} 0
+`,
+		},
+	} {
+		var jsonStats JSONDNSStatistics
+		ut.Assert(t, json.Unmarshal([]byte(c.stats), &jsonStats) == nil, "unmarshal should succeed")
+
+		dns := &DNSCollector{nodeIP: "10.0.0.1"}
+		views := jsonStats.toDNSStatistics().Views
+		err := testutil.CollectAndCompare(collectorFunc(func(ch chan<- prometheus.Metric) {
+			dns.collectResolverStats(ch, views)
+		}), strings.NewReader(c.expected))
+		ut.Assert(t, err == nil, "unexpected resolver metrics: %v", err)
+	}
+}
+
+func TestCollectZoneStats(t *testing.T) {
+	for _, c := range []struct {
+		stats    string
+		expected string
+	}{
+		{
+			jsonZoneStats,
+			`
+# HELP lx_dns_zone_queries dns zone queries per node,view,zone,rcode
+# TYPE lx_dns_zone_queries counter
+lx_dns_zone_queries{node="10.0.0.1",rcode="Success",view="default",zone="example.com"} 5
+# HELP lx_dns_zone_serial dns zone serial per node,view,zone
+# TYPE lx_dns_zone_serial gauge
+lx_dns_zone_serial{node="10.0.0.1",view="default",zone="example.com"} 2.020090101e+09
+`,
+		},
+		{
+			`{"views":{
+			  "v1":{"zones":[{"name":"slave.com","type":"slave","serial":7,"refresh":"2020-09-01T08:00:00Z","expires":"2020-09-08T08:00:00Z","rcodes":{"QryNXDOMAIN":2}}]},
+			  "_bind":{"zones":[{"name":"version.bind","serial":0}]}
+			}}`,
+			`
+# HELP lx_dns_zone_expire_time dns slave zone expire unix time per node,view,zone
+# TYPE lx_dns_zone_expire_time gauge
+lx_dns_zone_expire_time{node="10.0.0.1",view="v1",zone="slave.com"} 1.5995520e+09
+# HELP lx_dns_zone_queries dns zone queries per node,view,zone,rcode
+# TYPE lx_dns_zone_queries counter
+lx_dns_zone_queries{node="10.0.0.1",rcode="NXDOMAIN",view="v1",zone="slave.com"} 2
+# HELP lx_dns_zone_refresh_time dns slave zone next refresh unix time per node,view,zone
+# TYPE lx_dns_zone_refresh_time gauge
+lx_dns_zone_refresh_time{node="10.0.0.1",view="v1",zone="slave.com"} 1.5989472e+09
+# HELP lx_dns_zone_serial dns zone serial per node,view,zone
+# TYPE lx_dns_zone_serial gauge
+lx_dns_zone_serial{node="10.0.0.1",view="v1",zone="slave.com"} 7
+`,
+		},
+	} {
+		var jsonStats JSONDNSStatistics
+		ut.Assert(t, json.Unmarshal([]byte(c.stats), &jsonStats) == nil, "unmarshal should succeed")
+
+		dns := &DNSCollector{nodeIP: "10.0.0.1"}
+		views := jsonStats.toDNSZoneStatistics().Views
+		err := testutil.CollectAndCompare(collectorFunc(func(ch chan<- prometheus.Metric) {
+			dns.collectZoneStats(ch, views)
+		}), strings.NewReader(c.expected))
+		ut.Assert(t, err == nil, "unexpected zone metrics: %v", err)
+	}
+}
+
+func TestCollectWithoutQueries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == JSONStatsZonesPath {
+			w.Write([]byte(jsonZoneStats))
+		} else {
+			w.Write([]byte(strings.Replace(jsonServerStats, `"QUERY":100`, `"QUERY":0`, 1)))
+		}
+	}))
+	defer server.Close()
+
+	dns := &DNSCollector{enabled: true, baseUrl: server.URL, statsFormat: StatsFormatJSON, httpClient: server.Client()}
+	ut.Equal(t, testutil.CollectAndCount(dns, MetricNameDNSQueriesTotal), 0)
+	ut.Equal(t, testutil.CollectAndCount(dns, MetricNameDNSResolverQueriesSent), 1)
+	ut.Equal(t, testutil.CollectAndCount(dns, MetricNameDNSZoneSerial), 1)
+}
@@ -0,0 +1,46 @@
+package metric
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/dns/prober"
+)
+
+type DNSProbeCollector struct {
+	nodeIP string
+}
+
+func newDNSProbeCollector(conf *config.AgentConfig) *DNSProbeCollector {
+	return &DNSProbeCollector{nodeIP: conf.Server.IP}
+}
+
+func (dns *DNSProbeCollector) Describe(ch chan<- *prometheus.Desc) {
+	if prober.GetProber() != nil {
+		for _, desc := range DNSProbePrometheusDescs {
+			ch <- desc
+		}
+	}
+}
+
+func (dns *DNSProbeCollector) Collect(ch chan<- prometheus.Metric) {
+	p := prober.GetProber()
+	if p == nil {
+		return
+	}
+
+	for _, zone := range p.Zones() {
+		var up float64
+		if zone.Available {
+			up = 1
+		}
+		ch <- prometheus.MustNewConstMetric(DNSZoneProbeUp, prometheus.GaugeValue,
+			up, dns.nodeIP, zone.View, zone.Zone)
+		for reason, count := range zone.Failures {
+			ch <- prometheus.MustNewConstMetric(DNSZoneProbeFailures, prometheus.CounterValue,
+				float64(count), dns.nodeIP, zone.View, zone.Zone, reason)
+		}
+		ch <- prometheus.MustNewConstHistogram(DNSZoneProbeLatency, zone.LatencyCount,
+			zone.LatencySum, zone.CumulativeLatencyHits(), dns.nodeIP, zone.View, zone.Zone)
+	}
+}
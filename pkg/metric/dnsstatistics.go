@@ -36,3 +36,23 @@ type Gauge struct {
 	Name  string `xml:"name"`
 	Gauge int64  `xml:"counter"`
 }
+
+type DNSZoneStatistics struct {
+	Views []ZoneView `xml:"views>view"`
+}
+
+type ZoneView struct {
+	Name  string `xml:"name,attr"`
+	Zones []Zone `xml:"zones>zone"`
+}
+
+type Zone struct {
+	Name     string     `xml:"name,attr"`
+	Class    string     `xml:"rdataclass,attr"`
+	Type     string     `xml:"type"`
+	Serial   string     `xml:"serial"`
+	Loaded   string     `xml:"loaded"`
+	Refresh  string     `xml:"refresh"`
+	Expires  string     `xml:"expires"`
+	Counters []Counters `xml:"counters"`
+}
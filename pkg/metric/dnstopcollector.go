@@ -0,0 +1,48 @@
+package metric
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/dns/analytics"
+)
+
+type DNSTopCollector struct {
+	nodeIP string
+}
+
+func newDNSTopCollector(conf *config.AgentConfig) *DNSTopCollector {
+	return &DNSTopCollector{nodeIP: conf.Server.IP}
+}
+
+func (dns *DNSTopCollector) Describe(ch chan<- *prometheus.Desc) {
+	if analytics.GetAnalyzer() != nil {
+		for _, desc := range DNSTopPrometheusDescs {
+			ch <- desc
+		}
+	}
+}
+
+func (dns *DNSTopCollector) Collect(ch chan<- prometheus.Metric) {
+	analyzer := analytics.GetAnalyzer()
+	if analyzer == nil {
+		return
+	}
+
+	stats := analyzer.TopStats(analyzer.TopN())
+	dns.collectEntries(ch, DNSTopNames, stats.TopNames)
+	dns.collectEntries(ch, DNSTopClients, stats.TopClients)
+	dns.collectEntries(ch, DNSTopNXDomains, stats.TopNXDomains)
+	for view, qtypes := range stats.ViewQTypes {
+		for qtype, count := range qtypes {
+			ch <- prometheus.MustNewConstMetric(DNSViewQueryTypes, prometheus.GaugeValue,
+				float64(count), dns.nodeIP, view, qtype)
+		}
+	}
+}
+
+func (dns *DNSTopCollector) collectEntries(ch chan<- prometheus.Metric, desc *prometheus.Desc, entries []analytics.Entry) {
+	for _, entry := range entries {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(entry.Count), dns.nodeIP, entry.Key)
+	}
+}
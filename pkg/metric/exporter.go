@@ -12,8 +12,11 @@ import (
 const HttpClientTimeout = 10
 
 type Exporter struct {
-	dnsCollector  *DNSCollector
-	dhcpCollector *DHCPCollector
+	dnsCollector      *DNSCollector
+	dnsTopCollector   *DNSTopCollector
+	dnsProbeCollector *DNSProbeCollector
+	dhcpCollector     *DHCPCollector
+	nginxCollector    *NginxCollector
 }
 
 func NewExporter(conf *config.AgentConfig) (*Exporter, error) {
@@ -31,15 +34,35 @@ func NewExporter(conf *config.AgentConfig) (*Exporter, error) {
 		return nil, err
 	}
 
-	return &Exporter{dnsCollector: dnsCollector, dhcpCollector: dhcpCollector}, nil
+	return &Exporter{
+		dnsCollector:      dnsCollector,
+		dnsTopCollector:   newDNSTopCollector(conf),
+		dnsProbeCollector: newDNSProbeCollector(conf),
+		dhcpCollector:     dhcpCollector,
+		nginxCollector:    newNginxCollector(conf),
+	}, nil
 }
 
 func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
 	e.dnsCollector.Describe(ch)
+	e.dnsTopCollector.Describe(ch)
+	e.dnsProbeCollector.Describe(ch)
 	e.dhcpCollector.Describe(ch)
+	e.nginxCollector.Describe(ch)
 }
 
 func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 	e.dnsCollector.Collect(ch)
+	e.dnsTopCollector.Collect(ch)
+	e.dnsProbeCollector.Collect(ch)
 	e.dhcpCollector.Collect(ch)
+	e.nginxCollector.Collect(ch)
+}
+
+func (e *Exporter) reload(conf *config.AgentConfig) error {
+	if err := e.dnsCollector.reload(conf); err != nil {
+		return err
+	}
+
+	return e.dhcpCollector.reload(conf)
 }
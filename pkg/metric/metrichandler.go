@@ -1,19 +1,32 @@
 package metric
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
 	"net/http"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/zdnscloud/cement/log"
 
 	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/agentmetric"
+	"github.com/linkingthing/ddi-agent/pkg/health"
 )
 
+const ServerShutdownTimeout = 5 * time.Second
+
 type MetricHandler struct {
+	lock       sync.Mutex
 	metricPort uint32
 	exporter   *Exporter
+	mux        *http.ServeMux
+	server     *http.Server
 }
 
 func New(conf *config.AgentConfig) (*MetricHandler, error) {
@@ -22,13 +35,102 @@ func New(conf *config.AgentConfig) (*MetricHandler, error) {
 		return nil, err
 	}
 
-	return &MetricHandler{metricPort: conf.Metric.Port, exporter: exporter}, nil
+	return &MetricHandler{metricPort: conf.Metric.Port, exporter: exporter, mux: http.NewServeMux()}, nil
 }
 
 func (h *MetricHandler) Run() {
 	prometheus.MustRegister(h.exporter)
-	http.Handle("/metrics", promhttp.Handler())
-	if err := http.ListenAndServe(":"+strconv.Itoa(int(h.metricPort)), nil); err != nil {
+	prometheus.MustRegister(agentmetric.Collectors()...)
+	h.mux.Handle("/metrics", promhttp.Handler())
+	h.mux.HandleFunc("/healthz", healthzHandler)
+	h.mux.HandleFunc("/readyz", readyzHandler)
+
+	h.lock.Lock()
+	listener, err := net.Listen("tcp", metricAddr(h.metricPort))
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+	h.server = &http.Server{Handler: h.mux}
+	server := h.server
+	h.lock.Unlock()
+	serve(server, listener)
+}
+
+func (h *MetricHandler) Reload(conf *config.AgentConfig) error {
+	if err := h.exporter.reload(conf); err != nil {
+		return err
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.metricPort == conf.Metric.Port {
+		return nil
+	}
+
+	if h.server == nil {
+		h.metricPort = conf.Metric.Port
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", metricAddr(conf.Metric.Port))
+	if err != nil {
+		return fmt.Errorf("listen metric port %d failed: %s", conf.Metric.Port, err.Error())
+	}
+
+	oldServer := h.server
+	h.server = &http.Server{Handler: h.mux}
+	h.metricPort = conf.Metric.Port
+	go serve(h.server, listener)
+
+	ctx, cancel := context.WithTimeout(context.Background(), ServerShutdownTimeout)
+	defer cancel()
+	if err := oldServer.Shutdown(ctx); err != nil {
+		log.Warnf("shutdown old metric server failed: %s", err.Error())
+	}
+	return nil
+}
+
+func (h *MetricHandler) Stop(ctx context.Context) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.server == nil {
+		return nil
+	}
+
+	return h.server.Shutdown(ctx)
+}
+
+func serve(server *http.Server, listener net.Listener) {
+	if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
 		log.Fatalf(err.Error())
 	}
 }
+
+func metricAddr(port uint32) string {
+	return ":" + strconv.Itoa(int(port))
+}
+
+func healthzHandler(w http.ResponseWriter, r *http.Request) {
+	writeHealthReport(w, health.Check(r.Context()), http.StatusOK)
+}
+
+func readyzHandler(w http.ResponseWriter, r *http.Request) {
+	report := health.Check(r.Context())
+	if report.IsUp() {
+		writeHealthReport(w, report, http.StatusOK)
+	} else {
+		writeHealthReport(w, report, http.StatusServiceUnavailable)
+	}
+}
+
+func writeHealthReport(w http.ResponseWriter, report *health.Report, statusCode int) {
+	body, err := json.Marshal(report)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	w.Write(body)
+}
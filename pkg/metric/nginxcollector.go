@@ -0,0 +1,44 @@
+package metric
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/linkingthing/ddi-agent/config"
+	"github.com/linkingthing/ddi-agent/pkg/nginxlog"
+)
+
+type NginxCollector struct {
+	nodeIP string
+}
+
+func newNginxCollector(conf *config.AgentConfig) *NginxCollector {
+	return &NginxCollector{nodeIP: conf.Server.IP}
+}
+
+func (nginx *NginxCollector) Describe(ch chan<- *prometheus.Desc) {
+	if nginxlog.GetStats() != nil {
+		for _, desc := range NginxPrometheusDescs {
+			ch <- desc
+		}
+	}
+}
+
+func (nginx *NginxCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := nginxlog.GetStats()
+	if stats == nil {
+		return
+	}
+
+	for _, domain := range stats.Domains() {
+		for status, count := range domain.Requests {
+			ch <- prometheus.MustNewConstMetric(NginxProxyRequests, prometheus.CounterValue,
+				float64(count), nginx.nodeIP, domain.Domain, status)
+		}
+		ch <- prometheus.MustNewConstMetric(NginxProxyReceivedBytes, prometheus.CounterValue,
+			float64(domain.ReceivedBytes), nginx.nodeIP, domain.Domain)
+		ch <- prometheus.MustNewConstMetric(NginxProxySentBytes, prometheus.CounterValue,
+			float64(domain.SentBytes), nginx.nodeIP, domain.Domain)
+		ch <- prometheus.MustNewConstHistogram(NginxProxyUpstreamResponse, domain.UpstreamCount,
+			domain.UpstreamSum, domain.CumulativeUpstreamHits(), nginx.nodeIP, domain.Domain)
+	}
+}
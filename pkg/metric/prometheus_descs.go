@@ -11,6 +11,14 @@ const (
 	MetricLabelView     = "view"
 	MetricLabelRcode    = "rcode"
 	MetricLabelSubnetId = "subnet_id"
+	MetricLabelName     = "name"
+	MetricLabelClient   = "client"
+	MetricLabelZone     = "zone"
+	MetricLabelBucket   = "bucket"
+	MetricLabelDomain   = "domain"
+	MetricLabelStatus   = "status"
+	MetricLabelProtocol = "protocol"
+	MetricLabelReason   = "reason"
 
 	MetricNameDNSQPS                 = "lx_dns_qps"
 	MetricNameDNSQueriesTotal        = "lx_dns_queries_total"
@@ -19,6 +27,29 @@ const (
 	MetricNameDNSCacheHitsRatioTotal = "lx_dns_cache_hits_ratio_total"
 	MetricNameDNSCacheHitsRatio      = "lx_dns_cache_hits_ratio"
 	MetricNameDNSResolvedRatios      = "lx_dns_resolved_ratios"
+	MetricNameDNSTopNames            = "lx_dns_top_names"
+	MetricNameDNSTopClients          = "lx_dns_top_clients"
+	MetricNameDNSTopNXDomains        = "lx_dns_top_nxdomains"
+	MetricNameDNSViewQueryTypes      = "lx_dns_view_query_types"
+	MetricNameDNSZoneQueries         = "lx_dns_zone_queries"
+	MetricNameDNSZoneSerial          = "lx_dns_zone_serial"
+	MetricNameDNSZoneRefreshTime     = "lx_dns_zone_refresh_time"
+	MetricNameDNSZoneExpireTime      = "lx_dns_zone_expire_time"
+	MetricNameDNSResolverQueriesSent = "lx_dns_resolver_queries_sent"
+	MetricNameDNSResolverTimeouts    = "lx_dns_resolver_timeouts"
+	MetricNameDNSResolverLame        = "lx_dns_resolver_lame"
+	MetricNameDNSResolverEDNSFailure = "lx_dns_resolver_edns_failures"
+	MetricNameDNSResolverRTT         = "lx_dns_resolver_rtt"
+	MetricNameDNSEncryptedStats      = "lx_dns_encrypted_transport_stats"
+
+	MetricNameDNSZoneProbeUp       = "lx_dns_zone_probe_up"
+	MetricNameDNSZoneProbeFailures = "lx_dns_zone_probe_failures_total"
+	MetricNameDNSZoneProbeLatency  = "lx_dns_zone_probe_latency_seconds"
+
+	MetricNameNginxProxyRequests         = "lx_nginx_proxy_requests_total"
+	MetricNameNginxProxyReceivedBytes    = "lx_nginx_proxy_received_bytes_total"
+	MetricNameNginxProxySentBytes        = "lx_nginx_proxy_sent_bytes_total"
+	MetricNameNginxProxyUpstreamResponse = "lx_nginx_proxy_upstream_response_seconds"
 
 	MetricNameDHCPLPS          = "lx_dhcp_lps"
 	MetricNameDHCPPacketsStats = "lx_dhcp_packets_stats"
@@ -41,6 +72,50 @@ var (
 		[]string{MetricLabelNode, MetricLabelView}, nil)
 	DNSResolvedRatios = prometheus.NewDesc(MetricNameDNSResolvedRatios, "dns resolve ratio per node,rcode",
 		[]string{MetricLabelNode, MetricLabelRcode}, nil)
+	DNSTopNames = prometheus.NewDesc(MetricNameDNSTopNames, "dns top queried names in window per node,name",
+		[]string{MetricLabelNode, MetricLabelName}, nil)
+	DNSTopClients = prometheus.NewDesc(MetricNameDNSTopClients, "dns top clients in window per node,client",
+		[]string{MetricLabelNode, MetricLabelClient}, nil)
+	DNSTopNXDomains = prometheus.NewDesc(MetricNameDNSTopNXDomains, "dns top nxdomain names in window per node,name",
+		[]string{MetricLabelNode, MetricLabelName}, nil)
+	DNSViewQueryTypes = prometheus.NewDesc(MetricNameDNSViewQueryTypes, "dns qtypes in window per node,view,type",
+		[]string{MetricLabelNode, MetricLabelView, MetricLabelType}, nil)
+	DNSZoneQueries = prometheus.NewDesc(MetricNameDNSZoneQueries, "dns zone queries per node,view,zone,rcode",
+		[]string{MetricLabelNode, MetricLabelView, MetricLabelZone, MetricLabelRcode}, nil)
+	DNSZoneSerial = prometheus.NewDesc(MetricNameDNSZoneSerial, "dns zone serial per node,view,zone",
+		[]string{MetricLabelNode, MetricLabelView, MetricLabelZone}, nil)
+	DNSZoneRefreshTime = prometheus.NewDesc(MetricNameDNSZoneRefreshTime, "dns slave zone next refresh unix time per node,view,zone",
+		[]string{MetricLabelNode, MetricLabelView, MetricLabelZone}, nil)
+	DNSZoneExpireTime = prometheus.NewDesc(MetricNameDNSZoneExpireTime, "dns slave zone expire unix time per node,view,zone",
+		[]string{MetricLabelNode, MetricLabelView, MetricLabelZone}, nil)
+	DNSResolverQueriesSent = prometheus.NewDesc(MetricNameDNSResolverQueriesSent, "dns resolver queries sent per node,view",
+		[]string{MetricLabelNode, MetricLabelView}, nil)
+	DNSResolverTimeouts = prometheus.NewDesc(MetricNameDNSResolverTimeouts, "dns resolver query timeouts per node,view",
+		[]string{MetricLabelNode, MetricLabelView}, nil)
+	DNSResolverLame = prometheus.NewDesc(MetricNameDNSResolverLame, "dns resolver lame delegations per node,view",
+		[]string{MetricLabelNode, MetricLabelView}, nil)
+	DNSResolverEDNSFailures = prometheus.NewDesc(MetricNameDNSResolverEDNSFailure, "dns resolver edns failures per node,view",
+		[]string{MetricLabelNode, MetricLabelView}, nil)
+	DNSResolverRTT = prometheus.NewDesc(MetricNameDNSResolverRTT, "dns resolver query rtt per node,view,bucket",
+		[]string{MetricLabelNode, MetricLabelView, MetricLabelBucket}, nil)
+	DNSEncryptedStats = prometheus.NewDesc(MetricNameDNSEncryptedStats, "dns dot and doh socket stats per node,protocol,type",
+		[]string{MetricLabelNode, MetricLabelProtocol, MetricLabelType}, nil)
+
+	DNSZoneProbeUp = prometheus.NewDesc(MetricNameDNSZoneProbeUp, "dns zone probe availability per node,view,zone",
+		[]string{MetricLabelNode, MetricLabelView, MetricLabelZone}, nil)
+	DNSZoneProbeFailures = prometheus.NewDesc(MetricNameDNSZoneProbeFailures, "dns zone probe failures per node,view,zone,reason",
+		[]string{MetricLabelNode, MetricLabelView, MetricLabelZone, MetricLabelReason}, nil)
+	DNSZoneProbeLatency = prometheus.NewDesc(MetricNameDNSZoneProbeLatency, "dns zone probe soa query latency per node,view,zone",
+		[]string{MetricLabelNode, MetricLabelView, MetricLabelZone}, nil)
+
+	NginxProxyRequests = prometheus.NewDesc(MetricNameNginxProxyRequests, "nginx proxy requests per node,domain,status",
+		[]string{MetricLabelNode, MetricLabelDomain, MetricLabelStatus}, nil)
+	NginxProxyReceivedBytes = prometheus.NewDesc(MetricNameNginxProxyReceivedBytes, "nginx proxy received bytes per node,domain",
+		[]string{MetricLabelNode, MetricLabelDomain}, nil)
+	NginxProxySentBytes = prometheus.NewDesc(MetricNameNginxProxySentBytes, "nginx proxy sent bytes per node,domain",
+		[]string{MetricLabelNode, MetricLabelDomain}, nil)
+	NginxProxyUpstreamResponse = prometheus.NewDesc(MetricNameNginxProxyUpstreamResponse, "nginx proxy upstream response time per node,domain",
+		[]string{MetricLabelNode, MetricLabelDomain}, nil)
 
 	DHCPLPS = prometheus.NewDesc(MetricNameDHCPLPS, "dhcp lps per node",
 		[]string{MetricLabelNode}, nil)
@@ -53,5 +128,11 @@ var (
 )
 
 var DNSPrometheusDescs = []*prometheus.Desc{DNSQPS, DNSQueriesTotal, DNSQueryTypeRatios,
-	DNSCacheHits, DNSCacheHitsRatioTotal, DNSCacheHitsRatio, DNSResolvedRatios}
+	DNSCacheHits, DNSCacheHitsRatioTotal, DNSCacheHitsRatio, DNSResolvedRatios,
+	DNSZoneQueries, DNSZoneSerial, DNSZoneRefreshTime, DNSZoneExpireTime,
+	DNSResolverQueriesSent, DNSResolverTimeouts, DNSResolverLame, DNSResolverEDNSFailures, DNSResolverRTT, DNSEncryptedStats}
+var DNSTopPrometheusDescs = []*prometheus.Desc{DNSTopNames, DNSTopClients, DNSTopNXDomains, DNSViewQueryTypes}
+var DNSProbePrometheusDescs = []*prometheus.Desc{DNSZoneProbeUp, DNSZoneProbeFailures, DNSZoneProbeLatency}
+var NginxPrometheusDescs = []*prometheus.Desc{NginxProxyRequests, NginxProxyReceivedBytes,
+	NginxProxySentBytes, NginxProxyUpstreamResponse}
 var DHCPPrometheusDescs = []*prometheus.Desc{DHCPLPS, DHCPPacketsStats, DHCPLeasesTotal, DHCPUsages}
@@ -0,0 +1,81 @@
+package nginxlog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FormatName   = "ddi_proxy"
+	Format       = "$time_iso8601 $server_name $status $request_length $bytes_sent $request_time $upstream_response_time"
+	DefaultPath  = "/var/log/nginx/ddi_proxy_access.log"
+	noValue      = "-"
+	fieldCount   = 7
+	upstreamSeps = ",:"
+)
+
+type Request struct {
+	Time          time.Time
+	Domain        string
+	Status        string
+	ReceivedBytes uint64
+	SentBytes     uint64
+	RequestTime   float64
+	UpstreamTime  float64
+	HasUpstream   bool
+}
+
+func ParseLine(line string) (*Request, error) {
+	fields := strings.Fields(line)
+	if len(fields) < fieldCount {
+		return nil, fmt.Errorf("access log line has %d fields, expect at least %d", len(fields), fieldCount)
+	}
+
+	request := &Request{Domain: strings.ToLower(fields[1]), Status: fields[2]}
+	if request.Domain == "" || request.Domain == noValue {
+		return nil, fmt.Errorf("no server name found in access log line")
+	}
+
+	if t, err := time.Parse(time.RFC3339, fields[0]); err == nil {
+		request.Time = t
+	} else {
+		request.Time = time.Now()
+	}
+
+	if status, err := strconv.Atoi(request.Status); err != nil || status < 100 || status > 999 {
+		return nil, fmt.Errorf("status %s in access log line is invalid", fields[2])
+	}
+
+	var err error
+	if request.ReceivedBytes, err = strconv.ParseUint(fields[3], 10, 64); err != nil {
+		return nil, fmt.Errorf("request length %s in access log line is invalid", fields[3])
+	}
+
+	if request.SentBytes, err = strconv.ParseUint(fields[4], 10, 64); err != nil {
+		return nil, fmt.Errorf("bytes sent %s in access log line is invalid", fields[4])
+	}
+
+	if request.RequestTime, err = strconv.ParseFloat(fields[5], 64); err != nil {
+		return nil, fmt.Errorf("request time %s in access log line is invalid", fields[5])
+	}
+
+	request.UpstreamTime, request.HasUpstream = parseUpstreamTime(strings.Join(fields[6:], " "))
+	return request, nil
+}
+
+func parseUpstreamTime(value string) (float64, bool) {
+	var total float64
+	var found bool
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool {
+		return strings.ContainsRune(upstreamSeps, r)
+	}) {
+		if seconds, err := strconv.ParseFloat(strings.TrimSpace(part), 64); err == nil {
+			total += seconds
+			found = true
+		}
+	}
+
+	return total, found
+}
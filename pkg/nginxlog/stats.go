@@ -0,0 +1,128 @@
+package nginxlog
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/linkingthing/ddi-agent/config"
+)
+
+var UpstreamBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type DomainStats struct {
+	Domain        string
+	Requests      map[string]uint64
+	ReceivedBytes uint64
+	SentBytes     uint64
+	UpstreamCount uint64
+	UpstreamSum   float64
+	UpstreamHits  []uint64
+}
+
+func newDomainStats(domain string) *DomainStats {
+	return &DomainStats{
+		Domain:       domain,
+		Requests:     make(map[string]uint64),
+		UpstreamHits: make([]uint64, len(UpstreamBuckets)),
+	}
+}
+
+func (s *DomainStats) clone() *DomainStats {
+	c := *s
+	c.Requests = make(map[string]uint64, len(s.Requests))
+	for status, count := range s.Requests {
+		c.Requests[status] = count
+	}
+	c.UpstreamHits = append([]uint64(nil), s.UpstreamHits...)
+	return &c
+}
+
+func (s *DomainStats) CumulativeUpstreamHits() map[float64]uint64 {
+	buckets := make(map[float64]uint64, len(UpstreamBuckets))
+	var total uint64
+	for i, bound := range UpstreamBuckets {
+		total += s.UpstreamHits[i]
+		buckets[bound] = total
+	}
+
+	return buckets
+}
+
+type Stats struct {
+	lock    sync.Mutex
+	domains map[string]*DomainStats
+}
+
+var globalStats *Stats
+
+func GetStats() *Stats {
+	return globalStats
+}
+
+func Init(conf *config.AgentConfig) *Stats {
+	if conf.NginxAccessLog.Enabled == false {
+		return nil
+	}
+
+	globalStats = New()
+	return globalStats
+}
+
+func LogPath(conf *config.NginxAccessLogConf) string {
+	if conf.Path == "" {
+		return DefaultPath
+	}
+
+	return conf.Path
+}
+
+func New() *Stats {
+	return &Stats{domains: make(map[string]*DomainStats)}
+}
+
+func (s *Stats) HandleLine(line string) {
+	if request, err := ParseLine(line); err == nil {
+		s.HandleRequest(request)
+	}
+}
+
+func (s *Stats) HandleRequest(request *Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stats, ok := s.domains[request.Domain]
+	if ok == false {
+		stats = newDomainStats(request.Domain)
+		s.domains[request.Domain] = stats
+	}
+
+	stats.Requests[request.Status] += 1
+	stats.ReceivedBytes += request.ReceivedBytes
+	stats.SentBytes += request.SentBytes
+	if request.HasUpstream {
+		stats.UpstreamCount += 1
+		stats.UpstreamSum += request.UpstreamTime
+		if i := sort.SearchFloat64s(UpstreamBuckets, request.UpstreamTime); i < len(UpstreamBuckets) {
+			stats.UpstreamHits[i] += 1
+		}
+	}
+}
+
+func (s *Stats) Forget(domain string) {
+	s.lock.Lock()
+	delete(s.domains, domain)
+	s.lock.Unlock()
+}
+
+func (s *Stats) Domains() []*DomainStats {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	domains := make([]*DomainStats, 0, len(s.domains))
+	for _, stats := range s.domains {
+		domains = append(domains, stats.clone())
+	}
+
+	sort.Slice(domains, func(i, j int) bool { return domains[i].Domain < domains[j].Domain })
+	return domains
+}
@@ -0,0 +1,58 @@
+package nginxlog
+
+import (
+	"testing"
+
+	ut "github.com/zdnscloud/cement/unittest"
+)
+
+func TestParseAccessLogLine(t *testing.T) {
+	request, err := ParseLine("2020-10-19T10:32:19+08:00 WWW.Example.com 502 320 1024 0.120 0.050, 0.070")
+	ut.Assert(t, err == nil, "parse access log line failed: %v", err)
+	ut.Equal(t, request.Domain, "www.example.com")
+	ut.Equal(t, request.Status, "502")
+	ut.Equal(t, request.ReceivedBytes, uint64(320))
+	ut.Equal(t, request.SentBytes, uint64(1024))
+	ut.Equal(t, request.HasUpstream, true)
+	ut.Assert(t, request.UpstreamTime > 0.119 && request.UpstreamTime < 0.121, "upstream time should be summed")
+
+	request, err = ParseLine("2020-10-19T10:32:19+08:00 www.example.com 301 100 200 0.000 -")
+	ut.Assert(t, err == nil, "parse redirect line failed: %v", err)
+	ut.Equal(t, request.HasUpstream, false)
+
+	for _, line := range []string{
+		"2020-10-19T10:32:19+08:00 www.example.com 200 100 200 0.000",
+		"2020-10-19T10:32:19+08:00 www.example.com abc 100 200 0.000 -",
+		"2020-10-19T10:32:19+08:00 - 200 100 200 0.000 -",
+		"2020-10-19T10:32:19+08:00 www.example.com 200 -1 200 0.000 -",
+	} {
+		_, err := ParseLine(line)
+		ut.Assert(t, err != nil, "parse %s should fail", line)
+	}
+}
+
+func TestStats(t *testing.T) {
+	stats := New()
+	stats.HandleLine("2020-10-19T10:32:19+08:00 a.com 200 100 1000 0.010 0.008")
+	stats.HandleLine("2020-10-19T10:32:20+08:00 a.com 200 100 1000 0.300 0.300")
+	stats.HandleLine("2020-10-19T10:32:21+08:00 a.com 404 50 10 0.000 -")
+	stats.HandleLine("2020-10-19T10:32:21+08:00 b.com 200 50 10 20.000 20.000")
+	stats.HandleLine("invalid line")
+
+	domains := stats.Domains()
+	ut.Equal(t, len(domains), 2)
+	ut.Equal(t, domains[0].Domain, "a.com")
+	ut.Equal(t, domains[0].Requests, map[string]uint64{"200": 2, "404": 1})
+	ut.Equal(t, domains[0].ReceivedBytes, uint64(250))
+	ut.Equal(t, domains[0].SentBytes, uint64(2010))
+	ut.Equal(t, domains[0].UpstreamCount, uint64(2))
+	buckets := domains[0].CumulativeUpstreamHits()
+	ut.Equal(t, buckets[0.005], uint64(0))
+	ut.Equal(t, buckets[0.01], uint64(1))
+	ut.Equal(t, buckets[0.25], uint64(1))
+	ut.Equal(t, buckets[0.5], uint64(2))
+	ut.Equal(t, domains[1].CumulativeUpstreamHits()[10], uint64(0))
+
+	stats.Forget("a.com")
+	ut.Equal(t, len(stats.Domains()), 1)
+}
@@ -128,6 +128,14 @@ type UpdateGlobalConfigReq struct {
 	RecursiveClients uint32   `protobuf:"varint,7,opt,name=recursive_clients,json=recursiveClients,proto3" json:"recursive_clients,omitempty"`
 	TransferPort     uint32   `protobuf:"varint,8,opt,name=transfer_port,json=transferPort,proto3" json:"transfer_port,omitempty"`
 	UpdateModel      string   `protobuf:"bytes,9,opt,name=update_model,json=updateModel,proto3" json:"update_model,omitempty"`
+	DryRun           bool     `protobuf:"varint,10,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	DotEnable        bool     `protobuf:"varint,11,opt,name=dot_enable,json=dotEnable,proto3" json:"dot_enable,omitempty"`
+	DotPort          uint32   `protobuf:"varint,12,opt,name=dot_port,json=dotPort,proto3" json:"dot_port,omitempty"`
+	DohEnable        bool     `protobuf:"varint,13,opt,name=doh_enable,json=dohEnable,proto3" json:"doh_enable,omitempty"`
+	DohPort          uint32   `protobuf:"varint,14,opt,name=doh_port,json=dohPort,proto3" json:"doh_port,omitempty"`
+	DohEndpoint      string   `protobuf:"bytes,15,opt,name=doh_endpoint,json=dohEndpoint,proto3" json:"doh_endpoint,omitempty"`
+	TlsKey           []byte   `protobuf:"bytes,16,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	TlsCrt           []byte   `protobuf:"bytes,17,opt,name=tls_crt,json=tlsCrt,proto3" json:"tls_crt,omitempty"`
 }
 
 func (x *UpdateGlobalConfigReq) Reset() {
@@ -225,6 +233,62 @@ func (x *UpdateGlobalConfigReq) GetUpdateModel() string {
 	return ""
 }
 
+func (x *UpdateGlobalConfigReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpdateGlobalConfigReq) GetDotEnable() bool {
+	if x != nil {
+		return x.DotEnable
+	}
+	return false
+}
+
+func (x *UpdateGlobalConfigReq) GetDotPort() uint32 {
+	if x != nil {
+		return x.DotPort
+	}
+	return 0
+}
+
+func (x *UpdateGlobalConfigReq) GetDohEnable() bool {
+	if x != nil {
+		return x.DohEnable
+	}
+	return false
+}
+
+func (x *UpdateGlobalConfigReq) GetDohPort() uint32 {
+	if x != nil {
+		return x.DohPort
+	}
+	return 0
+}
+
+func (x *UpdateGlobalConfigReq) GetDohEndpoint() string {
+	if x != nil {
+		return x.DohEndpoint
+	}
+	return ""
+}
+
+func (x *UpdateGlobalConfigReq) GetTlsKey() []byte {
+	if x != nil {
+		return x.TlsKey
+	}
+	return nil
+}
+
+func (x *UpdateGlobalConfigReq) GetTlsCrt() []byte {
+	if x != nil {
+		return x.TlsCrt
+	}
+	return nil
+}
+
 type Acl struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -569,6 +633,7 @@ type CreateAuthZoneReq struct {
 	unknownFields protoimpl.UnknownFields
 
 	AuthZone *AuthZone `protobuf:"bytes,1,opt,name=authZone,proto3" json:"authZone,omitempty"`
+	DryRun   bool      `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *CreateAuthZoneReq) Reset() {
@@ -610,12 +675,20 @@ func (x *CreateAuthZoneReq) GetAuthZone() *AuthZone {
 	return nil
 }
 
+func (x *CreateAuthZoneReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type UpdateAuthZoneReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	AuthZone *AuthZone `protobuf:"bytes,1,opt,name=authZone,proto3" json:"authZone,omitempty"`
+	DryRun   bool      `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *UpdateAuthZoneReq) Reset() {
@@ -657,13 +730,21 @@ func (x *UpdateAuthZoneReq) GetAuthZone() *AuthZone {
 	return nil
 }
 
+func (x *UpdateAuthZoneReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type DeleteAuthZoneReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	View string `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	View   string `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DryRun bool   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *DeleteAuthZoneReq) Reset() {
@@ -712,6 +793,13 @@ func (x *DeleteAuthZoneReq) GetName() string {
 	return ""
 }
 
+func (x *DeleteAuthZoneReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type CreateAuthZoneAuthRRsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -987,6 +1075,7 @@ type BatchCreateAuthRRsReq struct {
 
 	AuthZoneRrs []*AuthZoneRR `protobuf:"bytes,1,rep,name=auth_zone_rrs,json=authZoneRrs,proto3" json:"auth_zone_rrs,omitempty"`
 	Soa         *AuthZoneRR   `protobuf:"bytes,2,opt,name=soa,proto3" json:"soa,omitempty"`
+	DryRun      bool          `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *BatchCreateAuthRRsReq) Reset() {
@@ -1035,13 +1124,21 @@ func (x *BatchCreateAuthRRsReq) GetSoa() *AuthZoneRR {
 	return nil
 }
 
+func (x *BatchCreateAuthRRsReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type CreateAuthRRReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rr  *AuthZoneRR `protobuf:"bytes,1,opt,name=rr,proto3" json:"rr,omitempty"`
-	Soa *AuthZoneRR `protobuf:"bytes,2,opt,name=soa,proto3" json:"soa,omitempty"`
+	Rr     *AuthZoneRR `protobuf:"bytes,1,opt,name=rr,proto3" json:"rr,omitempty"`
+	Soa    *AuthZoneRR `protobuf:"bytes,2,opt,name=soa,proto3" json:"soa,omitempty"`
+	DryRun bool        `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *CreateAuthRRReq) Reset() {
@@ -1090,14 +1187,22 @@ func (x *CreateAuthRRReq) GetSoa() *AuthZoneRR {
 	return nil
 }
 
+func (x *CreateAuthRRReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type UpdateAuthRRReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OldRr *AuthZoneRR `protobuf:"bytes,1,opt,name=old_rr,json=oldRr,proto3" json:"old_rr,omitempty"`
-	NewRr *AuthZoneRR `protobuf:"bytes,2,opt,name=new_rr,json=newRr,proto3" json:"new_rr,omitempty"`
-	Soa   *AuthZoneRR `protobuf:"bytes,3,opt,name=soa,proto3" json:"soa,omitempty"`
+	OldRr  *AuthZoneRR `protobuf:"bytes,1,opt,name=old_rr,json=oldRr,proto3" json:"old_rr,omitempty"`
+	NewRr  *AuthZoneRR `protobuf:"bytes,2,opt,name=new_rr,json=newRr,proto3" json:"new_rr,omitempty"`
+	Soa    *AuthZoneRR `protobuf:"bytes,3,opt,name=soa,proto3" json:"soa,omitempty"`
+	DryRun bool        `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *UpdateAuthRRReq) Reset() {
@@ -1153,13 +1258,21 @@ func (x *UpdateAuthRRReq) GetSoa() *AuthZoneRR {
 	return nil
 }
 
+func (x *UpdateAuthRRReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type DeleteAuthRRReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rr  *AuthZoneRR `protobuf:"bytes,1,opt,name=rr,proto3" json:"rr,omitempty"`
-	Soa *AuthZoneRR `protobuf:"bytes,2,opt,name=soa,proto3" json:"soa,omitempty"`
+	Rr     *AuthZoneRR `protobuf:"bytes,1,opt,name=rr,proto3" json:"rr,omitempty"`
+	Soa    *AuthZoneRR `protobuf:"bytes,2,opt,name=soa,proto3" json:"soa,omitempty"`
+	DryRun bool        `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *DeleteAuthRRReq) Reset() {
@@ -1208,19 +1321,30 @@ func (x *DeleteAuthRRReq) GetSoa() *AuthZoneRR {
 	return nil
 }
 
+func (x *DeleteAuthRRReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type CreateViewReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name         string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Priority     uint32          `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
-	Dns64        string          `protobuf:"bytes,4,opt,name=dns64,proto3" json:"dns64,omitempty"`
-	Key          string          `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
-	Acls         []string        `protobuf:"bytes,6,rep,name=acls,proto3" json:"acls,omitempty"`
-	Recursion    bool            `protobuf:"varint,7,opt,name=recursion,proto3" json:"recursion,omitempty"`
-	ViewPriority []*ViewPriority `protobuf:"bytes,8,rep,name=view_priority,json=viewPriority,proto3" json:"view_priority,omitempty"`
+	Id                 string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Priority           uint32          `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Dns64              string          `protobuf:"bytes,4,opt,name=dns64,proto3" json:"dns64,omitempty"`
+	Key                string          `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	Acls               []string        `protobuf:"bytes,6,rep,name=acls,proto3" json:"acls,omitempty"`
+	Recursion          bool            `protobuf:"varint,7,opt,name=recursion,proto3" json:"recursion,omitempty"`
+	ViewPriority       []*ViewPriority `protobuf:"bytes,8,rep,name=view_priority,json=viewPriority,proto3" json:"view_priority,omitempty"`
+	DryRun             bool            `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	EncryptedAddresses []string        `protobuf:"bytes,10,rep,name=encrypted_addresses,json=encryptedAddresses,proto3" json:"encrypted_addresses,omitempty"`
+	TlsKey             []byte          `protobuf:"bytes,11,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	TlsCrt             []byte          `protobuf:"bytes,12,opt,name=tls_crt,json=tlsCrt,proto3" json:"tls_crt,omitempty"`
 }
 
 func (x *CreateViewReq) Reset() {
@@ -1311,6 +1435,34 @@ func (x *CreateViewReq) GetViewPriority() []*ViewPriority {
 	return nil
 }
 
+func (x *CreateViewReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CreateViewReq) GetEncryptedAddresses() []string {
+	if x != nil {
+		return x.EncryptedAddresses
+	}
+	return nil
+}
+
+func (x *CreateViewReq) GetTlsKey() []byte {
+	if x != nil {
+		return x.TlsKey
+	}
+	return nil
+}
+
+func (x *CreateViewReq) GetTlsCrt() []byte {
+	if x != nil {
+		return x.TlsCrt
+	}
+	return nil
+}
+
 type ViewPriority struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1371,12 +1523,16 @@ type UpdateViewReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Priority     uint32          `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
-	Dns64        string          `protobuf:"bytes,3,opt,name=dns64,proto3" json:"dns64,omitempty"`
-	Acls         []string        `protobuf:"bytes,4,rep,name=acls,proto3" json:"acls,omitempty"`
-	Recursion    bool            `protobuf:"varint,5,opt,name=recursion,proto3" json:"recursion,omitempty"`
-	ViewPriority []*ViewPriority `protobuf:"bytes,6,rep,name=view_priority,json=viewPriority,proto3" json:"view_priority,omitempty"`
+	Id                 string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Priority           uint32          `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Dns64              string          `protobuf:"bytes,3,opt,name=dns64,proto3" json:"dns64,omitempty"`
+	Acls               []string        `protobuf:"bytes,4,rep,name=acls,proto3" json:"acls,omitempty"`
+	Recursion          bool            `protobuf:"varint,5,opt,name=recursion,proto3" json:"recursion,omitempty"`
+	ViewPriority       []*ViewPriority `protobuf:"bytes,6,rep,name=view_priority,json=viewPriority,proto3" json:"view_priority,omitempty"`
+	DryRun             bool            `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	EncryptedAddresses []string        `protobuf:"bytes,8,rep,name=encrypted_addresses,json=encryptedAddresses,proto3" json:"encrypted_addresses,omitempty"`
+	TlsKey             []byte          `protobuf:"bytes,9,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	TlsCrt             []byte          `protobuf:"bytes,10,opt,name=tls_crt,json=tlsCrt,proto3" json:"tls_crt,omitempty"`
 }
 
 func (x *UpdateViewReq) Reset() {
@@ -1453,6 +1609,34 @@ func (x *UpdateViewReq) GetViewPriority() []*ViewPriority {
 	return nil
 }
 
+func (x *UpdateViewReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpdateViewReq) GetEncryptedAddresses() []string {
+	if x != nil {
+		return x.EncryptedAddresses
+	}
+	return nil
+}
+
+func (x *UpdateViewReq) GetTlsKey() []byte {
+	if x != nil {
+		return x.TlsKey
+	}
+	return nil
+}
+
+func (x *UpdateViewReq) GetTlsCrt() []byte {
+	if x != nil {
+		return x.TlsCrt
+	}
+	return nil
+}
+
 type DeleteViewReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1460,6 +1644,7 @@ type DeleteViewReq struct {
 
 	Id           string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ViewPriority []*ViewPriority `protobuf:"bytes,2,rep,name=view_priority,json=viewPriority,proto3" json:"view_priority,omitempty"`
+	DryRun       bool            `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *DeleteViewReq) Reset() {
@@ -1508,20 +1693,30 @@ func (x *DeleteViewReq) GetViewPriority() []*ViewPriority {
 	return nil
 }
 
-type CreateNginxProxyReq struct {
+func (x *DeleteViewReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type NginxLocation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Domain  string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	Url     string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	IsHttps bool   `protobuf:"varint,3,opt,name=is_https,json=isHttps,proto3" json:"is_https,omitempty"`
-	Key     []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
-	Crt     []byte `protobuf:"bytes,5,opt,name=crt,proto3" json:"crt,omitempty"`
+	Path         string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Url          string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Upstreams    []string `protobuf:"bytes,3,rep,name=upstreams,proto3" json:"upstreams,omitempty"`
+	LbMethod     string   `protobuf:"bytes,4,opt,name=lb_method,json=lbMethod,proto3" json:"lb_method,omitempty"`
+	MaxFails     uint32   `protobuf:"varint,5,opt,name=max_fails,json=maxFails,proto3" json:"max_fails,omitempty"`
+	FailTimeout  uint32   `protobuf:"varint,6,opt,name=fail_timeout,json=failTimeout,proto3" json:"fail_timeout,omitempty"`
+	RedirectUrl  string   `protobuf:"bytes,7,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	RedirectCode uint32   `protobuf:"varint,8,opt,name=redirect_code,json=redirectCode,proto3" json:"redirect_code,omitempty"`
 }
 
-func (x *CreateNginxProxyReq) Reset() {
-	*x = CreateNginxProxyReq{}
+func (x *NginxLocation) Reset() {
+	*x = NginxLocation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dns_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1529,13 +1724,13 @@ func (x *CreateNginxProxyReq) Reset() {
 	}
 }
 
-func (x *CreateNginxProxyReq) String() string {
+func (x *NginxLocation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNginxProxyReq) ProtoMessage() {}
+func (*NginxLocation) ProtoMessage() {}
 
-func (x *CreateNginxProxyReq) ProtoReflect() protoreflect.Message {
+func (x *NginxLocation) ProtoReflect() protoreflect.Message {
 	mi := &file_dns_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1547,58 +1742,94 @@ func (x *CreateNginxProxyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNginxProxyReq.ProtoReflect.Descriptor instead.
-func (*CreateNginxProxyReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use NginxLocation.ProtoReflect.Descriptor instead.
+func (*NginxLocation) Descriptor() ([]byte, []int) {
 	return file_dns_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateNginxProxyReq) GetDomain() string {
+func (x *NginxLocation) GetPath() string {
 	if x != nil {
-		return x.Domain
+		return x.Path
 	}
 	return ""
 }
 
-func (x *CreateNginxProxyReq) GetUrl() string {
+func (x *NginxLocation) GetUrl() string {
 	if x != nil {
 		return x.Url
 	}
 	return ""
 }
 
-func (x *CreateNginxProxyReq) GetIsHttps() bool {
+func (x *NginxLocation) GetUpstreams() []string {
 	if x != nil {
-		return x.IsHttps
+		return x.Upstreams
 	}
-	return false
+	return nil
 }
 
-func (x *CreateNginxProxyReq) GetKey() []byte {
+func (x *NginxLocation) GetLbMethod() string {
 	if x != nil {
-		return x.Key
+		return x.LbMethod
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateNginxProxyReq) GetCrt() []byte {
+func (x *NginxLocation) GetMaxFails() uint32 {
 	if x != nil {
-		return x.Crt
+		return x.MaxFails
 	}
-	return nil
+	return 0
 }
 
-type UpdateNginxProxyReq struct {
+func (x *NginxLocation) GetFailTimeout() uint32 {
+	if x != nil {
+		return x.FailTimeout
+	}
+	return 0
+}
+
+func (x *NginxLocation) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *NginxLocation) GetRedirectCode() uint32 {
+	if x != nil {
+		return x.RedirectCode
+	}
+	return 0
+}
+
+type CreateNginxProxyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Domain  string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	Url     string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	IsHttps bool   `protobuf:"varint,3,opt,name=is_https,json=isHttps,proto3" json:"is_https,omitempty"`
+	Domain       string           `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Url          string           `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	IsHttps      bool             `protobuf:"varint,3,opt,name=is_https,json=isHttps,proto3" json:"is_https,omitempty"`
+	Key          []byte           `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Crt          []byte           `protobuf:"bytes,5,opt,name=crt,proto3" json:"crt,omitempty"`
+	Upstreams    []string         `protobuf:"bytes,6,rep,name=upstreams,proto3" json:"upstreams,omitempty"`
+	LbMethod     string           `protobuf:"bytes,7,opt,name=lb_method,json=lbMethod,proto3" json:"lb_method,omitempty"`
+	MaxFails     uint32           `protobuf:"varint,8,opt,name=max_fails,json=maxFails,proto3" json:"max_fails,omitempty"`
+	FailTimeout  uint32           `protobuf:"varint,9,opt,name=fail_timeout,json=failTimeout,proto3" json:"fail_timeout,omitempty"`
+	RedirectUrl  string           `protobuf:"bytes,10,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	RedirectCode uint32           `protobuf:"varint,11,opt,name=redirect_code,json=redirectCode,proto3" json:"redirect_code,omitempty"`
+	Locations    []*NginxLocation `protobuf:"bytes,12,rep,name=locations,proto3" json:"locations,omitempty"`
+	HttpToHttps  bool             `protobuf:"varint,13,opt,name=http_to_https,json=httpToHttps,proto3" json:"http_to_https,omitempty"`
+	Hsts         bool             `protobuf:"varint,14,opt,name=hsts,proto3" json:"hsts,omitempty"`
+	HstsMaxAge   uint32           `protobuf:"varint,15,opt,name=hsts_max_age,json=hstsMaxAge,proto3" json:"hsts_max_age,omitempty"`
+	TlsProfile   string           `protobuf:"bytes,16,opt,name=tls_profile,json=tlsProfile,proto3" json:"tls_profile,omitempty"`
+	TlsProtocols []string         `protobuf:"bytes,17,rep,name=tls_protocols,json=tlsProtocols,proto3" json:"tls_protocols,omitempty"`
+	TlsCiphers   string           `protobuf:"bytes,18,opt,name=tls_ciphers,json=tlsCiphers,proto3" json:"tls_ciphers,omitempty"`
 }
 
-func (x *UpdateNginxProxyReq) Reset() {
-	*x = UpdateNginxProxyReq{}
+func (x *CreateNginxProxyReq) Reset() {
+	*x = CreateNginxProxyReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dns_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1606,13 +1837,13 @@ func (x *UpdateNginxProxyReq) Reset() {
 	}
 }
 
-func (x *UpdateNginxProxyReq) String() string {
+func (x *CreateNginxProxyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNginxProxyReq) ProtoMessage() {}
+func (*CreateNginxProxyReq) ProtoMessage() {}
 
-func (x *UpdateNginxProxyReq) ProtoReflect() protoreflect.Message {
+func (x *CreateNginxProxyReq) ProtoReflect() protoreflect.Message {
 	mi := &file_dns_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1624,102 +1855,315 @@ func (x *UpdateNginxProxyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNginxProxyReq.ProtoReflect.Descriptor instead.
-func (*UpdateNginxProxyReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateNginxProxyReq.ProtoReflect.Descriptor instead.
+func (*CreateNginxProxyReq) Descriptor() ([]byte, []int) {
 	return file_dns_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UpdateNginxProxyReq) GetDomain() string {
+func (x *CreateNginxProxyReq) GetDomain() string {
 	if x != nil {
 		return x.Domain
 	}
 	return ""
 }
 
-func (x *UpdateNginxProxyReq) GetUrl() string {
+func (x *CreateNginxProxyReq) GetUrl() string {
 	if x != nil {
 		return x.Url
 	}
 	return ""
 }
 
-func (x *UpdateNginxProxyReq) GetIsHttps() bool {
+func (x *CreateNginxProxyReq) GetIsHttps() bool {
 	if x != nil {
 		return x.IsHttps
 	}
 	return false
 }
 
-type DeleteNginxProxyReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Domain  string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	IsHttps bool   `protobuf:"varint,2,opt,name=is_https,json=isHttps,proto3" json:"is_https,omitempty"`
+func (x *CreateNginxProxyReq) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
 }
 
-func (x *DeleteNginxProxyReq) Reset() {
-	*x = DeleteNginxProxyReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_dns_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateNginxProxyReq) GetCrt() []byte {
+	if x != nil {
+		return x.Crt
 	}
+	return nil
 }
 
-func (x *DeleteNginxProxyReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateNginxProxyReq) GetUpstreams() []string {
+	if x != nil {
+		return x.Upstreams
+	}
+	return nil
 }
 
-func (*DeleteNginxProxyReq) ProtoMessage() {}
+func (x *CreateNginxProxyReq) GetLbMethod() string {
+	if x != nil {
+		return x.LbMethod
+	}
+	return ""
+}
 
-func (x *DeleteNginxProxyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateNginxProxyReq) GetMaxFails() uint32 {
+	if x != nil {
+		return x.MaxFails
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DeleteNginxProxyReq.ProtoReflect.Descriptor instead.
-func (*DeleteNginxProxyReq) Descriptor() ([]byte, []int) {
-	return file_dns_proto_rawDescGZIP(), []int{26}
+func (x *CreateNginxProxyReq) GetFailTimeout() uint32 {
+	if x != nil {
+		return x.FailTimeout
+	}
+	return 0
 }
 
-func (x *DeleteNginxProxyReq) GetDomain() string {
+func (x *CreateNginxProxyReq) GetRedirectUrl() string {
 	if x != nil {
-		return x.Domain
+		return x.RedirectUrl
 	}
 	return ""
 }
 
-func (x *DeleteNginxProxyReq) GetIsHttps() bool {
+func (x *CreateNginxProxyReq) GetRedirectCode() uint32 {
 	if x != nil {
-		return x.IsHttps
+		return x.RedirectCode
 	}
-	return false
+	return 0
 }
 
-type Redirection struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	View         string `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
-	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	RrType       string `protobuf:"bytes,3,opt,name=rr_type,json=rrType,proto3" json:"rr_type,omitempty"`
-	Ttl          uint32 `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
-	Rdata        string `protobuf:"bytes,5,opt,name=rdata,proto3" json:"rdata,omitempty"`
-	RedirectType string `protobuf:"bytes,6,opt,name=redirect_type,json=redirectType,proto3" json:"redirect_type,omitempty"`
+func (x *CreateNginxProxyReq) GetLocations() []*NginxLocation {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
 }
 
-func (x *Redirection) Reset() {
-	*x = Redirection{}
+func (x *CreateNginxProxyReq) GetHttpToHttps() bool {
+	if x != nil {
+		return x.HttpToHttps
+	}
+	return false
+}
+
+func (x *CreateNginxProxyReq) GetHsts() bool {
+	if x != nil {
+		return x.Hsts
+	}
+	return false
+}
+
+func (x *CreateNginxProxyReq) GetHstsMaxAge() uint32 {
+	if x != nil {
+		return x.HstsMaxAge
+	}
+	return 0
+}
+
+func (x *CreateNginxProxyReq) GetTlsProfile() string {
+	if x != nil {
+		return x.TlsProfile
+	}
+	return ""
+}
+
+func (x *CreateNginxProxyReq) GetTlsProtocols() []string {
+	if x != nil {
+		return x.TlsProtocols
+	}
+	return nil
+}
+
+func (x *CreateNginxProxyReq) GetTlsCiphers() string {
+	if x != nil {
+		return x.TlsCiphers
+	}
+	return ""
+}
+
+type UpdateNginxProxyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain       string           `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Url          string           `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	IsHttps      bool             `protobuf:"varint,3,opt,name=is_https,json=isHttps,proto3" json:"is_https,omitempty"`
+	Upstreams    []string         `protobuf:"bytes,4,rep,name=upstreams,proto3" json:"upstreams,omitempty"`
+	LbMethod     string           `protobuf:"bytes,5,opt,name=lb_method,json=lbMethod,proto3" json:"lb_method,omitempty"`
+	MaxFails     uint32           `protobuf:"varint,6,opt,name=max_fails,json=maxFails,proto3" json:"max_fails,omitempty"`
+	FailTimeout  uint32           `protobuf:"varint,7,opt,name=fail_timeout,json=failTimeout,proto3" json:"fail_timeout,omitempty"`
+	RedirectUrl  string           `protobuf:"bytes,8,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	RedirectCode uint32           `protobuf:"varint,9,opt,name=redirect_code,json=redirectCode,proto3" json:"redirect_code,omitempty"`
+	Locations    []*NginxLocation `protobuf:"bytes,10,rep,name=locations,proto3" json:"locations,omitempty"`
+	HttpToHttps  bool             `protobuf:"varint,11,opt,name=http_to_https,json=httpToHttps,proto3" json:"http_to_https,omitempty"`
+	Hsts         bool             `protobuf:"varint,12,opt,name=hsts,proto3" json:"hsts,omitempty"`
+	HstsMaxAge   uint32           `protobuf:"varint,13,opt,name=hsts_max_age,json=hstsMaxAge,proto3" json:"hsts_max_age,omitempty"`
+	TlsProfile   string           `protobuf:"bytes,14,opt,name=tls_profile,json=tlsProfile,proto3" json:"tls_profile,omitempty"`
+	TlsProtocols []string         `protobuf:"bytes,15,rep,name=tls_protocols,json=tlsProtocols,proto3" json:"tls_protocols,omitempty"`
+	TlsCiphers   string           `protobuf:"bytes,16,opt,name=tls_ciphers,json=tlsCiphers,proto3" json:"tls_ciphers,omitempty"`
+}
+
+func (x *UpdateNginxProxyReq) Reset() {
+	*x = UpdateNginxProxyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNginxProxyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNginxProxyReq) ProtoMessage() {}
+
+func (x *UpdateNginxProxyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNginxProxyReq.ProtoReflect.Descriptor instead.
+func (*UpdateNginxProxyReq) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateNginxProxyReq) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *UpdateNginxProxyReq) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateNginxProxyReq) GetIsHttps() bool {
+	if x != nil {
+		return x.IsHttps
+	}
+	return false
+}
+
+func (x *UpdateNginxProxyReq) GetUpstreams() []string {
+	if x != nil {
+		return x.Upstreams
+	}
+	return nil
+}
+
+func (x *UpdateNginxProxyReq) GetLbMethod() string {
+	if x != nil {
+		return x.LbMethod
+	}
+	return ""
+}
+
+func (x *UpdateNginxProxyReq) GetMaxFails() uint32 {
+	if x != nil {
+		return x.MaxFails
+	}
+	return 0
+}
+
+func (x *UpdateNginxProxyReq) GetFailTimeout() uint32 {
+	if x != nil {
+		return x.FailTimeout
+	}
+	return 0
+}
+
+func (x *UpdateNginxProxyReq) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *UpdateNginxProxyReq) GetRedirectCode() uint32 {
+	if x != nil {
+		return x.RedirectCode
+	}
+	return 0
+}
+
+func (x *UpdateNginxProxyReq) GetLocations() []*NginxLocation {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *UpdateNginxProxyReq) GetHttpToHttps() bool {
+	if x != nil {
+		return x.HttpToHttps
+	}
+	return false
+}
+
+func (x *UpdateNginxProxyReq) GetHsts() bool {
+	if x != nil {
+		return x.Hsts
+	}
+	return false
+}
+
+func (x *UpdateNginxProxyReq) GetHstsMaxAge() uint32 {
+	if x != nil {
+		return x.HstsMaxAge
+	}
+	return 0
+}
+
+func (x *UpdateNginxProxyReq) GetTlsProfile() string {
+	if x != nil {
+		return x.TlsProfile
+	}
+	return ""
+}
+
+func (x *UpdateNginxProxyReq) GetTlsProtocols() []string {
+	if x != nil {
+		return x.TlsProtocols
+	}
+	return nil
+}
+
+func (x *UpdateNginxProxyReq) GetTlsCiphers() string {
+	if x != nil {
+		return x.TlsCiphers
+	}
+	return ""
+}
+
+type DeleteNginxProxyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain  string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	IsHttps bool   `protobuf:"varint,2,opt,name=is_https,json=isHttps,proto3" json:"is_https,omitempty"`
+}
+
+func (x *DeleteNginxProxyReq) Reset() {
+	*x = DeleteNginxProxyReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dns_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1727,6 +2171,65 @@ func (x *Redirection) Reset() {
 	}
 }
 
+func (x *DeleteNginxProxyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNginxProxyReq) ProtoMessage() {}
+
+func (x *DeleteNginxProxyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNginxProxyReq.ProtoReflect.Descriptor instead.
+func (*DeleteNginxProxyReq) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteNginxProxyReq) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *DeleteNginxProxyReq) GetIsHttps() bool {
+	if x != nil {
+		return x.IsHttps
+	}
+	return false
+}
+
+type Redirection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View         string `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RrType       string `protobuf:"bytes,3,opt,name=rr_type,json=rrType,proto3" json:"rr_type,omitempty"`
+	Ttl          uint32 `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Rdata        string `protobuf:"bytes,5,opt,name=rdata,proto3" json:"rdata,omitempty"`
+	RedirectType string `protobuf:"bytes,6,opt,name=redirect_type,json=redirectType,proto3" json:"redirect_type,omitempty"`
+}
+
+func (x *Redirection) Reset() {
+	*x = Redirection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
 func (x *Redirection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
@@ -1734,7 +2237,7 @@ func (x *Redirection) String() string {
 func (*Redirection) ProtoMessage() {}
 
 func (x *Redirection) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_proto_msgTypes[27]
+	mi := &file_dns_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1747,7 +2250,7 @@ func (x *Redirection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Redirection.ProtoReflect.Descriptor instead.
 func (*Redirection) Descriptor() ([]byte, []int) {
-	return file_dns_proto_rawDescGZIP(), []int{27}
+	return file_dns_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Redirection) GetView() string {
@@ -1798,12 +2301,13 @@ type CreateRedirectionReq struct {
 	unknownFields protoimpl.UnknownFields
 
 	Redirection *Redirection `protobuf:"bytes,1,opt,name=redirection,proto3" json:"redirection,omitempty"`
+	DryRun      bool         `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *CreateRedirectionReq) Reset() {
 	*x = CreateRedirectionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dns_proto_msgTypes[28]
+		mi := &file_dns_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1816,7 +2320,7 @@ func (x *CreateRedirectionReq) String() string {
 func (*CreateRedirectionReq) ProtoMessage() {}
 
 func (x *CreateRedirectionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_proto_msgTypes[28]
+	mi := &file_dns_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1829,7 +2333,7 @@ func (x *CreateRedirectionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRedirectionReq.ProtoReflect.Descriptor instead.
 func (*CreateRedirectionReq) Descriptor() ([]byte, []int) {
-	return file_dns_proto_rawDescGZIP(), []int{28}
+	return file_dns_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CreateRedirectionReq) GetRedirection() *Redirection {
@@ -1839,6 +2343,13 @@ func (x *CreateRedirectionReq) GetRedirection() *Redirection {
 	return nil
 }
 
+func (x *CreateRedirectionReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type UpdateRedirectionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1846,12 +2357,13 @@ type UpdateRedirectionReq struct {
 
 	OldRedirection *Redirection `protobuf:"bytes,1,opt,name=old_redirection,json=oldRedirection,proto3" json:"old_redirection,omitempty"`
 	NewRedirection *Redirection `protobuf:"bytes,2,opt,name=new_redirection,json=newRedirection,proto3" json:"new_redirection,omitempty"`
+	DryRun         bool         `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *UpdateRedirectionReq) Reset() {
 	*x = UpdateRedirectionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dns_proto_msgTypes[29]
+		mi := &file_dns_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1864,7 +2376,7 @@ func (x *UpdateRedirectionReq) String() string {
 func (*UpdateRedirectionReq) ProtoMessage() {}
 
 func (x *UpdateRedirectionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_proto_msgTypes[29]
+	mi := &file_dns_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1877,7 +2389,7 @@ func (x *UpdateRedirectionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRedirectionReq.ProtoReflect.Descriptor instead.
 func (*UpdateRedirectionReq) Descriptor() ([]byte, []int) {
-	return file_dns_proto_rawDescGZIP(), []int{29}
+	return file_dns_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdateRedirectionReq) GetOldRedirection() *Redirection {
@@ -1894,18 +2406,26 @@ func (x *UpdateRedirectionReq) GetNewRedirection() *Redirection {
 	return nil
 }
 
+func (x *UpdateRedirectionReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type DeleteRedirectionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Redirection *Redirection `protobuf:"bytes,1,opt,name=redirection,proto3" json:"redirection,omitempty"`
+	DryRun      bool         `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *DeleteRedirectionReq) Reset() {
 	*x = DeleteRedirectionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dns_proto_msgTypes[30]
+		mi := &file_dns_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1918,7 +2438,7 @@ func (x *DeleteRedirectionReq) String() string {
 func (*DeleteRedirectionReq) ProtoMessage() {}
 
 func (x *DeleteRedirectionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_proto_msgTypes[30]
+	mi := &file_dns_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1931,7 +2451,7 @@ func (x *DeleteRedirectionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRedirectionReq.ProtoReflect.Descriptor instead.
 func (*DeleteRedirectionReq) Descriptor() ([]byte, []int) {
-	return file_dns_proto_rawDescGZIP(), []int{30}
+	return file_dns_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DeleteRedirectionReq) GetRedirection() *Redirection {
@@ -1941,21 +2461,32 @@ func (x *DeleteRedirectionReq) GetRedirection() *Redirection {
 	return nil
 }
 
+func (x *DeleteRedirectionReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type CreateForwardZoneReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	View         string   `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
-	Name         string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ForwardStyle string   `protobuf:"bytes,3,opt,name=forward_style,json=forwardStyle,proto3" json:"forward_style,omitempty"`
-	Addresses    []string `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	View          string   `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	Name          string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ForwardStyle  string   `protobuf:"bytes,3,opt,name=forward_style,json=forwardStyle,proto3" json:"forward_style,omitempty"`
+	Addresses     []string `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	DryRun        bool     `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	TlsEnable     bool     `protobuf:"varint,6,opt,name=tls_enable,json=tlsEnable,proto3" json:"tls_enable,omitempty"`
+	TlsServerName string   `protobuf:"bytes,7,opt,name=tls_server_name,json=tlsServerName,proto3" json:"tls_server_name,omitempty"`
+	TlsCaBundle   []byte   `protobuf:"bytes,8,opt,name=tls_ca_bundle,json=tlsCaBundle,proto3" json:"tls_ca_bundle,omitempty"`
 }
 
 func (x *CreateForwardZoneReq) Reset() {
 	*x = CreateForwardZoneReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dns_proto_msgTypes[31]
+		mi := &file_dns_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1968,7 +2499,7 @@ func (x *CreateForwardZoneReq) String() string {
 func (*CreateForwardZoneReq) ProtoMessage() {}
 
 func (x *CreateForwardZoneReq) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_proto_msgTypes[31]
+	mi := &file_dns_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1981,7 +2512,7 @@ func (x *CreateForwardZoneReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateForwardZoneReq.ProtoReflect.Descriptor instead.
 func (*CreateForwardZoneReq) Descriptor() ([]byte, []int) {
-	return file_dns_proto_rawDescGZIP(), []int{31}
+	return file_dns_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CreateForwardZoneReq) GetView() string {
@@ -2012,21 +2543,53 @@ func (x *CreateForwardZoneReq) GetAddresses() []string {
 	return nil
 }
 
+func (x *CreateForwardZoneReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CreateForwardZoneReq) GetTlsEnable() bool {
+	if x != nil {
+		return x.TlsEnable
+	}
+	return false
+}
+
+func (x *CreateForwardZoneReq) GetTlsServerName() string {
+	if x != nil {
+		return x.TlsServerName
+	}
+	return ""
+}
+
+func (x *CreateForwardZoneReq) GetTlsCaBundle() []byte {
+	if x != nil {
+		return x.TlsCaBundle
+	}
+	return nil
+}
+
 type UpdateForwardZoneReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	View         string   `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
-	Name         string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ForwardStyle string   `protobuf:"bytes,3,opt,name=forward_style,json=forwardStyle,proto3" json:"forward_style,omitempty"`
-	Addresses    []string `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	View          string   `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	Name          string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ForwardStyle  string   `protobuf:"bytes,3,opt,name=forward_style,json=forwardStyle,proto3" json:"forward_style,omitempty"`
+	Addresses     []string `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	DryRun        bool     `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	TlsEnable     bool     `protobuf:"varint,6,opt,name=tls_enable,json=tlsEnable,proto3" json:"tls_enable,omitempty"`
+	TlsServerName string   `protobuf:"bytes,7,opt,name=tls_server_name,json=tlsServerName,proto3" json:"tls_server_name,omitempty"`
+	TlsCaBundle   []byte   `protobuf:"bytes,8,opt,name=tls_ca_bundle,json=tlsCaBundle,proto3" json:"tls_ca_bundle,omitempty"`
 }
 
 func (x *UpdateForwardZoneReq) Reset() {
 	*x = UpdateForwardZoneReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dns_proto_msgTypes[32]
+		mi := &file_dns_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2039,7 +2602,7 @@ func (x *UpdateForwardZoneReq) String() string {
 func (*UpdateForwardZoneReq) ProtoMessage() {}
 
 func (x *UpdateForwardZoneReq) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_proto_msgTypes[32]
+	mi := &file_dns_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2052,7 +2615,7 @@ func (x *UpdateForwardZoneReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateForwardZoneReq.ProtoReflect.Descriptor instead.
 func (*UpdateForwardZoneReq) Descriptor() ([]byte, []int) {
-	return file_dns_proto_rawDescGZIP(), []int{32}
+	return file_dns_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *UpdateForwardZoneReq) GetView() string {
@@ -2083,19 +2646,48 @@ func (x *UpdateForwardZoneReq) GetAddresses() []string {
 	return nil
 }
 
+func (x *UpdateForwardZoneReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpdateForwardZoneReq) GetTlsEnable() bool {
+	if x != nil {
+		return x.TlsEnable
+	}
+	return false
+}
+
+func (x *UpdateForwardZoneReq) GetTlsServerName() string {
+	if x != nil {
+		return x.TlsServerName
+	}
+	return ""
+}
+
+func (x *UpdateForwardZoneReq) GetTlsCaBundle() []byte {
+	if x != nil {
+		return x.TlsCaBundle
+	}
+	return nil
+}
+
 type DeleteForwardZoneReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	View string `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	View   string `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DryRun bool   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *DeleteForwardZoneReq) Reset() {
 	*x = DeleteForwardZoneReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dns_proto_msgTypes[33]
+		mi := &file_dns_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2108,7 +2700,7 @@ func (x *DeleteForwardZoneReq) String() string {
 func (*DeleteForwardZoneReq) ProtoMessage() {}
 
 func (x *DeleteForwardZoneReq) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_proto_msgTypes[33]
+	mi := &file_dns_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2121,7 +2713,7 @@ func (x *DeleteForwardZoneReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteForwardZoneReq.ProtoReflect.Descriptor instead.
 func (*DeleteForwardZoneReq) Descriptor() ([]byte, []int) {
-	return file_dns_proto_rawDescGZIP(), []int{33}
+	return file_dns_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DeleteForwardZoneReq) GetView() string {
@@ -2138,6 +2730,13 @@ func (x *DeleteForwardZoneReq) GetName() string {
 	return ""
 }
 
+func (x *DeleteForwardZoneReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type FlushForwardZoneReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2150,7 +2749,7 @@ type FlushForwardZoneReq struct {
 func (x *FlushForwardZoneReq) Reset() {
 	*x = FlushForwardZoneReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dns_proto_msgTypes[34]
+		mi := &file_dns_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2163,7 +2762,7 @@ func (x *FlushForwardZoneReq) String() string {
 func (*FlushForwardZoneReq) ProtoMessage() {}
 
 func (x *FlushForwardZoneReq) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_proto_msgTypes[34]
+	mi := &file_dns_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2176,7 +2775,7 @@ func (x *FlushForwardZoneReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlushForwardZoneReq.ProtoReflect.Descriptor instead.
 func (*FlushForwardZoneReq) Descriptor() ([]byte, []int) {
-	return file_dns_proto_rawDescGZIP(), []int{34}
+	return file_dns_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *FlushForwardZoneReq) GetNewForwardZones() []*FlushForwardZoneReqForwardZone {
@@ -2208,7 +2807,7 @@ type UploadLogReq struct {
 func (x *UploadLogReq) Reset() {
 	*x = UploadLogReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dns_proto_msgTypes[35]
+		mi := &file_dns_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2221,7 +2820,7 @@ func (x *UploadLogReq) String() string {
 func (*UploadLogReq) ProtoMessage() {}
 
 func (x *UploadLogReq) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_proto_msgTypes[35]
+	mi := &file_dns_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2234,7 +2833,7 @@ func (x *UploadLogReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadLogReq.ProtoReflect.Descriptor instead.
 func (*UploadLogReq) Descriptor() ([]byte, []int) {
-	return file_dns_proto_rawDescGZIP(), []int{35}
+	return file_dns_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *UploadLogReq) GetId() string {
@@ -2272,34 +2871,31 @@ func (x *UploadLogReq) GetMasterNodeIp() string {
 	return ""
 }
 
-type FlushForwardZoneReqForwardZone struct {
+type GetDNSTopStatsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	View         string   `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
-	Name         string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ForwardStyle string   `protobuf:"bytes,3,opt,name=forward_style,json=forwardStyle,proto3" json:"forward_style,omitempty"`
-	ForwardIps   []string `protobuf:"bytes,4,rep,name=forward_ips,json=forwardIps,proto3" json:"forward_ips,omitempty"`
+	TopN uint32 `protobuf:"varint,1,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
 }
 
-func (x *FlushForwardZoneReqForwardZone) Reset() {
-	*x = FlushForwardZoneReqForwardZone{}
+func (x *GetDNSTopStatsReq) Reset() {
+	*x = GetDNSTopStatsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dns_proto_msgTypes[36]
+		mi := &file_dns_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FlushForwardZoneReqForwardZone) String() string {
+func (x *GetDNSTopStatsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FlushForwardZoneReqForwardZone) ProtoMessage() {}
+func (*GetDNSTopStatsReq) ProtoMessage() {}
 
-func (x *FlushForwardZoneReqForwardZone) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_proto_msgTypes[36]
+func (x *GetDNSTopStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2310,451 +2906,2300 @@ func (x *FlushForwardZoneReqForwardZone) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FlushForwardZoneReqForwardZone.ProtoReflect.Descriptor instead.
-func (*FlushForwardZoneReqForwardZone) Descriptor() ([]byte, []int) {
-	return file_dns_proto_rawDescGZIP(), []int{34, 0}
+// Deprecated: Use GetDNSTopStatsReq.ProtoReflect.Descriptor instead.
+func (*GetDNSTopStatsReq) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *FlushForwardZoneReqForwardZone) GetView() string {
+func (x *GetDNSTopStatsReq) GetTopN() uint32 {
 	if x != nil {
-		return x.View
+		return x.TopN
 	}
-	return ""
+	return 0
 }
 
-func (x *FlushForwardZoneReqForwardZone) GetName() string {
-	if x != nil {
-		return x.Name
+type TopStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TopStat) Reset() {
+	*x = TopStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *FlushForwardZoneReqForwardZone) GetForwardStyle() string {
+func (x *TopStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopStat) ProtoMessage() {}
+
+func (x *TopStat) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopStat.ProtoReflect.Descriptor instead.
+func (*TopStat) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TopStat) GetKey() string {
 	if x != nil {
-		return x.ForwardStyle
+		return x.Key
 	}
 	return ""
 }
 
-func (x *FlushForwardZoneReqForwardZone) GetForwardIps() []string {
+func (x *TopStat) GetCount() uint64 {
 	if x != nil {
-		return x.ForwardIps
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-var File_dns_proto protoreflect.FileDescriptor
+type ViewQueryTypes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_dns_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x64, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x12, 0x64, 0x64, 0x69, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x25, 0x0a, 0x0b, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x0c, 0x0a,
-	0x0a, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x22, 0xd8, 0x02, 0x0a, 0x15,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6e, 0x73, 0x73, 0x65, 0x63, 0x5f, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x6e, 0x73,
-	0x73, 0x65, 0x63, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x62,
-	0x6c, 0x61, 0x63, 0x6b, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x68, 0x6f, 0x6c, 0x65,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x68,
-	0x6f, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x61, 0x63,
-	0x6b, 0x68, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65,
-	0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23,
-	0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50,
-	0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x3b, 0x0a, 0x03, 0x41, 0x63, 0x6c, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03,
-	0x69, 0x70, 0x73, 0x22, 0x2c, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x6c,
-	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x6c, 0x52, 0x03, 0x61, 0x63,
-	0x6c, 0x22, 0x33, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x6c,
-	0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x22, 0x2c, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x6c, 0x52,
-	0x03, 0x61, 0x63, 0x6c, 0x22, 0x1e, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63,
-	0x6c, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72,
-	0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6c, 0x61,
-	0x76, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c, 0x61, 0x76, 0x65,
-	0x73, 0x22, 0x40, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a,
-	0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5a, 0x6f,
-	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5a,
-	0x6f, 0x6e, 0x65, 0x22, 0x40, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68,
-	0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x08, 0x61, 0x75, 0x74,
-	0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x3b, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69,
-	0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x7e, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
-	0x5a, 0x6f, 0x6e, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2b,
-	0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e,
-	0x65, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x0d, 0x61,
-	0x75, 0x74, 0x68, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a,
-	0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52,
-	0x72, 0x73, 0x22, 0x7d, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
-	0x5a, 0x6f, 0x6e, 0x65, 0x41, 0x58, 0x46, 0x52, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52,
-	0x09, 0x61, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x61, 0x75,
-	0x74, 0x68, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f,
-	0x6e, 0x65, 0x52, 0x52, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x72,
-	0x73, 0x22, 0xba, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
-	0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x58, 0x46, 0x52, 0x52, 0x65, 0x71, 0x12, 0x3c, 0x0a, 0x11, 0x6f,
-	0x6c, 0x64, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x41, 0x75,
-	0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x11, 0x6e, 0x65, 0x77,
-	0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74,
-	0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x41, 0x75, 0x74, 0x68,
-	0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x6f, 0x61, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x04, 0x73, 0x6f, 0x61, 0x73, 0x22, 0x9f,
-	0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x12, 0x12, 0x0a,
-	0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65,
-	0x77, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x74, 0x74, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x72, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79,
-	0x22, 0x73, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x52, 0x52, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x0d, 0x61, 0x75, 0x74,
-	0x68, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e,
-	0x65, 0x52, 0x52, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x72, 0x73,
-	0x12, 0x23, 0x0a, 0x03, 0x73, 0x6f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52,
-	0x52, 0x03, 0x73, 0x6f, 0x61, 0x22, 0x59, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x52, 0x52, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x02, 0x72, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74,
-	0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x02, 0x72, 0x72, 0x12, 0x23, 0x0a, 0x03, 0x73,
-	0x6f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x03, 0x73, 0x6f, 0x61,
-	0x22, 0x8a, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52,
-	0x52, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x06, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74,
-	0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x05, 0x6f, 0x6c, 0x64, 0x52, 0x72, 0x12, 0x28,
-	0x0a, 0x06, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52,
-	0x52, 0x52, 0x05, 0x6e, 0x65, 0x77, 0x52, 0x72, 0x12, 0x23, 0x0a, 0x03, 0x73, 0x6f, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x03, 0x73, 0x6f, 0x61, 0x22, 0x59, 0x0a,
-	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x52, 0x65, 0x71,
-	0x12, 0x21, 0x0a, 0x02, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52,
-	0x02, 0x72, 0x72, 0x12, 0x23, 0x0a, 0x03, 0x73, 0x6f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e,
-	0x65, 0x52, 0x52, 0x52, 0x03, 0x73, 0x6f, 0x61, 0x22, 0xe3, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6e,
-	0x73, 0x36, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6e, 0x73, 0x36, 0x34,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0d, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x52, 0x0c, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x3a,
-	0x0a, 0x0c, 0x56, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xbd, 0x01, 0x0a, 0x0d, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6e, 0x73, 0x36,
-	0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6e, 0x73, 0x36, 0x34, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x63,
-	0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x38, 0x0a, 0x0d, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x56, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x76, 0x69,
-	0x65, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x59, 0x0a, 0x0d, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x0d, 0x76,
-	0x69, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x50,
-	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x7e, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e,
-	0x67, 0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
-	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x68, 0x74, 0x74,
-	0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x48, 0x74, 0x74, 0x70,
-	0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x03, 0x63, 0x72, 0x74, 0x22, 0x5a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e,
-	0x67, 0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
-	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x68, 0x74, 0x74,
-	0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x48, 0x74, 0x74, 0x70,
-	0x73, 0x22, 0x48, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x67, 0x69, 0x6e, 0x78,
-	0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x48, 0x74, 0x74, 0x70, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x0b,
-	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x76,
-	0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x4c, 0x0a, 0x14, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
-	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x90, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x12, 0x3b, 0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6f,
-	0x6c, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a,
-	0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
-	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x52,
-	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x14, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65,
-	0x71, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x6f, 0x72,
-	0x77, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x81, 0x01, 0x0a,
-	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f,
-	0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x79,
-	0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x22, 0x3e, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0xba, 0x02, 0x0a, 0x13, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x52, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f,
-	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x75, 0x73,
-	0x68, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x2e,
-	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x0f, 0x6e, 0x65, 0x77,
-	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x11,
-	0x6f, 0x6c, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x7a, 0x6f, 0x6e, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x46, 0x6c, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65,
-	0x52, 0x65, 0x71, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52,
-	0x0f, 0x6f, 0x6c, 0x64, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x73,
-	0x1a, 0x7b, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76,
-	0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x49, 0x70, 0x73, 0x22, 0x8e, 0x01,
-	0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x70, 0x32, 0x88,
-	0x10, 0x0a, 0x0c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12,
-	0x34, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x4e, 0x53, 0x12, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x4e, 0x53,
-	0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x6f, 0x70,
-	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x63, 0x6c, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x36, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x6c, 0x12, 0x13,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x6c,
-	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x41, 0x63, 0x6c, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x40, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x6c, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x65,
-	0x77, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a,
-	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65,
-	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x40, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a,
-	0x6f, 0x6e, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a,
-	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65,
-	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x73,
-	0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x73, 0x52, 0x65,
-	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x41, 0x58, 0x46, 0x52, 0x12, 0x1c, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
-	0x5a, 0x6f, 0x6e, 0x65, 0x41, 0x58, 0x46, 0x52, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x48, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a,
-	0x6f, 0x6e, 0x65, 0x49, 0x58, 0x46, 0x52, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x58,
-	0x46, 0x52, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44,
-	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65,
-	0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72,
-	0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f,
-	0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44,
-	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65,
-	0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x10, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x72, 0x77,
-	0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x46, 0x6c, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x52, 0x65,
-	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x52, 0x65, 0x71, 0x1a,
-	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x52, 0x52, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x52, 0x52, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a,
-	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a,
-	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a,
-	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a,
-	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e,
-	0x67, 0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x67, 0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44,
-	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x10, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x67, 0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12,
-	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x67,
-	0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x44, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x67, 0x69, 0x6e, 0x78,
-	0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4e, 0x67, 0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65,
-	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62,
-	0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x36, 0x0a, 0x09, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x13,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67,
-	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	View   string            `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	Qtypes map[string]uint64 `protobuf:"bytes,2,rep,name=qtypes,proto3" json:"qtypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-var (
-	file_dns_proto_rawDescOnce sync.Once
-	file_dns_proto_rawDescData = file_dns_proto_rawDesc
-)
+func (x *ViewQueryTypes) Reset() {
+	*x = ViewQueryTypes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_dns_proto_rawDescGZIP() []byte {
-	file_dns_proto_rawDescOnce.Do(func() {
-		file_dns_proto_rawDescData = protoimpl.X.CompressGZIP(file_dns_proto_rawDescData)
-	})
-	return file_dns_proto_rawDescData
+func (x *ViewQueryTypes) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_dns_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
-var file_dns_proto_goTypes = []interface{}{
-	(*DNSStartReq)(nil),                    // 0: proto.DNSStartReq
-	(*DNSStopReq)(nil),                     // 1: proto.DNSStopReq
+func (*ViewQueryTypes) ProtoMessage() {}
+
+func (x *ViewQueryTypes) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewQueryTypes.ProtoReflect.Descriptor instead.
+func (*ViewQueryTypes) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ViewQueryTypes) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
+func (x *ViewQueryTypes) GetQtypes() map[string]uint64 {
+	if x != nil {
+		return x.Qtypes
+	}
+	return nil
+}
+
+type GetDNSTopStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Succeed       bool              `protobuf:"varint,1,opt,name=succeed,proto3" json:"succeed,omitempty"`
+	WindowSeconds uint32            `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	TopNames      []*TopStat        `protobuf:"bytes,3,rep,name=top_names,json=topNames,proto3" json:"top_names,omitempty"`
+	TopClients    []*TopStat        `protobuf:"bytes,4,rep,name=top_clients,json=topClients,proto3" json:"top_clients,omitempty"`
+	TopNxdomains  []*TopStat        `protobuf:"bytes,5,rep,name=top_nxdomains,json=topNxdomains,proto3" json:"top_nxdomains,omitempty"`
+	ViewQtypes    []*ViewQueryTypes `protobuf:"bytes,6,rep,name=view_qtypes,json=viewQtypes,proto3" json:"view_qtypes,omitempty"`
+}
+
+func (x *GetDNSTopStatsResponse) Reset() {
+	*x = GetDNSTopStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDNSTopStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDNSTopStatsResponse) ProtoMessage() {}
+
+func (x *GetDNSTopStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDNSTopStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetDNSTopStatsResponse) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetDNSTopStatsResponse) GetSucceed() bool {
+	if x != nil {
+		return x.Succeed
+	}
+	return false
+}
+
+func (x *GetDNSTopStatsResponse) GetWindowSeconds() uint32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *GetDNSTopStatsResponse) GetTopNames() []*TopStat {
+	if x != nil {
+		return x.TopNames
+	}
+	return nil
+}
+
+func (x *GetDNSTopStatsResponse) GetTopClients() []*TopStat {
+	if x != nil {
+		return x.TopClients
+	}
+	return nil
+}
+
+func (x *GetDNSTopStatsResponse) GetTopNxdomains() []*TopStat {
+	if x != nil {
+		return x.TopNxdomains
+	}
+	return nil
+}
+
+func (x *GetDNSTopStatsResponse) GetViewQtypes() []*ViewQueryTypes {
+	if x != nil {
+		return x.ViewQtypes
+	}
+	return nil
+}
+
+type ListConfigSnapshotsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListConfigSnapshotsReq) Reset() {
+	*x = ListConfigSnapshotsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConfigSnapshotsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigSnapshotsReq) ProtoMessage() {}
+
+func (x *ListConfigSnapshotsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigSnapshotsReq.ProtoReflect.Descriptor instead.
+func (*ListConfigSnapshotsReq) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{41}
+}
+
+type ConfigSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreateTime string `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	FileCount  uint32 `protobuf:"varint,3,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	Size       uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ConfigSnapshot) Reset() {
+	*x = ConfigSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSnapshot) ProtoMessage() {}
+
+func (x *ConfigSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSnapshot.ProtoReflect.Descriptor instead.
+func (*ConfigSnapshot) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ConfigSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConfigSnapshot) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+func (x *ConfigSnapshot) GetFileCount() uint32 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+func (x *ConfigSnapshot) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListConfigSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Succeed         bool              `protobuf:"varint,1,opt,name=succeed,proto3" json:"succeed,omitempty"`
+	Snapshots       []*ConfigSnapshot `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	DriftSnapshotId string            `protobuf:"bytes,3,opt,name=drift_snapshot_id,json=driftSnapshotId,proto3" json:"drift_snapshot_id,omitempty"`
+	DriftTime       string            `protobuf:"bytes,4,opt,name=drift_time,json=driftTime,proto3" json:"drift_time,omitempty"`
+}
+
+func (x *ListConfigSnapshotsResponse) Reset() {
+	*x = ListConfigSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConfigSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListConfigSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListConfigSnapshotsResponse) GetSucceed() bool {
+	if x != nil {
+		return x.Succeed
+	}
+	return false
+}
+
+func (x *ListConfigSnapshotsResponse) GetSnapshots() []*ConfigSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *ListConfigSnapshotsResponse) GetDriftSnapshotId() string {
+	if x != nil {
+		return x.DriftSnapshotId
+	}
+	return ""
+}
+
+func (x *ListConfigSnapshotsResponse) GetDriftTime() string {
+	if x != nil {
+		return x.DriftTime
+	}
+	return ""
+}
+
+type DiffConfigSnapshotsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromId string `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId   string `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+}
+
+func (x *DiffConfigSnapshotsReq) Reset() {
+	*x = DiffConfigSnapshotsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffConfigSnapshotsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffConfigSnapshotsReq) ProtoMessage() {}
+
+func (x *DiffConfigSnapshotsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffConfigSnapshotsReq.ProtoReflect.Descriptor instead.
+func (*DiffConfigSnapshotsReq) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DiffConfigSnapshotsReq) GetFromId() string {
+	if x != nil {
+		return x.FromId
+	}
+	return ""
+}
+
+func (x *DiffConfigSnapshotsReq) GetToId() string {
+	if x != nil {
+		return x.ToId
+	}
+	return ""
+}
+
+type DiffConfigSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Succeed bool              `protobuf:"varint,1,opt,name=succeed,proto3" json:"succeed,omitempty"`
+	Files   []*ConfigFileDiff `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *DiffConfigSnapshotsResponse) Reset() {
+	*x = DiffConfigSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffConfigSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffConfigSnapshotsResponse) ProtoMessage() {}
+
+func (x *DiffConfigSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffConfigSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*DiffConfigSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DiffConfigSnapshotsResponse) GetSucceed() bool {
+	if x != nil {
+		return x.Succeed
+	}
+	return false
+}
+
+func (x *DiffConfigSnapshotsResponse) GetFiles() []*ConfigFileDiff {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type RollbackConfigSnapshotReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RollbackConfigSnapshotReq) Reset() {
+	*x = RollbackConfigSnapshotReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackConfigSnapshotReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackConfigSnapshotReq) ProtoMessage() {}
+
+func (x *RollbackConfigSnapshotReq) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackConfigSnapshotReq.ProtoReflect.Descriptor instead.
+func (*RollbackConfigSnapshotReq) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RollbackConfigSnapshotReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DiagnoseQueryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type             string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	View             string `protobuf:"bytes,3,opt,name=view,proto3" json:"view,omitempty"`
+	SourceAddress    string `protobuf:"bytes,4,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	RecursionDesired bool   `protobuf:"varint,5,opt,name=recursion_desired,json=recursionDesired,proto3" json:"recursion_desired,omitempty"`
+	DnssecOk         bool   `protobuf:"varint,6,opt,name=dnssec_ok,json=dnssecOk,proto3" json:"dnssec_ok,omitempty"`
+	ClientSubnet     string `protobuf:"bytes,7,opt,name=client_subnet,json=clientSubnet,proto3" json:"client_subnet,omitempty"`
+	Trace            bool   `protobuf:"varint,8,opt,name=trace,proto3" json:"trace,omitempty"`
+	TimeoutSeconds   uint32 `protobuf:"varint,9,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+}
+
+func (x *DiagnoseQueryReq) Reset() {
+	*x = DiagnoseQueryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnoseQueryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseQueryReq) ProtoMessage() {}
+
+func (x *DiagnoseQueryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseQueryReq.ProtoReflect.Descriptor instead.
+func (*DiagnoseQueryReq) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DiagnoseQueryReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiagnoseQueryReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DiagnoseQueryReq) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
+func (x *DiagnoseQueryReq) GetSourceAddress() string {
+	if x != nil {
+		return x.SourceAddress
+	}
+	return ""
+}
+
+func (x *DiagnoseQueryReq) GetRecursionDesired() bool {
+	if x != nil {
+		return x.RecursionDesired
+	}
+	return false
+}
+
+func (x *DiagnoseQueryReq) GetDnssecOk() bool {
+	if x != nil {
+		return x.DnssecOk
+	}
+	return false
+}
+
+func (x *DiagnoseQueryReq) GetClientSubnet() string {
+	if x != nil {
+		return x.ClientSubnet
+	}
+	return ""
+}
+
+func (x *DiagnoseQueryReq) GetTrace() bool {
+	if x != nil {
+		return x.Trace
+	}
+	return false
+}
+
+func (x *DiagnoseQueryReq) GetTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type DiagnoseRR struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ttl   uint32 `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Class string `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"`
+	Type  string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Rdata string `protobuf:"bytes,5,opt,name=rdata,proto3" json:"rdata,omitempty"`
+}
+
+func (x *DiagnoseRR) Reset() {
+	*x = DiagnoseRR{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnoseRR) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseRR) ProtoMessage() {}
+
+func (x *DiagnoseRR) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseRR.ProtoReflect.Descriptor instead.
+func (*DiagnoseRR) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DiagnoseRR) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiagnoseRR) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *DiagnoseRR) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *DiagnoseRR) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DiagnoseRR) GetRdata() string {
+	if x != nil {
+		return x.Rdata
+	}
+	return ""
+}
+
+type DiagnoseMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Server              string        `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Zone                string        `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	Id                  uint32        `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Opcode              string        `protobuf:"bytes,4,opt,name=opcode,proto3" json:"opcode,omitempty"`
+	Rcode               string        `protobuf:"bytes,5,opt,name=rcode,proto3" json:"rcode,omitempty"`
+	Flags               []string      `protobuf:"bytes,6,rep,name=flags,proto3" json:"flags,omitempty"`
+	Answer              []*DiagnoseRR `protobuf:"bytes,7,rep,name=answer,proto3" json:"answer,omitempty"`
+	Authority           []*DiagnoseRR `protobuf:"bytes,8,rep,name=authority,proto3" json:"authority,omitempty"`
+	Additional          []*DiagnoseRR `protobuf:"bytes,9,rep,name=additional,proto3" json:"additional,omitempty"`
+	Edns                bool          `protobuf:"varint,10,opt,name=edns,proto3" json:"edns,omitempty"`
+	EdnsUdpSize         uint32        `protobuf:"varint,11,opt,name=edns_udp_size,json=ednsUdpSize,proto3" json:"edns_udp_size,omitempty"`
+	DnssecOk            bool          `protobuf:"varint,12,opt,name=dnssec_ok,json=dnssecOk,proto3" json:"dnssec_ok,omitempty"`
+	EdnsOptions         []string      `protobuf:"bytes,13,rep,name=edns_options,json=ednsOptions,proto3" json:"edns_options,omitempty"`
+	Tsig                bool          `protobuf:"varint,14,opt,name=tsig,proto3" json:"tsig,omitempty"`
+	ElapsedMicroseconds uint64        `protobuf:"varint,15,opt,name=elapsed_microseconds,json=elapsedMicroseconds,proto3" json:"elapsed_microseconds,omitempty"`
+	ErrorMessage        string        `protobuf:"bytes,16,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *DiagnoseMessage) Reset() {
+	*x = DiagnoseMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnoseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseMessage) ProtoMessage() {}
+
+func (x *DiagnoseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseMessage.ProtoReflect.Descriptor instead.
+func (*DiagnoseMessage) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DiagnoseMessage) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *DiagnoseMessage) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *DiagnoseMessage) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DiagnoseMessage) GetOpcode() string {
+	if x != nil {
+		return x.Opcode
+	}
+	return ""
+}
+
+func (x *DiagnoseMessage) GetRcode() string {
+	if x != nil {
+		return x.Rcode
+	}
+	return ""
+}
+
+func (x *DiagnoseMessage) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *DiagnoseMessage) GetAnswer() []*DiagnoseRR {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+func (x *DiagnoseMessage) GetAuthority() []*DiagnoseRR {
+	if x != nil {
+		return x.Authority
+	}
+	return nil
+}
+
+func (x *DiagnoseMessage) GetAdditional() []*DiagnoseRR {
+	if x != nil {
+		return x.Additional
+	}
+	return nil
+}
+
+func (x *DiagnoseMessage) GetEdns() bool {
+	if x != nil {
+		return x.Edns
+	}
+	return false
+}
+
+func (x *DiagnoseMessage) GetEdnsUdpSize() uint32 {
+	if x != nil {
+		return x.EdnsUdpSize
+	}
+	return 0
+}
+
+func (x *DiagnoseMessage) GetDnssecOk() bool {
+	if x != nil {
+		return x.DnssecOk
+	}
+	return false
+}
+
+func (x *DiagnoseMessage) GetEdnsOptions() []string {
+	if x != nil {
+		return x.EdnsOptions
+	}
+	return nil
+}
+
+func (x *DiagnoseMessage) GetTsig() bool {
+	if x != nil {
+		return x.Tsig
+	}
+	return false
+}
+
+func (x *DiagnoseMessage) GetElapsedMicroseconds() uint64 {
+	if x != nil {
+		return x.ElapsedMicroseconds
+	}
+	return 0
+}
+
+func (x *DiagnoseMessage) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type DiagnoseQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Succeed  bool               `protobuf:"varint,1,opt,name=succeed,proto3" json:"succeed,omitempty"`
+	Messages []*DiagnoseMessage `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *DiagnoseQueryResponse) Reset() {
+	*x = DiagnoseQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnoseQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseQueryResponse) ProtoMessage() {}
+
+func (x *DiagnoseQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseQueryResponse.ProtoReflect.Descriptor instead.
+func (*DiagnoseQueryResponse) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DiagnoseQueryResponse) GetSucceed() bool {
+	if x != nil {
+		return x.Succeed
+	}
+	return false
+}
+
+func (x *DiagnoseQueryResponse) GetMessages() []*DiagnoseMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type FlushDNSCacheReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View string `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tree bool   `protobuf:"varint,3,opt,name=tree,proto3" json:"tree,omitempty"`
+}
+
+func (x *FlushDNSCacheReq) Reset() {
+	*x = FlushDNSCacheReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushDNSCacheReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushDNSCacheReq) ProtoMessage() {}
+
+func (x *FlushDNSCacheReq) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushDNSCacheReq.ProtoReflect.Descriptor instead.
+func (*FlushDNSCacheReq) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *FlushDNSCacheReq) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
+func (x *FlushDNSCacheReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FlushDNSCacheReq) GetTree() bool {
+	if x != nil {
+		return x.Tree
+	}
+	return false
+}
+
+type GetDNSCacheStatsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View string `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+}
+
+func (x *GetDNSCacheStatsReq) Reset() {
+	*x = GetDNSCacheStatsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDNSCacheStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDNSCacheStatsReq) ProtoMessage() {}
+
+func (x *GetDNSCacheStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDNSCacheStatsReq.ProtoReflect.Descriptor instead.
+func (*GetDNSCacheStatsReq) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetDNSCacheStatsReq) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
+type DNSCacheStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View        string            `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	Size        uint64            `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Entries     uint64            `protobuf:"varint,3,opt,name=entries,proto3" json:"entries,omitempty"`
+	QueryHits   uint64            `protobuf:"varint,4,opt,name=query_hits,json=queryHits,proto3" json:"query_hits,omitempty"`
+	QueryMisses uint64            `protobuf:"varint,5,opt,name=query_misses,json=queryMisses,proto3" json:"query_misses,omitempty"`
+	HitRatio    float64           `protobuf:"fixed64,6,opt,name=hit_ratio,json=hitRatio,proto3" json:"hit_ratio,omitempty"`
+	Counters    map[string]uint64 `protobuf:"bytes,7,rep,name=counters,proto3" json:"counters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Rrsets      map[string]uint64 `protobuf:"bytes,8,rep,name=rrsets,proto3" json:"rrsets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *DNSCacheStats) Reset() {
+	*x = DNSCacheStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DNSCacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DNSCacheStats) ProtoMessage() {}
+
+func (x *DNSCacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DNSCacheStats.ProtoReflect.Descriptor instead.
+func (*DNSCacheStats) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DNSCacheStats) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
+func (x *DNSCacheStats) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DNSCacheStats) GetEntries() uint64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *DNSCacheStats) GetQueryHits() uint64 {
+	if x != nil {
+		return x.QueryHits
+	}
+	return 0
+}
+
+func (x *DNSCacheStats) GetQueryMisses() uint64 {
+	if x != nil {
+		return x.QueryMisses
+	}
+	return 0
+}
+
+func (x *DNSCacheStats) GetHitRatio() float64 {
+	if x != nil {
+		return x.HitRatio
+	}
+	return 0
+}
+
+func (x *DNSCacheStats) GetCounters() map[string]uint64 {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *DNSCacheStats) GetRrsets() map[string]uint64 {
+	if x != nil {
+		return x.Rrsets
+	}
+	return nil
+}
+
+type GetDNSCacheStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Succeed bool             `protobuf:"varint,1,opt,name=succeed,proto3" json:"succeed,omitempty"`
+	Views   []*DNSCacheStats `protobuf:"bytes,2,rep,name=views,proto3" json:"views,omitempty"`
+}
+
+func (x *GetDNSCacheStatsResponse) Reset() {
+	*x = GetDNSCacheStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDNSCacheStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDNSCacheStatsResponse) ProtoMessage() {}
+
+func (x *GetDNSCacheStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDNSCacheStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetDNSCacheStatsResponse) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetDNSCacheStatsResponse) GetSucceed() bool {
+	if x != nil {
+		return x.Succeed
+	}
+	return false
+}
+
+func (x *GetDNSCacheStatsResponse) GetViews() []*DNSCacheStats {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+type DumpDNSCacheReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View string `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DumpDNSCacheReq) Reset() {
+	*x = DumpDNSCacheReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DumpDNSCacheReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpDNSCacheReq) ProtoMessage() {}
+
+func (x *DumpDNSCacheReq) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpDNSCacheReq.ProtoReflect.Descriptor instead.
+func (*DumpDNSCacheReq) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DumpDNSCacheReq) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
+func (x *DumpDNSCacheReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DNSCacheEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View  string `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ttl   uint32 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Type  string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Rdata string `protobuf:"bytes,5,opt,name=rdata,proto3" json:"rdata,omitempty"`
+}
+
+func (x *DNSCacheEntry) Reset() {
+	*x = DNSCacheEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DNSCacheEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DNSCacheEntry) ProtoMessage() {}
+
+func (x *DNSCacheEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DNSCacheEntry.ProtoReflect.Descriptor instead.
+func (*DNSCacheEntry) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DNSCacheEntry) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
+func (x *DNSCacheEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DNSCacheEntry) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *DNSCacheEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DNSCacheEntry) GetRdata() string {
+	if x != nil {
+		return x.Rdata
+	}
+	return ""
+}
+
+type DumpDNSCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Succeed bool             `protobuf:"varint,1,opt,name=succeed,proto3" json:"succeed,omitempty"`
+	Entries []*DNSCacheEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *DumpDNSCacheResponse) Reset() {
+	*x = DumpDNSCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DumpDNSCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpDNSCacheResponse) ProtoMessage() {}
+
+func (x *DumpDNSCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpDNSCacheResponse.ProtoReflect.Descriptor instead.
+func (*DumpDNSCacheResponse) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DumpDNSCacheResponse) GetSucceed() bool {
+	if x != nil {
+		return x.Succeed
+	}
+	return false
+}
+
+func (x *DumpDNSCacheResponse) GetEntries() []*DNSCacheEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type FlushForwardZoneReqForwardZone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View          string   `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	Name          string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ForwardStyle  string   `protobuf:"bytes,3,opt,name=forward_style,json=forwardStyle,proto3" json:"forward_style,omitempty"`
+	ForwardIps    []string `protobuf:"bytes,4,rep,name=forward_ips,json=forwardIps,proto3" json:"forward_ips,omitempty"`
+	TlsEnable     bool     `protobuf:"varint,5,opt,name=tls_enable,json=tlsEnable,proto3" json:"tls_enable,omitempty"`
+	TlsServerName string   `protobuf:"bytes,6,opt,name=tls_server_name,json=tlsServerName,proto3" json:"tls_server_name,omitempty"`
+	TlsCaBundle   []byte   `protobuf:"bytes,7,opt,name=tls_ca_bundle,json=tlsCaBundle,proto3" json:"tls_ca_bundle,omitempty"`
+}
+
+func (x *FlushForwardZoneReqForwardZone) Reset() {
+	*x = FlushForwardZoneReqForwardZone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dns_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushForwardZoneReqForwardZone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushForwardZoneReqForwardZone) ProtoMessage() {}
+
+func (x *FlushForwardZoneReqForwardZone) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushForwardZoneReqForwardZone.ProtoReflect.Descriptor instead.
+func (*FlushForwardZoneReqForwardZone) Descriptor() ([]byte, []int) {
+	return file_dns_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *FlushForwardZoneReqForwardZone) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
+func (x *FlushForwardZoneReqForwardZone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FlushForwardZoneReqForwardZone) GetForwardStyle() string {
+	if x != nil {
+		return x.ForwardStyle
+	}
+	return ""
+}
+
+func (x *FlushForwardZoneReqForwardZone) GetForwardIps() []string {
+	if x != nil {
+		return x.ForwardIps
+	}
+	return nil
+}
+
+func (x *FlushForwardZoneReqForwardZone) GetTlsEnable() bool {
+	if x != nil {
+		return x.TlsEnable
+	}
+	return false
+}
+
+func (x *FlushForwardZoneReqForwardZone) GetTlsServerName() string {
+	if x != nil {
+		return x.TlsServerName
+	}
+	return ""
+}
+
+func (x *FlushForwardZoneReqForwardZone) GetTlsCaBundle() []byte {
+	if x != nil {
+		return x.TlsCaBundle
+	}
+	return nil
+}
+
+var File_dns_proto protoreflect.FileDescriptor
+
+var file_dns_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x64, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x12, 0x64, 0x64, 0x69, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x25, 0x0a, 0x0b, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x0c, 0x0a,
+	0x0a, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x22, 0xba, 0x04, 0x0a, 0x15,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6e, 0x73, 0x73, 0x65, 0x63, 0x5f, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x6e, 0x73,
+	0x73, 0x65, 0x63, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x62,
+	0x6c, 0x61, 0x63, 0x6b, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x68, 0x6f, 0x6c, 0x65,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x68,
+	0x6f, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x61, 0x63,
+	0x6b, 0x68, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65,
+	0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x6f, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x6f, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x64, 0x6f, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x64, 0x6f, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6f, 0x68,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64,
+	0x6f, 0x68, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x6f, 0x68, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x6f, 0x68, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x68, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x68, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6c, 0x73, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x72, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x74, 0x6c, 0x73, 0x43, 0x72, 0x74, 0x22, 0x3b, 0x0a, 0x03, 0x41, 0x63, 0x6c, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x03, 0x69, 0x70, 0x73, 0x22, 0x2c, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x6c, 0x52, 0x03,
+	0x61, 0x63, 0x6c, 0x22, 0x33, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x63, 0x6c, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x22, 0x2c, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63,
+	0x6c, 0x52, 0x03, 0x61, 0x63, 0x6c, 0x22, 0x1e, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x5a,
+	0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6c, 0x61, 0x76, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c, 0x61,
+	0x76, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68,
+	0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x08, 0x61, 0x75, 0x74,
+	0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x59,
+	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x54, 0x0a, 0x11, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22,
+	0x7e, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x61,
+	0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x08,
+	0x61, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65,
+	0x52, 0x52, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x72, 0x73, 0x22,
+	0x7d, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e,
+	0x65, 0x41, 0x58, 0x46, 0x52, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52,
+	0x52, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x72, 0x73, 0x22, 0xba,
+	0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e,
+	0x65, 0x49, 0x58, 0x46, 0x52, 0x52, 0x65, 0x71, 0x12, 0x3c, 0x0a, 0x11, 0x6f, 0x6c, 0x64, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x41, 0x75, 0x74, 0x68, 0x5a,
+	0x6f, 0x6e, 0x65, 0x52, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f,
+	0x6e, 0x65, 0x52, 0x52, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e,
+	0x65, 0x52, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x6f, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a,
+	0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x04, 0x73, 0x6f, 0x61, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0a,
+	0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12,
+	0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f,
+	0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x22, 0x8c, 0x01,
+	0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x52, 0x52, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52,
+	0x52, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x72, 0x73, 0x12, 0x23,
+	0x0a, 0x03, 0x73, 0x6f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x03,
+	0x73, 0x6f, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x72, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x52, 0x65, 0x71, 0x12,
+	0x21, 0x0a, 0x02, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x02,
+	0x72, 0x72, 0x12, 0x23, 0x0a, 0x03, 0x73, 0x6f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65,
+	0x52, 0x52, 0x52, 0x03, 0x73, 0x6f, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0xa3, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x52, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x06, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x05, 0x6f, 0x6c, 0x64, 0x52, 0x72, 0x12, 0x28,
+	0x0a, 0x06, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52,
+	0x52, 0x52, 0x05, 0x6e, 0x65, 0x77, 0x52, 0x72, 0x12, 0x23, 0x0a, 0x03, 0x73, 0x6f, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x03, 0x73, 0x6f, 0x61, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x72, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x02, 0x72, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x02, 0x72, 0x72, 0x12, 0x23, 0x0a, 0x03,
+	0x73, 0x6f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x52, 0x52, 0x03, 0x73, 0x6f,
+	0x61, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xdf, 0x02, 0x0a, 0x0d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x6e, 0x73, 0x36, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6e, 0x73,
+	0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63,
+	0x75, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0d, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x0c, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6c,
+	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x6c, 0x73,
+	0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x72, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x6c, 0x73, 0x43, 0x72, 0x74, 0x22, 0x3a, 0x0a, 0x0c,
+	0x56, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xb9, 0x02, 0x0a, 0x0d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6e, 0x73, 0x36, 0x34, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6e, 0x73, 0x36, 0x34, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x63, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38,
+	0x0a, 0x0d, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69,
+	0x65, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x76, 0x69, 0x65, 0x77,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6c, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x6c, 0x73, 0x5f, 0x63, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x6c,
+	0x73, 0x43, 0x72, 0x74, 0x22, 0x72, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x0d, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x0c, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xf8, 0x01, 0x0a, 0x0d, 0x4e, 0x67, 0x69,
+	0x6e, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x62, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x62, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x66, 0x61, 0x69, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0xb6, 0x04, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x67,
+	0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x68, 0x74, 0x74, 0x70,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x48, 0x74, 0x74, 0x70, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x63, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x62, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x62, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x61, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55,
+	0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4e, 0x67, 0x69, 0x6e, 0x78, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x68,
+	0x74, 0x74, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x54, 0x6f, 0x48, 0x74, 0x74, 0x70, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x73, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68,
+	0x73, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x73, 0x74, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x68, 0x73, 0x74, 0x73, 0x4d,
+	0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6c, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6c, 0x73, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6c, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74,
+	0x6c, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6c, 0x73, 0x5f, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x6c, 0x73, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x73, 0x22, 0x92, 0x04, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x67, 0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x19,
+	0x0a, 0x08, 0x69, 0x73, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x69, 0x73, 0x48, 0x74, 0x74, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x62, 0x5f, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x62, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x09,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x67, 0x69, 0x6e, 0x78, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x22, 0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x68, 0x74, 0x74, 0x70,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x54, 0x6f, 0x48,
+	0x74, 0x74, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x73, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x68, 0x73, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x73, 0x74, 0x73,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x68, 0x73, 0x74, 0x73, 0x4d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6c,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x6c, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x6c, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x74, 0x6c, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x73, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6c, 0x73, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72,
+	0x73, 0x22, 0x48, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x67, 0x69, 0x6e, 0x78,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x48, 0x74, 0x74, 0x70, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x0b,
+	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x76,
+	0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x65, 0x0a, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0xa9, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x3b, 0x0a, 0x0f, 0x6f, 0x6c, 0x64,
+	0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x65, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x22, 0x85, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6c, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6c, 0x73, 0x5f, 0x63,
+	0x61, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
+	0x74, 0x6c, 0x73, 0x43, 0x61, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x85, 0x02, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x79, 0x6c,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x6c,
+	0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x6c, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x22, 0x0a, 0x0d, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x6c, 0x73, 0x43, 0x61, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x22, 0x57, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x76,
+	0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xa6, 0x03, 0x0a,
+	0x13, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x52, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x11, 0x6f, 0x6c, 0x64, 0x5f,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x75, 0x73,
+	0x68, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x2e,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x0f, 0x6f, 0x6c, 0x64,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x1a, 0xe6, 0x01, 0x0a,
+	0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x49, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6c,
+	0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x74, 0x6c, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6c, 0x73,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x74, 0x6c, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x5f, 0x62, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x6c, 0x73, 0x43, 0x61, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x70, 0x22, 0x28, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x4e, 0x53,
+	0x54, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x13, 0x0a, 0x05, 0x74,
+	0x6f, 0x70, 0x5f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4e,
+	0x22, 0x31, 0x0a, 0x07, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x0e, 0x56, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x39, 0x0a, 0x06, 0x71, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x74, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x71,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x51, 0x74, 0x79, 0x70, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xa4, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x54, 0x6f, 0x70, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x09,
+	0x74, 0x6f, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x52,
+	0x08, 0x74, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x74, 0x6f, 0x70,
+	0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0a,
+	0x74, 0x6f, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0d, 0x74, 0x6f,
+	0x70, 0x5f, 0x6e, 0x78, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x4e, 0x78, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12,
+	0x36, 0x0a, 0x0b, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x71, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x65,
+	0x77, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x0a, 0x76, 0x69, 0x65,
+	0x77, 0x51, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x22, 0x74, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65,
+	0x64, 0x12, 0x33, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x72, 0x69, 0x66, 0x74, 0x5f,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x64, 0x72, 0x69, 0x66, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x72, 0x69, 0x66, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x72, 0x69, 0x66, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x46, 0x0a, 0x16, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x72,
+	0x6f, 0x6d, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x1b, 0x44, 0x69, 0x66,
+	0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x65, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x46, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22,
+	0x2b, 0x0a, 0x19, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa3, 0x02, 0x0a,
+	0x10, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65,
+	0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6e, 0x73, 0x73, 0x65, 0x63, 0x5f, 0x6f, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x6e, 0x73, 0x73, 0x65, 0x63, 0x4f, 0x6b, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x22, 0x72, 0x0a, 0x0a, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x52, 0x52,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x72, 0x64, 0x61, 0x74, 0x61, 0x22, 0x84, 0x04, 0x0a, 0x0f, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x52, 0x52, 0x52, 0x06, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x52, 0x52, 0x52, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x52, 0x52, 0x52, 0x0a, 0x61,
+	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x64, 0x6e,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x65, 0x64, 0x6e, 0x73, 0x12, 0x22, 0x0a,
+	0x0d, 0x65, 0x64, 0x6e, 0x73, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x64, 0x6e, 0x73, 0x55, 0x64, 0x70, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6e, 0x73, 0x73, 0x65, 0x63, 0x5f, 0x6f, 0x6b, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x6e, 0x73, 0x73, 0x65, 0x63, 0x4f, 0x6b, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x64, 0x6e, 0x73, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x64, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x73, 0x69, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x74, 0x73, 0x69, 0x67, 0x12, 0x31, 0x0a, 0x14, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64,
+	0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x13, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4d, 0x69, 0x63, 0x72,
+	0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x65, 0x0a,
+	0x15, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64,
+	0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x10, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x44, 0x4e, 0x53,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x74, 0x72, 0x65, 0x65, 0x22, 0x29, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x76,
+	0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22,
+	0xa2, 0x03, 0x0a, 0x0d, 0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x68, 0x69, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69,
+	0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d,
+	0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x68, 0x69, 0x74, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x12, 0x3e, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x4e, 0x53,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x72, 0x73, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x4e, 0x53, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x52, 0x72, 0x73, 0x65, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x72, 0x72, 0x73, 0x65, 0x74, 0x73, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x52, 0x72, 0x73,
+	0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x60, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x39, 0x0a, 0x0f, 0x44, 0x75, 0x6d, 0x70, 0x44, 0x4e,
+	0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65,
+	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x73, 0x0a, 0x0d, 0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x72, 0x64, 0x61, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x14, 0x44, 0x75, 0x6d, 0x70, 0x44, 0x4e,
+	0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x32, 0x83, 0x15, 0x0a, 0x0c, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x08, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x4e, 0x53, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x4e,
+	0x53, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x32, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x4e, 0x53, 0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x6c,
+	0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44,
+	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x6c, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x6c,
+	0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x63, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44,
+	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x6c, 0x12, 0x18, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a,
+	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x38, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x12,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44,
+	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x12,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e,
+	0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4e, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a,
+	0x6f, 0x6e, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x48, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a,
+	0x6f, 0x6e, 0x65, 0x41, 0x58, 0x46, 0x52, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x41, 0x58,
+	0x46, 0x52, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44,
+	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x12, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x58, 0x46,
+	0x52, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x58, 0x46, 0x52, 0x52, 0x65, 0x71, 0x1a,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f,
+	0x6e, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a,
+	0x10, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e,
+	0x65, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x52, 0x52, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x52, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x12,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x52, 0x52, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x52, 0x52, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x52, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x44, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x67, 0x69, 0x6e, 0x78, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4e, 0x67, 0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e,
+	0x67, 0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x67, 0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44,
+	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x10, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x67, 0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x67,
+	0x69, 0x6e, 0x78, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x48, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44,
+	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x54, 0x6f, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x4e, 0x53, 0x54, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x54, 0x6f,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5a, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x13,
+	0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x16, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x44, 0x4e, 0x53,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c,
+	0x75, 0x73, 0x68, 0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x44, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x44, 0x75, 0x6d, 0x70, 0x44,
+	0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x75, 0x6d, 0x70, 0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x44, 0x4e, 0x53, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dns_proto_rawDescOnce sync.Once
+	file_dns_proto_rawDescData = file_dns_proto_rawDesc
+)
+
+func file_dns_proto_rawDescGZIP() []byte {
+	file_dns_proto_rawDescOnce.Do(func() {
+		file_dns_proto_rawDescData = protoimpl.X.CompressGZIP(file_dns_proto_rawDescData)
+	})
+	return file_dns_proto_rawDescData
+}
+
+var file_dns_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
+var file_dns_proto_goTypes = []interface{}{
+	(*DNSStartReq)(nil),                    // 0: proto.DNSStartReq
+	(*DNSStopReq)(nil),                     // 1: proto.DNSStopReq
 	(*UpdateGlobalConfigReq)(nil),          // 2: proto.UpdateGlobalConfigReq
 	(*Acl)(nil),                            // 3: proto.Acl
 	(*CreateAclReq)(nil),                   // 4: proto.CreateAclReq
@@ -2777,20 +5222,46 @@ var file_dns_proto_goTypes = []interface{}{
 	(*ViewPriority)(nil),                   // 21: proto.ViewPriority
 	(*UpdateViewReq)(nil),                  // 22: proto.UpdateViewReq
 	(*DeleteViewReq)(nil),                  // 23: proto.DeleteViewReq
-	(*CreateNginxProxyReq)(nil),            // 24: proto.CreateNginxProxyReq
-	(*UpdateNginxProxyReq)(nil),            // 25: proto.UpdateNginxProxyReq
-	(*DeleteNginxProxyReq)(nil),            // 26: proto.DeleteNginxProxyReq
-	(*Redirection)(nil),                    // 27: proto.Redirection
-	(*CreateRedirectionReq)(nil),           // 28: proto.CreateRedirectionReq
-	(*UpdateRedirectionReq)(nil),           // 29: proto.UpdateRedirectionReq
-	(*DeleteRedirectionReq)(nil),           // 30: proto.DeleteRedirectionReq
-	(*CreateForwardZoneReq)(nil),           // 31: proto.CreateForwardZoneReq
-	(*UpdateForwardZoneReq)(nil),           // 32: proto.UpdateForwardZoneReq
-	(*DeleteForwardZoneReq)(nil),           // 33: proto.DeleteForwardZoneReq
-	(*FlushForwardZoneReq)(nil),            // 34: proto.FlushForwardZoneReq
-	(*UploadLogReq)(nil),                   // 35: proto.UploadLogReq
-	(*FlushForwardZoneReqForwardZone)(nil), // 36: proto.FlushForwardZoneReq.forwardZone
-	(*DDIResponse)(nil),                    // 37: proto.DDIResponse
+	(*NginxLocation)(nil),                  // 24: proto.NginxLocation
+	(*CreateNginxProxyReq)(nil),            // 25: proto.CreateNginxProxyReq
+	(*UpdateNginxProxyReq)(nil),            // 26: proto.UpdateNginxProxyReq
+	(*DeleteNginxProxyReq)(nil),            // 27: proto.DeleteNginxProxyReq
+	(*Redirection)(nil),                    // 28: proto.Redirection
+	(*CreateRedirectionReq)(nil),           // 29: proto.CreateRedirectionReq
+	(*UpdateRedirectionReq)(nil),           // 30: proto.UpdateRedirectionReq
+	(*DeleteRedirectionReq)(nil),           // 31: proto.DeleteRedirectionReq
+	(*CreateForwardZoneReq)(nil),           // 32: proto.CreateForwardZoneReq
+	(*UpdateForwardZoneReq)(nil),           // 33: proto.UpdateForwardZoneReq
+	(*DeleteForwardZoneReq)(nil),           // 34: proto.DeleteForwardZoneReq
+	(*FlushForwardZoneReq)(nil),            // 35: proto.FlushForwardZoneReq
+	(*UploadLogReq)(nil),                   // 36: proto.UploadLogReq
+	(*GetDNSTopStatsReq)(nil),              // 37: proto.GetDNSTopStatsReq
+	(*TopStat)(nil),                        // 38: proto.TopStat
+	(*ViewQueryTypes)(nil),                 // 39: proto.ViewQueryTypes
+	(*GetDNSTopStatsResponse)(nil),         // 40: proto.GetDNSTopStatsResponse
+	(*ListConfigSnapshotsReq)(nil),         // 41: proto.ListConfigSnapshotsReq
+	(*ConfigSnapshot)(nil),                 // 42: proto.ConfigSnapshot
+	(*ListConfigSnapshotsResponse)(nil),    // 43: proto.ListConfigSnapshotsResponse
+	(*DiffConfigSnapshotsReq)(nil),         // 44: proto.DiffConfigSnapshotsReq
+	(*DiffConfigSnapshotsResponse)(nil),    // 45: proto.DiffConfigSnapshotsResponse
+	(*RollbackConfigSnapshotReq)(nil),      // 46: proto.RollbackConfigSnapshotReq
+	(*DiagnoseQueryReq)(nil),               // 47: proto.DiagnoseQueryReq
+	(*DiagnoseRR)(nil),                     // 48: proto.DiagnoseRR
+	(*DiagnoseMessage)(nil),                // 49: proto.DiagnoseMessage
+	(*DiagnoseQueryResponse)(nil),          // 50: proto.DiagnoseQueryResponse
+	(*FlushDNSCacheReq)(nil),               // 51: proto.FlushDNSCacheReq
+	(*GetDNSCacheStatsReq)(nil),            // 52: proto.GetDNSCacheStatsReq
+	(*DNSCacheStats)(nil),                  // 53: proto.DNSCacheStats
+	(*GetDNSCacheStatsResponse)(nil),       // 54: proto.GetDNSCacheStatsResponse
+	(*DumpDNSCacheReq)(nil),                // 55: proto.DumpDNSCacheReq
+	(*DNSCacheEntry)(nil),                  // 56: proto.DNSCacheEntry
+	(*DumpDNSCacheResponse)(nil),           // 57: proto.DumpDNSCacheResponse
+	(*FlushForwardZoneReqForwardZone)(nil), // 58: proto.FlushForwardZoneReq.forwardZone
+	nil,                                    // 59: proto.ViewQueryTypes.QtypesEntry
+	nil,                                    // 60: proto.DNSCacheStats.CountersEntry
+	nil,                                    // 61: proto.DNSCacheStats.RrsetsEntry
+	(*ConfigFileDiff)(nil),                 // 62: proto.ConfigFileDiff
+	(*DDIResponse)(nil),                    // 63: proto.DDIResponse
 }
 var file_dns_proto_depIdxs = []int32{
 	3,  // 0: proto.CreateAclReq.acl:type_name -> proto.Acl
@@ -2817,79 +5288,112 @@ var file_dns_proto_depIdxs = []int32{
 	21, // 21: proto.CreateViewReq.view_priority:type_name -> proto.ViewPriority
 	21, // 22: proto.UpdateViewReq.view_priority:type_name -> proto.ViewPriority
 	21, // 23: proto.DeleteViewReq.view_priority:type_name -> proto.ViewPriority
-	27, // 24: proto.CreateRedirectionReq.redirection:type_name -> proto.Redirection
-	27, // 25: proto.UpdateRedirectionReq.old_redirection:type_name -> proto.Redirection
-	27, // 26: proto.UpdateRedirectionReq.new_redirection:type_name -> proto.Redirection
-	27, // 27: proto.DeleteRedirectionReq.redirection:type_name -> proto.Redirection
-	36, // 28: proto.FlushForwardZoneReq.new_forward_zones:type_name -> proto.FlushForwardZoneReq.forwardZone
-	36, // 29: proto.FlushForwardZoneReq.old_forward_zones:type_name -> proto.FlushForwardZoneReq.forwardZone
-	0,  // 30: proto.AgentManager.StartDNS:input_type -> proto.DNSStartReq
-	1,  // 31: proto.AgentManager.StopDNS:input_type -> proto.DNSStopReq
-	4,  // 32: proto.AgentManager.CreateAcl:input_type -> proto.CreateAclReq
-	6,  // 33: proto.AgentManager.UpdateAcl:input_type -> proto.UpdateAclReq
-	7,  // 34: proto.AgentManager.DeleteAcl:input_type -> proto.DeleteAclReq
-	5,  // 35: proto.AgentManager.BatchCreateAcl:input_type -> proto.BatchCreateAclReq
-	20, // 36: proto.AgentManager.CreateView:input_type -> proto.CreateViewReq
-	22, // 37: proto.AgentManager.UpdateView:input_type -> proto.UpdateViewReq
-	23, // 38: proto.AgentManager.DeleteView:input_type -> proto.DeleteViewReq
-	9,  // 39: proto.AgentManager.CreateAuthZone:input_type -> proto.CreateAuthZoneReq
-	10, // 40: proto.AgentManager.UpdateAuthZone:input_type -> proto.UpdateAuthZoneReq
-	11, // 41: proto.AgentManager.DeleteAuthZone:input_type -> proto.DeleteAuthZoneReq
-	12, // 42: proto.AgentManager.CreateAuthZoneAuthRRs:input_type -> proto.CreateAuthZoneAuthRRsReq
-	13, // 43: proto.AgentManager.UpdateAuthZoneAXFR:input_type -> proto.UpdateAuthZoneAXFRReq
-	14, // 44: proto.AgentManager.UpdateAuthZoneIXFR:input_type -> proto.UpdateAuthZoneIXFRReq
-	31, // 45: proto.AgentManager.CreateForwardZone:input_type -> proto.CreateForwardZoneReq
-	32, // 46: proto.AgentManager.UpdateForwardZone:input_type -> proto.UpdateForwardZoneReq
-	33, // 47: proto.AgentManager.DeleteForwardZone:input_type -> proto.DeleteForwardZoneReq
-	34, // 48: proto.AgentManager.FlushForwardZone:input_type -> proto.FlushForwardZoneReq
-	17, // 49: proto.AgentManager.CreateAuthRR:input_type -> proto.CreateAuthRRReq
-	18, // 50: proto.AgentManager.UpdateAuthRR:input_type -> proto.UpdateAuthRRReq
-	19, // 51: proto.AgentManager.DeleteAuthRR:input_type -> proto.DeleteAuthRRReq
-	16, // 52: proto.AgentManager.BatchCreateAuthRRs:input_type -> proto.BatchCreateAuthRRsReq
-	28, // 53: proto.AgentManager.CreateRedirection:input_type -> proto.CreateRedirectionReq
-	29, // 54: proto.AgentManager.UpdateRedirection:input_type -> proto.UpdateRedirectionReq
-	30, // 55: proto.AgentManager.DeleteRedirection:input_type -> proto.DeleteRedirectionReq
-	24, // 56: proto.AgentManager.CreateNginxProxy:input_type -> proto.CreateNginxProxyReq
-	25, // 57: proto.AgentManager.UpdateNginxProxy:input_type -> proto.UpdateNginxProxyReq
-	26, // 58: proto.AgentManager.DeleteNginxProxy:input_type -> proto.DeleteNginxProxyReq
-	2,  // 59: proto.AgentManager.UpdateGlobalConfig:input_type -> proto.UpdateGlobalConfigReq
-	35, // 60: proto.AgentManager.UploadLog:input_type -> proto.UploadLogReq
-	37, // 61: proto.AgentManager.StartDNS:output_type -> proto.DDIResponse
-	37, // 62: proto.AgentManager.StopDNS:output_type -> proto.DDIResponse
-	37, // 63: proto.AgentManager.CreateAcl:output_type -> proto.DDIResponse
-	37, // 64: proto.AgentManager.UpdateAcl:output_type -> proto.DDIResponse
-	37, // 65: proto.AgentManager.DeleteAcl:output_type -> proto.DDIResponse
-	37, // 66: proto.AgentManager.BatchCreateAcl:output_type -> proto.DDIResponse
-	37, // 67: proto.AgentManager.CreateView:output_type -> proto.DDIResponse
-	37, // 68: proto.AgentManager.UpdateView:output_type -> proto.DDIResponse
-	37, // 69: proto.AgentManager.DeleteView:output_type -> proto.DDIResponse
-	37, // 70: proto.AgentManager.CreateAuthZone:output_type -> proto.DDIResponse
-	37, // 71: proto.AgentManager.UpdateAuthZone:output_type -> proto.DDIResponse
-	37, // 72: proto.AgentManager.DeleteAuthZone:output_type -> proto.DDIResponse
-	37, // 73: proto.AgentManager.CreateAuthZoneAuthRRs:output_type -> proto.DDIResponse
-	37, // 74: proto.AgentManager.UpdateAuthZoneAXFR:output_type -> proto.DDIResponse
-	37, // 75: proto.AgentManager.UpdateAuthZoneIXFR:output_type -> proto.DDIResponse
-	37, // 76: proto.AgentManager.CreateForwardZone:output_type -> proto.DDIResponse
-	37, // 77: proto.AgentManager.UpdateForwardZone:output_type -> proto.DDIResponse
-	37, // 78: proto.AgentManager.DeleteForwardZone:output_type -> proto.DDIResponse
-	37, // 79: proto.AgentManager.FlushForwardZone:output_type -> proto.DDIResponse
-	37, // 80: proto.AgentManager.CreateAuthRR:output_type -> proto.DDIResponse
-	37, // 81: proto.AgentManager.UpdateAuthRR:output_type -> proto.DDIResponse
-	37, // 82: proto.AgentManager.DeleteAuthRR:output_type -> proto.DDIResponse
-	37, // 83: proto.AgentManager.BatchCreateAuthRRs:output_type -> proto.DDIResponse
-	37, // 84: proto.AgentManager.CreateRedirection:output_type -> proto.DDIResponse
-	37, // 85: proto.AgentManager.UpdateRedirection:output_type -> proto.DDIResponse
-	37, // 86: proto.AgentManager.DeleteRedirection:output_type -> proto.DDIResponse
-	37, // 87: proto.AgentManager.CreateNginxProxy:output_type -> proto.DDIResponse
-	37, // 88: proto.AgentManager.UpdateNginxProxy:output_type -> proto.DDIResponse
-	37, // 89: proto.AgentManager.DeleteNginxProxy:output_type -> proto.DDIResponse
-	37, // 90: proto.AgentManager.UpdateGlobalConfig:output_type -> proto.DDIResponse
-	37, // 91: proto.AgentManager.UploadLog:output_type -> proto.DDIResponse
-	61, // [61:92] is the sub-list for method output_type
-	30, // [30:61] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	24, // 24: proto.CreateNginxProxyReq.locations:type_name -> proto.NginxLocation
+	24, // 25: proto.UpdateNginxProxyReq.locations:type_name -> proto.NginxLocation
+	28, // 26: proto.CreateRedirectionReq.redirection:type_name -> proto.Redirection
+	28, // 27: proto.UpdateRedirectionReq.old_redirection:type_name -> proto.Redirection
+	28, // 28: proto.UpdateRedirectionReq.new_redirection:type_name -> proto.Redirection
+	28, // 29: proto.DeleteRedirectionReq.redirection:type_name -> proto.Redirection
+	58, // 30: proto.FlushForwardZoneReq.new_forward_zones:type_name -> proto.FlushForwardZoneReq.forwardZone
+	58, // 31: proto.FlushForwardZoneReq.old_forward_zones:type_name -> proto.FlushForwardZoneReq.forwardZone
+	59, // 32: proto.ViewQueryTypes.qtypes:type_name -> proto.ViewQueryTypes.QtypesEntry
+	38, // 33: proto.GetDNSTopStatsResponse.top_names:type_name -> proto.TopStat
+	38, // 34: proto.GetDNSTopStatsResponse.top_clients:type_name -> proto.TopStat
+	38, // 35: proto.GetDNSTopStatsResponse.top_nxdomains:type_name -> proto.TopStat
+	39, // 36: proto.GetDNSTopStatsResponse.view_qtypes:type_name -> proto.ViewQueryTypes
+	42, // 37: proto.ListConfigSnapshotsResponse.snapshots:type_name -> proto.ConfigSnapshot
+	62, // 38: proto.DiffConfigSnapshotsResponse.files:type_name -> proto.ConfigFileDiff
+	48, // 39: proto.DiagnoseMessage.answer:type_name -> proto.DiagnoseRR
+	48, // 40: proto.DiagnoseMessage.authority:type_name -> proto.DiagnoseRR
+	48, // 41: proto.DiagnoseMessage.additional:type_name -> proto.DiagnoseRR
+	49, // 42: proto.DiagnoseQueryResponse.messages:type_name -> proto.DiagnoseMessage
+	60, // 43: proto.DNSCacheStats.counters:type_name -> proto.DNSCacheStats.CountersEntry
+	61, // 44: proto.DNSCacheStats.rrsets:type_name -> proto.DNSCacheStats.RrsetsEntry
+	53, // 45: proto.GetDNSCacheStatsResponse.views:type_name -> proto.DNSCacheStats
+	56, // 46: proto.DumpDNSCacheResponse.entries:type_name -> proto.DNSCacheEntry
+	0,  // 47: proto.AgentManager.StartDNS:input_type -> proto.DNSStartReq
+	1,  // 48: proto.AgentManager.StopDNS:input_type -> proto.DNSStopReq
+	4,  // 49: proto.AgentManager.CreateAcl:input_type -> proto.CreateAclReq
+	6,  // 50: proto.AgentManager.UpdateAcl:input_type -> proto.UpdateAclReq
+	7,  // 51: proto.AgentManager.DeleteAcl:input_type -> proto.DeleteAclReq
+	5,  // 52: proto.AgentManager.BatchCreateAcl:input_type -> proto.BatchCreateAclReq
+	20, // 53: proto.AgentManager.CreateView:input_type -> proto.CreateViewReq
+	22, // 54: proto.AgentManager.UpdateView:input_type -> proto.UpdateViewReq
+	23, // 55: proto.AgentManager.DeleteView:input_type -> proto.DeleteViewReq
+	9,  // 56: proto.AgentManager.CreateAuthZone:input_type -> proto.CreateAuthZoneReq
+	10, // 57: proto.AgentManager.UpdateAuthZone:input_type -> proto.UpdateAuthZoneReq
+	11, // 58: proto.AgentManager.DeleteAuthZone:input_type -> proto.DeleteAuthZoneReq
+	12, // 59: proto.AgentManager.CreateAuthZoneAuthRRs:input_type -> proto.CreateAuthZoneAuthRRsReq
+	13, // 60: proto.AgentManager.UpdateAuthZoneAXFR:input_type -> proto.UpdateAuthZoneAXFRReq
+	14, // 61: proto.AgentManager.UpdateAuthZoneIXFR:input_type -> proto.UpdateAuthZoneIXFRReq
+	32, // 62: proto.AgentManager.CreateForwardZone:input_type -> proto.CreateForwardZoneReq
+	33, // 63: proto.AgentManager.UpdateForwardZone:input_type -> proto.UpdateForwardZoneReq
+	34, // 64: proto.AgentManager.DeleteForwardZone:input_type -> proto.DeleteForwardZoneReq
+	35, // 65: proto.AgentManager.FlushForwardZone:input_type -> proto.FlushForwardZoneReq
+	17, // 66: proto.AgentManager.CreateAuthRR:input_type -> proto.CreateAuthRRReq
+	18, // 67: proto.AgentManager.UpdateAuthRR:input_type -> proto.UpdateAuthRRReq
+	19, // 68: proto.AgentManager.DeleteAuthRR:input_type -> proto.DeleteAuthRRReq
+	16, // 69: proto.AgentManager.BatchCreateAuthRRs:input_type -> proto.BatchCreateAuthRRsReq
+	29, // 70: proto.AgentManager.CreateRedirection:input_type -> proto.CreateRedirectionReq
+	30, // 71: proto.AgentManager.UpdateRedirection:input_type -> proto.UpdateRedirectionReq
+	31, // 72: proto.AgentManager.DeleteRedirection:input_type -> proto.DeleteRedirectionReq
+	25, // 73: proto.AgentManager.CreateNginxProxy:input_type -> proto.CreateNginxProxyReq
+	26, // 74: proto.AgentManager.UpdateNginxProxy:input_type -> proto.UpdateNginxProxyReq
+	27, // 75: proto.AgentManager.DeleteNginxProxy:input_type -> proto.DeleteNginxProxyReq
+	2,  // 76: proto.AgentManager.UpdateGlobalConfig:input_type -> proto.UpdateGlobalConfigReq
+	36, // 77: proto.AgentManager.UploadLog:input_type -> proto.UploadLogReq
+	37, // 78: proto.AgentManager.GetDNSTopStats:input_type -> proto.GetDNSTopStatsReq
+	41, // 79: proto.AgentManager.ListConfigSnapshots:input_type -> proto.ListConfigSnapshotsReq
+	44, // 80: proto.AgentManager.DiffConfigSnapshots:input_type -> proto.DiffConfigSnapshotsReq
+	46, // 81: proto.AgentManager.RollbackConfigSnapshot:input_type -> proto.RollbackConfigSnapshotReq
+	47, // 82: proto.AgentManager.DiagnoseQuery:input_type -> proto.DiagnoseQueryReq
+	51, // 83: proto.AgentManager.FlushDNSCache:input_type -> proto.FlushDNSCacheReq
+	52, // 84: proto.AgentManager.GetDNSCacheStats:input_type -> proto.GetDNSCacheStatsReq
+	55, // 85: proto.AgentManager.DumpDNSCache:input_type -> proto.DumpDNSCacheReq
+	63, // 86: proto.AgentManager.StartDNS:output_type -> proto.DDIResponse
+	63, // 87: proto.AgentManager.StopDNS:output_type -> proto.DDIResponse
+	63, // 88: proto.AgentManager.CreateAcl:output_type -> proto.DDIResponse
+	63, // 89: proto.AgentManager.UpdateAcl:output_type -> proto.DDIResponse
+	63, // 90: proto.AgentManager.DeleteAcl:output_type -> proto.DDIResponse
+	63, // 91: proto.AgentManager.BatchCreateAcl:output_type -> proto.DDIResponse
+	63, // 92: proto.AgentManager.CreateView:output_type -> proto.DDIResponse
+	63, // 93: proto.AgentManager.UpdateView:output_type -> proto.DDIResponse
+	63, // 94: proto.AgentManager.DeleteView:output_type -> proto.DDIResponse
+	63, // 95: proto.AgentManager.CreateAuthZone:output_type -> proto.DDIResponse
+	63, // 96: proto.AgentManager.UpdateAuthZone:output_type -> proto.DDIResponse
+	63, // 97: proto.AgentManager.DeleteAuthZone:output_type -> proto.DDIResponse
+	63, // 98: proto.AgentManager.CreateAuthZoneAuthRRs:output_type -> proto.DDIResponse
+	63, // 99: proto.AgentManager.UpdateAuthZoneAXFR:output_type -> proto.DDIResponse
+	63, // 100: proto.AgentManager.UpdateAuthZoneIXFR:output_type -> proto.DDIResponse
+	63, // 101: proto.AgentManager.CreateForwardZone:output_type -> proto.DDIResponse
+	63, // 102: proto.AgentManager.UpdateForwardZone:output_type -> proto.DDIResponse
+	63, // 103: proto.AgentManager.DeleteForwardZone:output_type -> proto.DDIResponse
+	63, // 104: proto.AgentManager.FlushForwardZone:output_type -> proto.DDIResponse
+	63, // 105: proto.AgentManager.CreateAuthRR:output_type -> proto.DDIResponse
+	63, // 106: proto.AgentManager.UpdateAuthRR:output_type -> proto.DDIResponse
+	63, // 107: proto.AgentManager.DeleteAuthRR:output_type -> proto.DDIResponse
+	63, // 108: proto.AgentManager.BatchCreateAuthRRs:output_type -> proto.DDIResponse
+	63, // 109: proto.AgentManager.CreateRedirection:output_type -> proto.DDIResponse
+	63, // 110: proto.AgentManager.UpdateRedirection:output_type -> proto.DDIResponse
+	63, // 111: proto.AgentManager.DeleteRedirection:output_type -> proto.DDIResponse
+	63, // 112: proto.AgentManager.CreateNginxProxy:output_type -> proto.DDIResponse
+	63, // 113: proto.AgentManager.UpdateNginxProxy:output_type -> proto.DDIResponse
+	63, // 114: proto.AgentManager.DeleteNginxProxy:output_type -> proto.DDIResponse
+	63, // 115: proto.AgentManager.UpdateGlobalConfig:output_type -> proto.DDIResponse
+	63, // 116: proto.AgentManager.UploadLog:output_type -> proto.DDIResponse
+	40, // 117: proto.AgentManager.GetDNSTopStats:output_type -> proto.GetDNSTopStatsResponse
+	43, // 118: proto.AgentManager.ListConfigSnapshots:output_type -> proto.ListConfigSnapshotsResponse
+	45, // 119: proto.AgentManager.DiffConfigSnapshots:output_type -> proto.DiffConfigSnapshotsResponse
+	63, // 120: proto.AgentManager.RollbackConfigSnapshot:output_type -> proto.DDIResponse
+	50, // 121: proto.AgentManager.DiagnoseQuery:output_type -> proto.DiagnoseQueryResponse
+	63, // 122: proto.AgentManager.FlushDNSCache:output_type -> proto.DDIResponse
+	54, // 123: proto.AgentManager.GetDNSCacheStats:output_type -> proto.GetDNSCacheStatsResponse
+	57, // 124: proto.AgentManager.DumpDNSCache:output_type -> proto.DumpDNSCacheResponse
+	86, // [86:125] is the sub-list for method output_type
+	47, // [47:86] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_dns_proto_init() }
@@ -2911,8 +5415,44 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DNSStopReq); i {
+		file_dns_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSStopReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGlobalConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Acl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAclReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2923,8 +5463,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateGlobalConfigReq); i {
+		file_dns_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateAclReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2935,8 +5475,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Acl); i {
+		file_dns_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAclReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2947,8 +5487,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAclReq); i {
+		file_dns_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAclReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2959,8 +5499,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreateAclReq); i {
+		file_dns_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthZone); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2971,8 +5511,236 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAclReq); i {
+		file_dns_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAuthZoneReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAuthZoneReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAuthZoneReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAuthZoneAuthRRsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAuthZoneAXFRReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAuthZoneIXFRReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthZoneRR); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateAuthRRsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAuthRRReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAuthRRReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAuthRRReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateViewReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ViewPriority); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateViewReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteViewReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NginxLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateNginxProxyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNginxProxyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteNginxProxyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dns_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Redirection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2983,8 +5751,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAclReq); i {
+		file_dns_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRedirectionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2995,8 +5763,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthZone); i {
+		file_dns_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRedirectionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3007,8 +5775,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAuthZoneReq); i {
+		file_dns_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRedirectionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3019,8 +5787,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAuthZoneReq); i {
+		file_dns_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateForwardZoneReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3031,8 +5799,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAuthZoneReq); i {
+		file_dns_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateForwardZoneReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3043,8 +5811,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAuthZoneAuthRRsReq); i {
+		file_dns_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteForwardZoneReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3055,8 +5823,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAuthZoneAXFRReq); i {
+		file_dns_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushForwardZoneReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3067,8 +5835,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAuthZoneIXFRReq); i {
+		file_dns_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadLogReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3079,8 +5847,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthZoneRR); i {
+		file_dns_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDNSTopStatsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3091,8 +5859,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreateAuthRRsReq); i {
+		file_dns_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopStat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3103,8 +5871,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAuthRRReq); i {
+		file_dns_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ViewQueryTypes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3115,8 +5883,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAuthRRReq); i {
+		file_dns_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDNSTopStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3127,8 +5895,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAuthRRReq); i {
+		file_dns_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConfigSnapshotsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3139,8 +5907,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateViewReq); i {
+		file_dns_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigSnapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3151,8 +5919,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ViewPriority); i {
+		file_dns_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConfigSnapshotsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3163,8 +5931,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateViewReq); i {
+		file_dns_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffConfigSnapshotsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3175,8 +5943,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteViewReq); i {
+		file_dns_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffConfigSnapshotsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3187,8 +5955,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateNginxProxyReq); i {
+		file_dns_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackConfigSnapshotReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3199,8 +5967,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateNginxProxyReq); i {
+		file_dns_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnoseQueryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3211,8 +5979,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteNginxProxyReq); i {
+		file_dns_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnoseRR); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3223,8 +5991,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Redirection); i {
+		file_dns_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnoseMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3235,8 +6003,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRedirectionReq); i {
+		file_dns_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnoseQueryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3247,8 +6015,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRedirectionReq); i {
+		file_dns_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushDNSCacheReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3259,8 +6027,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRedirectionReq); i {
+		file_dns_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDNSCacheStatsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3271,8 +6039,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateForwardZoneReq); i {
+		file_dns_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSCacheStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3283,8 +6051,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateForwardZoneReq); i {
+		file_dns_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDNSCacheStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3295,8 +6063,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteForwardZoneReq); i {
+		file_dns_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DumpDNSCacheReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3307,8 +6075,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlushForwardZoneReq); i {
+		file_dns_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSCacheEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3319,8 +6087,8 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadLogReq); i {
+		file_dns_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DumpDNSCacheResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3331,7 +6099,7 @@ func file_dns_proto_init() {
 				return nil
 			}
 		}
-		file_dns_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_dns_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FlushForwardZoneReqForwardZone); i {
 			case 0:
 				return &v.state
@@ -3350,7 +6118,7 @@ func file_dns_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dns_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   37,
+			NumMessages:   62,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -3407,6 +6175,14 @@ type AgentManagerClient interface {
 	DeleteNginxProxy(ctx context.Context, in *DeleteNginxProxyReq, opts ...grpc.CallOption) (*DDIResponse, error)
 	UpdateGlobalConfig(ctx context.Context, in *UpdateGlobalConfigReq, opts ...grpc.CallOption) (*DDIResponse, error)
 	UploadLog(ctx context.Context, in *UploadLogReq, opts ...grpc.CallOption) (*DDIResponse, error)
+	GetDNSTopStats(ctx context.Context, in *GetDNSTopStatsReq, opts ...grpc.CallOption) (*GetDNSTopStatsResponse, error)
+	ListConfigSnapshots(ctx context.Context, in *ListConfigSnapshotsReq, opts ...grpc.CallOption) (*ListConfigSnapshotsResponse, error)
+	DiffConfigSnapshots(ctx context.Context, in *DiffConfigSnapshotsReq, opts ...grpc.CallOption) (*DiffConfigSnapshotsResponse, error)
+	RollbackConfigSnapshot(ctx context.Context, in *RollbackConfigSnapshotReq, opts ...grpc.CallOption) (*DDIResponse, error)
+	DiagnoseQuery(ctx context.Context, in *DiagnoseQueryReq, opts ...grpc.CallOption) (*DiagnoseQueryResponse, error)
+	FlushDNSCache(ctx context.Context, in *FlushDNSCacheReq, opts ...grpc.CallOption) (*DDIResponse, error)
+	GetDNSCacheStats(ctx context.Context, in *GetDNSCacheStatsReq, opts ...grpc.CallOption) (*GetDNSCacheStatsResponse, error)
+	DumpDNSCache(ctx context.Context, in *DumpDNSCacheReq, opts ...grpc.CallOption) (*DumpDNSCacheResponse, error)
 }
 
 type agentManagerClient struct {
@@ -3696,6 +6472,78 @@ func (c *agentManagerClient) UploadLog(ctx context.Context, in *UploadLogReq, op
 	return out, nil
 }
 
+func (c *agentManagerClient) GetDNSTopStats(ctx context.Context, in *GetDNSTopStatsReq, opts ...grpc.CallOption) (*GetDNSTopStatsResponse, error) {
+	out := new(GetDNSTopStatsResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentManager/GetDNSTopStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentManagerClient) ListConfigSnapshots(ctx context.Context, in *ListConfigSnapshotsReq, opts ...grpc.CallOption) (*ListConfigSnapshotsResponse, error) {
+	out := new(ListConfigSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentManager/ListConfigSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentManagerClient) DiffConfigSnapshots(ctx context.Context, in *DiffConfigSnapshotsReq, opts ...grpc.CallOption) (*DiffConfigSnapshotsResponse, error) {
+	out := new(DiffConfigSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentManager/DiffConfigSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentManagerClient) RollbackConfigSnapshot(ctx context.Context, in *RollbackConfigSnapshotReq, opts ...grpc.CallOption) (*DDIResponse, error) {
+	out := new(DDIResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentManager/RollbackConfigSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentManagerClient) DiagnoseQuery(ctx context.Context, in *DiagnoseQueryReq, opts ...grpc.CallOption) (*DiagnoseQueryResponse, error) {
+	out := new(DiagnoseQueryResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentManager/DiagnoseQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentManagerClient) FlushDNSCache(ctx context.Context, in *FlushDNSCacheReq, opts ...grpc.CallOption) (*DDIResponse, error) {
+	out := new(DDIResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentManager/FlushDNSCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentManagerClient) GetDNSCacheStats(ctx context.Context, in *GetDNSCacheStatsReq, opts ...grpc.CallOption) (*GetDNSCacheStatsResponse, error) {
+	out := new(GetDNSCacheStatsResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentManager/GetDNSCacheStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentManagerClient) DumpDNSCache(ctx context.Context, in *DumpDNSCacheReq, opts ...grpc.CallOption) (*DumpDNSCacheResponse, error) {
+	out := new(DumpDNSCacheResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentManager/DumpDNSCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentManagerServer is the server API for AgentManager service.
 type AgentManagerServer interface {
 	StartDNS(context.Context, *DNSStartReq) (*DDIResponse, error)
@@ -3729,6 +6577,14 @@ type AgentManagerServer interface {
 	DeleteNginxProxy(context.Context, *DeleteNginxProxyReq) (*DDIResponse, error)
 	UpdateGlobalConfig(context.Context, *UpdateGlobalConfigReq) (*DDIResponse, error)
 	UploadLog(context.Context, *UploadLogReq) (*DDIResponse, error)
+	GetDNSTopStats(context.Context, *GetDNSTopStatsReq) (*GetDNSTopStatsResponse, error)
+	ListConfigSnapshots(context.Context, *ListConfigSnapshotsReq) (*ListConfigSnapshotsResponse, error)
+	DiffConfigSnapshots(context.Context, *DiffConfigSnapshotsReq) (*DiffConfigSnapshotsResponse, error)
+	RollbackConfigSnapshot(context.Context, *RollbackConfigSnapshotReq) (*DDIResponse, error)
+	DiagnoseQuery(context.Context, *DiagnoseQueryReq) (*DiagnoseQueryResponse, error)
+	FlushDNSCache(context.Context, *FlushDNSCacheReq) (*DDIResponse, error)
+	GetDNSCacheStats(context.Context, *GetDNSCacheStatsReq) (*GetDNSCacheStatsResponse, error)
+	DumpDNSCache(context.Context, *DumpDNSCacheReq) (*DumpDNSCacheResponse, error)
 }
 
 // UnimplementedAgentManagerServer can be embedded to have forward compatible implementations.
@@ -3828,6 +6684,30 @@ func (*UnimplementedAgentManagerServer) UpdateGlobalConfig(context.Context, *Upd
 func (*UnimplementedAgentManagerServer) UploadLog(context.Context, *UploadLogReq) (*DDIResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadLog not implemented")
 }
+func (*UnimplementedAgentManagerServer) GetDNSTopStats(context.Context, *GetDNSTopStatsReq) (*GetDNSTopStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDNSTopStats not implemented")
+}
+func (*UnimplementedAgentManagerServer) ListConfigSnapshots(context.Context, *ListConfigSnapshotsReq) (*ListConfigSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigSnapshots not implemented")
+}
+func (*UnimplementedAgentManagerServer) DiffConfigSnapshots(context.Context, *DiffConfigSnapshotsReq) (*DiffConfigSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffConfigSnapshots not implemented")
+}
+func (*UnimplementedAgentManagerServer) RollbackConfigSnapshot(context.Context, *RollbackConfigSnapshotReq) (*DDIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackConfigSnapshot not implemented")
+}
+func (*UnimplementedAgentManagerServer) DiagnoseQuery(context.Context, *DiagnoseQueryReq) (*DiagnoseQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiagnoseQuery not implemented")
+}
+func (*UnimplementedAgentManagerServer) FlushDNSCache(context.Context, *FlushDNSCacheReq) (*DDIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushDNSCache not implemented")
+}
+func (*UnimplementedAgentManagerServer) GetDNSCacheStats(context.Context, *GetDNSCacheStatsReq) (*GetDNSCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDNSCacheStats not implemented")
+}
+func (*UnimplementedAgentManagerServer) DumpDNSCache(context.Context, *DumpDNSCacheReq) (*DumpDNSCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpDNSCache not implemented")
+}
 
 func RegisterAgentManagerServer(s *grpc.Server, srv AgentManagerServer) {
 	s.RegisterService(&_AgentManager_serviceDesc, srv)
@@ -4391,6 +7271,150 @@ func _AgentManager_UploadLog_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentManager_GetDNSTopStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDNSTopStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentManagerServer).GetDNSTopStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentManager/GetDNSTopStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentManagerServer).GetDNSTopStats(ctx, req.(*GetDNSTopStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentManager_ListConfigSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigSnapshotsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentManagerServer).ListConfigSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentManager/ListConfigSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentManagerServer).ListConfigSnapshots(ctx, req.(*ListConfigSnapshotsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentManager_DiffConfigSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffConfigSnapshotsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentManagerServer).DiffConfigSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentManager/DiffConfigSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentManagerServer).DiffConfigSnapshots(ctx, req.(*DiffConfigSnapshotsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentManager_RollbackConfigSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConfigSnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentManagerServer).RollbackConfigSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentManager/RollbackConfigSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentManagerServer).RollbackConfigSnapshot(ctx, req.(*RollbackConfigSnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentManager_DiagnoseQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiagnoseQueryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentManagerServer).DiagnoseQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentManager/DiagnoseQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentManagerServer).DiagnoseQuery(ctx, req.(*DiagnoseQueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentManager_FlushDNSCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushDNSCacheReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentManagerServer).FlushDNSCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentManager/FlushDNSCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentManagerServer).FlushDNSCache(ctx, req.(*FlushDNSCacheReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentManager_GetDNSCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDNSCacheStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentManagerServer).GetDNSCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentManager/GetDNSCacheStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentManagerServer).GetDNSCacheStats(ctx, req.(*GetDNSCacheStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentManager_DumpDNSCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpDNSCacheReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentManagerServer).DumpDNSCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentManager/DumpDNSCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentManagerServer).DumpDNSCache(ctx, req.(*DumpDNSCacheReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AgentManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.AgentManager",
 	HandlerType: (*AgentManagerServer)(nil),
@@ -4519,6 +7543,38 @@ var _AgentManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UploadLog",
 			Handler:    _AgentManager_UploadLog_Handler,
 		},
+		{
+			MethodName: "GetDNSTopStats",
+			Handler:    _AgentManager_GetDNSTopStats_Handler,
+		},
+		{
+			MethodName: "ListConfigSnapshots",
+			Handler:    _AgentManager_ListConfigSnapshots_Handler,
+		},
+		{
+			MethodName: "DiffConfigSnapshots",
+			Handler:    _AgentManager_DiffConfigSnapshots_Handler,
+		},
+		{
+			MethodName: "RollbackConfigSnapshot",
+			Handler:    _AgentManager_RollbackConfigSnapshot_Handler,
+		},
+		{
+			MethodName: "DiagnoseQuery",
+			Handler:    _AgentManager_DiagnoseQuery_Handler,
+		},
+		{
+			MethodName: "FlushDNSCache",
+			Handler:    _AgentManager_FlushDNSCache_Handler,
+		},
+		{
+			MethodName: "GetDNSCacheStats",
+			Handler:    _AgentManager_GetDNSCacheStats_Handler,
+		},
+		{
+			MethodName: "DumpDNSCache",
+			Handler:    _AgentManager_DumpDNSCache_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dns.proto",
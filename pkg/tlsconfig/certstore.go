@@ -0,0 +1,122 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zdnscloud/cement/log"
+
+	"github.com/linkingthing/ddi-agent/config"
+)
+
+const CheckInterval = 10 * time.Second
+
+type certStore struct {
+	conf      config.TLSConf
+	lock      sync.Mutex
+	lastCheck time.Time
+	modTimes  map[string]time.Time
+	cert      *tls.Certificate
+	pool      *x509.CertPool
+}
+
+func newCertStore(conf config.TLSConf) (*certStore, error) {
+	s := &certStore{conf: conf}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	s.lastCheck = time.Now()
+	return s, nil
+}
+
+func (s *certStore) get() (*tls.Certificate, *x509.CertPool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if time.Since(s.lastCheck) >= CheckInterval {
+		s.lastCheck = time.Now()
+		if s.isChanged() {
+			if err := s.load(); err != nil {
+				log.Warnf("reload tls certificates failed, keep using old ones: %s", err.Error())
+			} else {
+				log.Infof("reload tls certificates succeed")
+			}
+		}
+	}
+
+	return s.cert, s.pool
+}
+
+func (s *certStore) files() []string {
+	var files []string
+	for _, file := range []string{s.conf.CertFile, s.conf.KeyFile, s.conf.CAFile} {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+func (s *certStore) isChanged() bool {
+	for _, file := range s.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return false
+		}
+
+		if info.ModTime().Equal(s.modTimes[file]) == false {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *certStore) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, file := range s.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return fmt.Errorf("stat tls file %s failed: %s", file, err.Error())
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	var cert *tls.Certificate
+	if s.conf.CertFile != "" || s.conf.KeyFile != "" {
+		c, err := tls.LoadX509KeyPair(s.conf.CertFile, s.conf.KeyFile)
+		if err != nil {
+			return fmt.Errorf("load tls key pair %s %s failed: %s", s.conf.CertFile, s.conf.KeyFile, err.Error())
+		}
+		cert = &c
+	}
+
+	var pool *x509.CertPool
+	if s.conf.CAFile != "" {
+		data, err := ioutil.ReadFile(s.conf.CAFile)
+		if err != nil {
+			return fmt.Errorf("read tls ca file %s failed: %s", s.conf.CAFile, err.Error())
+		}
+
+		pool = x509.NewCertPool()
+		if pool.AppendCertsFromPEM(data) == false {
+			return fmt.Errorf("no valid certificate found in tls ca file %s", s.conf.CAFile)
+		}
+	} else {
+		systemPool, err := x509.SystemCertPool()
+		if err != nil {
+			return fmt.Errorf("load system cert pool failed: %s", err.Error())
+		}
+		pool = systemPool
+	}
+
+	s.cert = cert
+	s.pool = pool
+	s.modTimes = modTimes
+	return nil
+}
@@ -0,0 +1,189 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/linkingthing/ddi-agent/config"
+)
+
+const ALPNProtoHTTP2 = "h2"
+
+func ServerOptions(conf config.TLSConf) ([]grpc.ServerOption, error) {
+	if conf.Enabled == false {
+		return nil, nil
+	}
+
+	tlsConfig, err := NewServerTLSConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}, nil
+}
+
+func DialOption(conf config.TLSConf, addr string) (grpc.DialOption, error) {
+	if conf.Enabled == false {
+		return grpc.WithInsecure(), nil
+	}
+
+	tlsConfig, err := NewClientTLSConfig(conf, DialHosts(addr)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
+func NewServerTLSConfig(conf config.TLSConf) (*tls.Config, error) {
+	if conf.CertFile == "" || conf.KeyFile == "" {
+		return nil, fmt.Errorf("tls server requires cert_file and key_file")
+	}
+
+	if conf.ClientAuth && conf.CAFile == "" {
+		return nil, fmt.Errorf("tls client auth requires ca_file")
+	}
+
+	store, err := newCertStore(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{ALPNProtoHTTP2},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := store.get()
+			tlsConfig := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   []string{ALPNProtoHTTP2},
+				Certificates: []tls.Certificate{*cert},
+			}
+
+			if conf.ClientAuth {
+				tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+				tlsConfig.ClientCAs = pool
+				tlsConfig.VerifyPeerCertificate = func(_ [][]byte, chains [][]*x509.Certificate) error {
+					if len(chains) == 0 || len(chains[0]) == 0 {
+						return fmt.Errorf("no verified client certificate")
+					}
+					return verifyPeerName(chains[0][0], conf.PeerNames)
+				}
+			}
+
+			return tlsConfig, nil
+		},
+	}, nil
+}
+
+func NewClientTLSConfig(conf config.TLSConf, hosts ...string) (*tls.Config, error) {
+	if (conf.CertFile == "") != (conf.KeyFile == "") {
+		return nil, fmt.Errorf("tls client requires both cert_file and key_file or neither")
+	}
+
+	if conf.CertFile != "" && conf.CAFile == "" {
+		return nil, fmt.Errorf("mutual tls requires ca_file")
+	}
+
+	store, err := newCertStore(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         conf.ServerName,
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			serverNames := hosts
+			if conf.ServerName != "" {
+				serverNames = []string{conf.ServerName}
+			} else if cs.ServerName != "" {
+				serverNames = []string{cs.ServerName}
+			}
+
+			_, pool := store.get()
+			return verifyServerCertificate(cs.PeerCertificates, pool, serverNames, conf.PeerNames)
+		},
+	}
+
+	if conf.CertFile != "" {
+		tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := store.get()
+			return cert, nil
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+func DialHosts(addrs ...string) []string {
+	var hosts []string
+	for _, addr := range addrs {
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			hosts = append(hosts, host)
+		} else {
+			hosts = append(hosts, addr)
+		}
+	}
+
+	return hosts
+}
+
+func verifyServerCertificate(certs []*x509.Certificate, pool *x509.CertPool, serverNames []string, peerNames []string) error {
+	if len(certs) == 0 {
+		return fmt.Errorf("no server certificate")
+	}
+
+	if len(serverNames) == 0 {
+		return fmt.Errorf("server name is required to verify server certificate")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	var err error
+	for _, serverName := range serverNames {
+		if _, err = certs[0].Verify(x509.VerifyOptions{
+			Roots:         pool,
+			Intermediates: intermediates,
+			DNSName:       serverName,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}); err == nil {
+			return verifyPeerName(certs[0], peerNames)
+		}
+	}
+
+	return fmt.Errorf("verify server certificate failed: %s", err.Error())
+}
+
+func verifyPeerName(cert *x509.Certificate, peerNames []string) error {
+	if len(peerNames) == 0 {
+		return nil
+	}
+
+	identities := append([]string{cert.Subject.CommonName}, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		identities = append(identities, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+
+	for _, identity := range identities {
+		for _, peerName := range peerNames {
+			if identity != "" && identity == peerName {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("peer certificate %s is not in allowed peer names", cert.Subject.CommonName)
+}
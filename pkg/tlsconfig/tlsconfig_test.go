@@ -0,0 +1,168 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	ut "github.com/zdnscloud/cement/unittest"
+
+	"github.com/linkingthing/ddi-agent/config"
+)
+
+func writeCert(t *testing.T, dir, name string, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ut.Assert(t, err == nil, "generate key failed")
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	ut.Assert(t, err == nil, "create certificate failed")
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	ut.Assert(t, err == nil, "marshal key failed")
+	ioutil.WriteFile(path.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644)
+	ioutil.WriteFile(path.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	cert, _ := x509.ParseCertificate(der)
+	return cert, key
+}
+
+func newTemplate(serial int64, cn string, isCA bool) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: cn},
+		DNSNames:              []string{cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+}
+
+func handshake(t *testing.T, serverConf, clientConf *tls.Config) (error, error) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConf)
+	ut.Assert(t, err == nil, "listen failed")
+	defer listener.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- conn.(*tls.Conn).Handshake()
+	}()
+
+	conn, clientErr := tls.Dial("tcp", listener.Addr().String(), clientConf)
+	if clientErr == nil {
+		_, clientErr = conn.Read(make([]byte, 1))
+		conn.Close()
+	}
+	return <-serverErr, clientErr
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tlsconfig")
+	ut.Assert(t, err == nil, "create temp dir failed")
+	defer os.RemoveAll(dir)
+
+	ca, caKey := writeCert(t, dir, "ca", newTemplate(1, "ddi-ca", true), nil, nil)
+	writeCert(t, dir, "server", newTemplate(2, "ddi-agent", false), ca, caKey)
+	writeCert(t, dir, "client", newTemplate(3, "ddi-controller", false), ca, caKey)
+
+	serverConf, err := NewServerTLSConfig(config.TLSConf{
+		Enabled:    true,
+		CertFile:   path.Join(dir, "server.crt"),
+		KeyFile:    path.Join(dir, "server.key"),
+		CAFile:     path.Join(dir, "ca.crt"),
+		ClientAuth: true,
+		PeerNames:  []string{"ddi-controller"},
+	})
+	ut.Assert(t, err == nil, "new server tls config failed")
+
+	clientTLSConf := config.TLSConf{
+		Enabled:    true,
+		CertFile:   path.Join(dir, "client.crt"),
+		KeyFile:    path.Join(dir, "client.key"),
+		CAFile:     path.Join(dir, "ca.crt"),
+		ServerName: "ddi-agent",
+	}
+	clientConf, err := NewClientTLSConfig(clientTLSConf)
+	ut.Assert(t, err == nil, "new client tls config failed")
+	serverErr, clientErr := handshake(t, serverConf, clientConf)
+	ut.Assert(t, serverErr == nil, "mutual tls handshake should succeed")
+
+	clientTLSConf.ServerName = "other-agent"
+	clientConf, _ = NewClientTLSConfig(clientTLSConf)
+	_, clientErr = handshake(t, serverConf, clientConf)
+	ut.Assert(t, clientErr != nil, "server name mismatch should fail")
+
+	clientTLSConf.ServerName = ""
+	clientConf, _ = NewClientTLSConfig(clientTLSConf)
+	_, clientErr = handshake(t, serverConf, clientConf)
+	ut.Assert(t, clientErr != nil, "empty server name should be checked against dial host")
+
+	noCAConf := clientTLSConf
+	noCAConf.CAFile = ""
+	_, err = NewClientTLSConfig(noCAConf)
+	ut.Assert(t, err != nil, "mutual tls without ca file should fail")
+
+	clientTLSConf.ServerName = "ddi-agent"
+	clientTLSConf.CertFile = path.Join(dir, "server.crt")
+	clientTLSConf.KeyFile = path.Join(dir, "server.key")
+	clientConf, _ = NewClientTLSConfig(clientTLSConf)
+	serverErr, _ = handshake(t, serverConf, clientConf)
+	ut.Assert(t, serverErr != nil, "client not in peer names should be rejected")
+}
+
+func TestIPServerName(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tlsconfig")
+	ut.Assert(t, err == nil, "create temp dir failed")
+	defer os.RemoveAll(dir)
+
+	ca, caKey := writeCert(t, dir, "ca", newTemplate(1, "ddi-ca", true), nil, nil)
+	serverTemplate := newTemplate(2, "ddi-agent", false)
+	serverTemplate.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+	writeCert(t, dir, "server", serverTemplate, ca, caKey)
+
+	serverConf, err := NewServerTLSConfig(config.TLSConf{
+		Enabled:  true,
+		CertFile: path.Join(dir, "server.crt"),
+		KeyFile:  path.Join(dir, "server.key"),
+	})
+	ut.Assert(t, err == nil, "new server tls config failed")
+
+	clientTLSConf := config.TLSConf{
+		Enabled: true,
+		CAFile:  path.Join(dir, "ca.crt"),
+	}
+	clientConf, err := NewClientTLSConfig(clientTLSConf, DialHosts("127.0.0.1:58888")...)
+	ut.Assert(t, err == nil, "new client tls config failed")
+	serverErr, _ := handshake(t, serverConf, clientConf)
+	ut.Assert(t, serverErr == nil, "dial host ip should match ip san: %v", serverErr)
+
+	clientTLSConf.ServerName = "127.0.0.1"
+	clientConf, _ = NewClientTLSConfig(clientTLSConf)
+	serverErr, _ = handshake(t, serverConf, clientConf)
+	ut.Assert(t, serverErr == nil, "ip server name should match ip san: %v", serverErr)
+
+	clientTLSConf.ServerName = "10.0.0.1"
+	clientConf, _ = NewClientTLSConfig(clientTLSConf)
+	_, clientErr := handshake(t, serverConf, clientConf)
+	ut.Assert(t, clientErr != nil, "ip server name mismatch should fail")
+}